@@ -1,9 +1,11 @@
 import React, { useState } from 'react';
 import { useQuery, useMutation, useQueryClient } from '@tanstack/react-query';
 import { vehicleSvcApi, type VehicleSvc } from '../../shared/api/vehicle-svc';
+import { useAuth } from '../../shared/hooks/useAuth';
 
 export const MasterVehicleList: React.FC = () => {
   const queryClient = useQueryClient();
+  const { user } = useAuth();
   const [showCreateForm, setShowCreateForm] = useState(false);
   const [editingVehicle, setEditingVehicle] = useState<VehicleSvc | null>(null);
   const [editingMileage, setEditingMileage] = useState<VehicleSvc | null>(null);
@@ -88,7 +90,7 @@ export const MasterVehicleList: React.FC = () => {
   };
 
   const handleStatusChange = (id: string, status: string) => {
-    updateStatusMutation.mutate({ id, data: { status } });
+    updateStatusMutation.mutate({ id, data: { status, reason: 'other', actor: user?.email } });
   };
 
   const handleUpdateMileage = (vehicle: VehicleSvc, e: React.FormEvent<HTMLFormElement>) => {
@@ -39,7 +39,7 @@ export const TrackingVehicleList: React.FC = () => {
                 <strong>License:</strong> {vehicle.licenseNumber}
               </p>
               <p>
-                <strong>Mileage:</strong> {vehicle.mileage.toFixed(1)} km
+                <strong>Mileage:</strong> {vehicle.mileage.toFixed(1)} {vehicle.mileageUnit ?? 'km'}
               </p>
               <p>
                 <strong>Fuel:</strong> {vehicle.fuelLevel.toFixed(1)}%
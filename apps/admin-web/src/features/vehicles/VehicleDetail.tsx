@@ -31,9 +31,19 @@ export const VehicleDetail: React.FC = () => {
     const labels: Record<string, string> = {
       created: '🆕 Created',
       location_updated: '📍 Location Updated',
+      location_archived: '🗄️ Location Archived',
       status_changed: '🔄 Status Changed',
       mileage_updated: '🚗 Mileage Updated',
       fuel_updated: '⛽ Fuel Updated',
+      energy_updated: '🔋 Energy Updated',
+      correction_applied: '🛠️ Correction Applied',
+      details_updated: '✏️ Details Updated',
+      group_changed: '🏢 Group Changed',
+      tags_changed: '🏷️ Tags Changed',
+      device_paired: '📡 Device Paired',
+      device_unpaired: '🔌 Device Unpaired',
+      vehicle_deleted: '🗑️ Vehicle Deleted',
+      vehicle_restored: '♻️ Vehicle Restored',
     };
     return labels[type] || type;
   };
@@ -74,11 +84,16 @@ export const VehicleDetail: React.FC = () => {
           </div>
           <div className="info-item">
             <label>Mileage</label>
-            <span>{vehicle.mileage.toFixed(1)} km</span>
+            <span>{vehicle.mileage.toFixed(1)} {vehicle.mileageUnit ?? 'km'}</span>
           </div>
           <div className="info-item">
-            <label>Fuel Level</label>
-            <span>{vehicle.fuelLevel.toFixed(1)}%</span>
+            <label>{vehicle.energyType === 'bev' ? 'Battery' : 'Fuel Level'}</label>
+            <span>
+              {vehicle.fuelLevel.toFixed(1)}%
+              {vehicle.chargingState === 'charging' && ' (charging)'}
+              {vehicle.estimatedRange !== undefined &&
+                ` · ${vehicle.estimatedRange.toFixed(0)} ${vehicle.mileageUnit ?? 'km'} range`}
+            </span>
           </div>
           <div className="info-item">
             <label>Location</label>
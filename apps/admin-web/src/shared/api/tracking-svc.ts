@@ -8,6 +8,15 @@ const trackingApi = axios.create({
   },
 });
 
+// Requests are scoped to the tenant of the signed-in user's token.
+trackingApi.interceptors.request.use((config) => {
+  const token = localStorage.getItem('auth_token');
+  if (token) {
+    config.headers.Authorization = `Bearer ${token}`;
+  }
+  return config;
+});
+
 
 export interface Vehicle {
   id: string;
@@ -20,7 +29,13 @@ export interface Vehicle {
   longitude: number;
   altitude: number;
   mileage: number;
-  fuelLevel: number;
+  mileageUnit?: string; // km or mi
+  fuelLevel: number; // battery state of charge for a bev
+  energyType?: string; // ice, hybrid or bev
+  energyCapacity?: number;
+  capacityUnit?: string; // L, gal or kWh
+  chargingState?: string;
+  estimatedRange?: number; // in mileageUnit
   createdAt: string;
   updatedAt: string;
 }
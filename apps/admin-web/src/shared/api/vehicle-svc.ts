@@ -7,6 +7,15 @@ const vehicleSvcClient = axios.create({
   },
 });
 
+// Requests are scoped to the tenant of the signed-in user's token.
+vehicleSvcClient.interceptors.request.use((config) => {
+  const token = localStorage.getItem('auth_token');
+  if (token) {
+    config.headers.Authorization = `Bearer ${token}`;
+  }
+  return config;
+});
+
 export interface VehicleSvc {
   id: string;
   vin: string;
@@ -18,7 +27,13 @@ export interface VehicleSvc {
   longitude: number;
   altitude: number;
   mileage: number;
-  fuelLevel: number;
+  mileageUnit?: string; // km or mi
+  fuelLevel: number; // battery state of charge for a bev
+  energyType?: string; // ice, hybrid or bev
+  energyCapacity?: number;
+  capacityUnit?: string; // L, gal or kWh
+  chargingState?: string;
+  estimatedRange?: number; // in mileageUnit
   version: number;
   createdAt: string;
   updatedAt: string;
@@ -35,6 +50,8 @@ export interface CreateVehicleRequest {
   altitude?: number;
   mileage?: number;
   fuelLevel?: number;
+  energyType?: string;
+  energyCapacity?: number;
 }
 
 export interface UpdateVehicleLocationRequest {
@@ -46,6 +63,8 @@ export interface UpdateVehicleLocationRequest {
 
 export interface UpdateVehicleStatusRequest {
   status: string;
+  reason: string;
+  actor?: string;
 }
 
 export interface UpdateVehicleMileageRequest {
@@ -29,7 +29,7 @@ func main() {
 	container := di.NewContainer(logger)
 
 	mux := http.NewServeMux()
-	route.RegisterRoutes(mux, container.LoginHandler, container.RegisterHandler, logger)
+	route.RegisterRoutes(mux, container.LoginHandler, container.RegisterHandler, container.PrefsHandler, logger)
 
 	srv := &http.Server{
 		Addr:    ":" + cfg.Port,
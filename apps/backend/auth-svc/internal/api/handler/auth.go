@@ -3,28 +3,34 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/application/service"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/infrastructure/security"
 )
 
 type AuthHandler struct {
-	loginHandler    *service.LoginHandler
-	registerHandler *service.RegisterUserHandler
-	logger          *zap.Logger
+	loginHandler       *service.LoginHandler
+	registerHandler    *service.RegisterUserHandler
+	preferencesHandler *service.UpdatePreferencesHandler
+	logger             *zap.Logger
 }
 
 func NewAuthHandler(
 	loginHandler *service.LoginHandler,
 	registerHandler *service.RegisterUserHandler,
+	preferencesHandler *service.UpdatePreferencesHandler,
 	logger *zap.Logger,
 ) *AuthHandler {
 	return &AuthHandler{
-		loginHandler:    loginHandler,
-		registerHandler: registerHandler,
-		logger:          logger,
+		loginHandler:       loginHandler,
+		registerHandler:    registerHandler,
+		preferencesHandler: preferencesHandler,
+		logger:             logger,
 	}
 }
 
@@ -34,10 +40,12 @@ type LoginRequest struct {
 }
 
 type UserInfo struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
-	Role  string `json:"role"`
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	TenantID string `json:"tenantId"`
+	Units    string `json:"units"`
 }
 
 type LoginResponse struct {
@@ -81,18 +89,23 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	h.respondSuccess(w, http.StatusOK, LoginResponse{
 		Token: token,
 		User: UserInfo{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  user.GetRole(),
+			ID:       user.ID,
+			Email:    user.Email,
+			Name:     user.Name,
+			Role:     user.GetRole(),
+			TenantID: user.TenantID,
+			Units:    user.Units,
 		},
 	})
 }
 
+// RegisterRequest carries no tenant: a user joins the tenant of the admin
+// registering them, and signs up to the default tenant on their own.
 type RegisterRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 	Name     string `json:"name"`
+	Units    string `json:"units"`
 }
 
 type RegisterResponse struct {
@@ -105,8 +118,24 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tenantID := entity.DefaultTenantID
+	if r.Header.Get("Authorization") != "" {
+		claims, ok := h.authenticate(w, r)
+		if !ok {
+			return
+		}
+		if claims.Role != entity.RoleAdmin {
+			h.respondError(w, http.StatusForbidden, "only an admin can register users to a tenant")
+			return
+		}
+		tenantID = claims.TenantID
+	}
+
+	// Unknown fields are refused so a caller cannot pick its own tenant.
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
 	var req RegisterRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decoder.Decode(&req); err != nil {
 		h.respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
@@ -116,6 +145,8 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		Email:    req.Email,
 		Password: req.Password,
 		Name:     req.Name,
+		TenantID: tenantID,
+		Units:    req.Units,
 	}
 
 	if err := h.registerHandler.Handle(r.Context(), cmd); err != nil {
@@ -127,6 +158,50 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	h.respondSuccess(w, http.StatusCreated, RegisterResponse{Message: "user registered successfully"})
 }
 
+type UpdatePreferencesRequest struct {
+	Units string `json:"units"`
+}
+
+// UpdatePreferences saves the caller's preferences and returns a new token
+// carrying them; the old token keeps the previous preference until it expires.
+func (h *AuthHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	claims, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdatePreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	defer r.Body.Close()
+
+	cmd := &command.UpdatePreferencesCommand{
+		Email: claims.UserID,
+		Units: req.Units,
+	}
+
+	token, user, err := h.preferencesHandler.Handle(r.Context(), cmd)
+	if err != nil {
+		h.logger.Warn("update preferences failed", zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, LoginResponse{
+		Token: token,
+		User: UserInfo{
+			ID:       user.ID,
+			Email:    user.Email,
+			Name:     user.Name,
+			Role:     user.GetRole(),
+			TenantID: user.TenantID,
+			Units:    user.Units,
+		},
+	})
+}
+
 func (h *AuthHandler) Verify(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		w.WriteHeader(http.StatusMethodNotAllowed)
@@ -137,14 +212,32 @@ func (h *AuthHandler) Verify(w http.ResponseWriter, r *http.Request) {
 	// For now, return a placeholder
 	h.respondSuccess(w, http.StatusOK, VerifyResponse{
 		User: UserInfo{
-			ID:    "user-id",
-			Email: "user@example.com",
-			Name:  "User",
-			Role:  "operator",
+			ID:       "user-id",
+			Email:    "user@example.com",
+			Name:     "User",
+			Role:     "operator",
+			TenantID: entity.DefaultTenantID,
+			Units:    entity.UnitsMetric,
 		},
 	})
 }
 
+// authenticate returns the claims of the request's bearer token, or responds
+// 401 and reports false.
+func (h *AuthHandler) authenticate(w http.ResponseWriter, r *http.Request) (*security.Claims, bool) {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		h.respondError(w, http.StatusUnauthorized, "missing or invalid authorization header")
+		return nil, false
+	}
+	claims, err := security.ValidateToken(parts[1])
+	if err != nil {
+		h.respondError(w, http.StatusUnauthorized, "invalid or expired token")
+		return nil, false
+	}
+	return claims, true
+}
+
 func (h *AuthHandler) respondSuccess(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
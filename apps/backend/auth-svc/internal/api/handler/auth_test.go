@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/application/service"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/infrastructure/persistence"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/infrastructure/security"
+)
+
+func newTestAuthHandler() (*AuthHandler, *persistence.InMemoryUserRepository) {
+	repo := persistence.NewInMemoryUserRepository()
+	return NewAuthHandler(
+		service.NewLoginHandler(repo),
+		service.NewRegisterUserHandler(repo),
+		service.NewUpdatePreferencesHandler(repo),
+		zap.NewNop(),
+	), repo
+}
+
+func register(h *AuthHandler, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/register", strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	h.Register(recorder, req)
+	return recorder
+}
+
+func tokenFor(t *testing.T, role, tenantID string) string {
+	t.Helper()
+	token, err := security.GenerateToken("someone@acme.com", role, tenantID, entity.UnitsMetric)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	return token
+}
+
+func TestRegisterSelfSignUpJoinsDefaultTenant(t *testing.T) {
+	h, repo := newTestAuthHandler()
+
+	res := register(h, "", `{"email":"new@acme.com","password":"secret","name":"New"}`)
+	if res.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	user, err := repo.GetByEmail(context.Background(), "new@acme.com")
+	if err != nil {
+		t.Fatalf("user not saved: %v", err)
+	}
+	if user.TenantID != entity.DefaultTenantID {
+		t.Fatalf("expected tenant %q, got %q", entity.DefaultTenantID, user.TenantID)
+	}
+}
+
+func TestRegisterRejectsTenantInBody(t *testing.T) {
+	h, repo := newTestAuthHandler()
+
+	for name, token := range map[string]string{
+		"anonymous": "",
+		"admin":     tokenFor(t, entity.RoleAdmin, "acme"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := register(h, token, `{"email":"intruder@acme.com","password":"secret","tenantId":"globex"}`)
+			if res.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, res.Code)
+			}
+			if exists, _ := repo.ExistsByEmail(context.Background(), "intruder@acme.com"); exists {
+				t.Fatal("expected no user to be saved")
+			}
+		})
+	}
+}
+
+func TestRegisterByAdminJoinsAdminTenant(t *testing.T) {
+	h, repo := newTestAuthHandler()
+
+	res := register(h, tokenFor(t, entity.RoleAdmin, "acme"), `{"email":"driver@acme.com","password":"secret"}`)
+	if res.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	user, err := repo.GetByEmail(context.Background(), "driver@acme.com")
+	if err != nil {
+		t.Fatalf("user not saved: %v", err)
+	}
+	if user.TenantID != "acme" {
+		t.Fatalf("expected tenant %q, got %q", "acme", user.TenantID)
+	}
+}
+
+func TestRegisterByNonAdminIsForbidden(t *testing.T) {
+	h, repo := newTestAuthHandler()
+
+	res := register(h, tokenFor(t, entity.RoleOperator, "acme"), `{"email":"friend@acme.com","password":"secret"}`)
+	if res.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, res.Code)
+	}
+	if exists, _ := repo.ExistsByEmail(context.Background(), "friend@acme.com"); exists {
+		t.Fatal("expected no user to be saved")
+	}
+}
+
+func TestRegisterWithInvalidTokenIsUnauthorized(t *testing.T) {
+	h, _ := newTestAuthHandler()
+
+	res := register(h, "not-a-token", `{"email":"someone@acme.com","password":"secret"}`)
+	if res.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, res.Code)
+	}
+}
+
+func TestLoginTokenCarriesUserTenant(t *testing.T) {
+	h, _ := newTestAuthHandler()
+	register(h, tokenFor(t, entity.RoleAdmin, "acme"), `{"email":"driver@acme.com","password":"secret"}`)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(`{"email":"driver@acme.com","password":"secret"}`))
+	recorder := httptest.NewRecorder()
+	h.Login(recorder, req)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	var body LoginResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.User.TenantID != "acme" {
+		t.Fatalf("expected tenant %q, got %q", "acme", body.User.TenantID)
+	}
+	claims, err := security.ValidateToken(body.Token)
+	if err != nil {
+		t.Fatalf("invalid token: %v", err)
+	}
+	if claims.TenantID != "acme" {
+		t.Fatalf("expected tenant %q in token, got %q", "acme", claims.TenantID)
+	}
+}
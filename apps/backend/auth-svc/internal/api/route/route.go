@@ -13,14 +13,16 @@ func RegisterRoutes(
 	mux *http.ServeMux,
 	loginHandler *service.LoginHandler,
 	registerHandler *service.RegisterUserHandler,
+	preferencesHandler *service.UpdatePreferencesHandler,
 	logger *zap.Logger,
 ) {
-	authHandler := handler.NewAuthHandler(loginHandler, registerHandler, logger)
+	authHandler := handler.NewAuthHandler(loginHandler, registerHandler, preferencesHandler, logger)
 
 	mux.HandleFunc("GET /health", healthCheck)
 	mux.HandleFunc("POST /api/v1/auth/login", authHandler.Login)
 	mux.HandleFunc("POST /api/v1/auth/register", authHandler.Register)
 	mux.HandleFunc("GET /api/v1/auth/verify", authHandler.Verify)
+	mux.HandleFunc("PUT /api/v1/auth/preferences", authHandler.UpdatePreferences)
 }
 
 func healthCheck(w http.ResponseWriter, r *http.Request) {
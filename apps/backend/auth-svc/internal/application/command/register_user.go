@@ -4,4 +4,6 @@ type RegisterUserCommand struct {
 	Email    string
 	Password string
 	Name     string
+	TenantID string // the registering admin's tenant, or the default one on self sign-up
+	Units    string // optional; defaults to metric
 }
@@ -0,0 +1,10 @@
+package command
+
+type UpdatePreferencesCommand struct {
+	Email string
+	Units string
+}
+
+func (c *UpdatePreferencesCommand) CommandName() string {
+	return "UpdatePreferences"
+}
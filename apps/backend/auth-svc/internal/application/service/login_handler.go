@@ -27,7 +27,7 @@ func (h *LoginHandler) Handle(ctx context.Context, cmd *command.LoginCommand) (s
 		return "", nil, fmt.Errorf("invalid credentials")
 	}
 
-	token, err := security.GenerateToken(user.ID, user.GetRole())
+	token, err := security.GenerateToken(user.ID, user.GetRole(), user.TenantID, user.Units)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -31,10 +31,15 @@ func (h *RegisterUserHandler) Handle(ctx context.Context, cmd *command.RegisterU
 		return fmt.Errorf("user already exists: %s", cmd.Email)
 	}
 
-	user, err := entity.NewUser(cmd.Email, cmd.Password, cmd.Name)
+	user, err := entity.NewUser(cmd.Email, cmd.Password, cmd.Name, cmd.TenantID)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
 	}
+	if cmd.Units != "" {
+		if err := user.SetUnits(cmd.Units); err != nil {
+			return err
+		}
+	}
 
 	if err := h.userRepo.Save(ctx, user); err != nil {
 		return fmt.Errorf("failed to save user: %w", err)
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/auth-svc/internal/infrastructure/security"
+)
+
+type UpdatePreferencesHandler struct {
+	userRepo UserRepository
+}
+
+func NewUpdatePreferencesHandler(userRepo UserRepository) *UpdatePreferencesHandler {
+	return &UpdatePreferencesHandler{userRepo: userRepo}
+}
+
+// Handle saves the preferences and issues a fresh token, since the other
+// services read the unit preference from the token.
+func (h *UpdatePreferencesHandler) Handle(ctx context.Context, cmd *command.UpdatePreferencesCommand) (string, *entity.User, error) {
+	user, err := h.userRepo.GetByEmail(ctx, cmd.Email)
+	if err != nil {
+		return "", nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if err := user.SetUnits(cmd.Units); err != nil {
+		return "", nil, err
+	}
+
+	if err := h.userRepo.Save(ctx, user); err != nil {
+		return "", nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	token, err := security.GenerateToken(user.ID, user.GetRole(), user.TenantID, user.Units)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return token, user, nil
+}
@@ -12,19 +12,35 @@ const (
 	RoleViewer   = "viewer"
 )
 
+// Unit systems a user can read and enter distances and volumes in.
+const (
+	UnitsMetric   = "metric"
+	UnitsImperial = "imperial"
+)
+
+// DefaultTenantID is the organization users belong to when none is given, so
+// single-fleet deployments keep working unchanged.
+const DefaultTenantID = "default"
+
 type User struct {
 	ID       string
 	Email    string
 	Password string
 	Name     string
 	Roles    []string
+	TenantID string
+	Units    string // preferred unit system, used when a request does not ask for one
 }
 
-func NewUser(email, plainPassword, name string) (*User, error) {
+func NewUser(email, plainPassword, name, tenantID string) (*User, error) {
 	if email == "" || plainPassword == "" {
 		return nil, fmt.Errorf("email and password are required")
 	}
 
+	if tenantID == "" {
+		tenantID = DefaultTenantID
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(plainPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
@@ -36,6 +52,8 @@ func NewUser(email, plainPassword, name string) (*User, error) {
 		Password: string(hashedPassword),
 		Name:     name,
 		Roles:    []string{RoleOperator},
+		TenantID: tenantID,
+		Units:    UnitsMetric,
 	}, nil
 }
 
@@ -59,6 +77,15 @@ func (u *User) SetRole(role string) error {
 	return nil
 }
 
+func (u *User) SetUnits(units string) error {
+	if units != UnitsMetric && units != UnitsImperial {
+		return fmt.Errorf("invalid units: %s", units)
+	}
+
+	u.Units = units
+	return nil
+}
+
 func (u *User) GetRole() string {
 	if len(u.Roles) > 0 {
 		return u.Roles[0]
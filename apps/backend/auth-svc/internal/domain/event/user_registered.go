@@ -1,6 +1,7 @@
 package event
 
 type UserRegisteredEvent struct {
-	UserID string
-	Email  string
+	UserID   string
+	Email    string
+	TenantID string
 }
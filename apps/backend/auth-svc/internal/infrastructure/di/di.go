@@ -12,6 +12,7 @@ type Container struct {
 	UserRepository  service.UserRepository
 	LoginHandler    *service.LoginHandler
 	RegisterHandler *service.RegisterUserHandler
+	PrefsHandler    *service.UpdatePreferencesHandler
 }
 
 func NewContainer(logger *zap.Logger) *Container {
@@ -19,11 +20,13 @@ func NewContainer(logger *zap.Logger) *Container {
 
 	loginHandler := service.NewLoginHandler(userRepo)
 	registerHandler := service.NewRegisterUserHandler(userRepo)
+	prefsHandler := service.NewUpdatePreferencesHandler(userRepo)
 
 	return &Container{
 		Logger:          logger,
 		UserRepository:  userRepo,
 		LoginHandler:    loginHandler,
 		RegisterHandler: registerHandler,
+		PrefsHandler:    prefsHandler,
 	}
 }
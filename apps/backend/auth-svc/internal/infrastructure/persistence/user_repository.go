@@ -18,7 +18,7 @@ func NewInMemoryUserRepository() *InMemoryUserRepository {
 		users: make(map[string]*entity.User),
 	}
 
-	adminUser, _ := entity.NewUser("admin@ln.com", "admin123", "Admin User")
+	adminUser, _ := entity.NewUser("admin@ln.com", "admin123", "Admin User", entity.DefaultTenantID)
 	adminUser.SetRole(entity.RoleAdmin)
 	repo.users["admin@ln.com"] = adminUser
 
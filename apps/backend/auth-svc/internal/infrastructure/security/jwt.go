@@ -14,15 +14,19 @@ const (
 )
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	TenantID string `json:"tenant_id"`
+	Units    string `json:"units,omitempty"` // the user's unit preference
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userID, role string) (string, error) {
+func GenerateToken(userID, role, tenantID, units string) (string, error) {
 	claims := Claims{
-		UserID: userID,
-		Role:   role,
+		UserID:   userID,
+		Role:     role,
+		TenantID: tenantID,
+		Units:    units,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(TokenExpiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -3,18 +3,41 @@ package config
 import "time"
 
 type Config struct {
-	AppEnv string
-	HTTP   HTTPConfig
-	Mongo  MongoConfig
+	AppEnv   string
+	HTTP     HTTPConfig
+	Mongo    MongoConfig
+	Trip     TripConfig
+	Location LocationConfig
 }
 
 type HTTPConfig struct {
 	Port         string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// AnonymousTenant lets requests without a bearer token use the default
+	// tenant, for callers that predate tenants. Off unless set.
+	AnonymousTenant bool
 }
 
 type MongoConfig struct {
 	URI      string
 	Database string
 }
+
+type TripConfig struct {
+	// StationaryTimeout is how long a vehicle must stay put before its trip ends.
+	StationaryTimeout time.Duration
+	// MinMovementMeters filters GPS jitter out of trip detection.
+	MinMovementMeters float64
+}
+
+type LocationConfig struct {
+	// RawRetention is how long every recorded point is kept after it is
+	// saved.
+	RawRetention time.Duration
+	// MinuteRetention is how long minute rollups are kept; hourly rollups
+	// are kept for good.
+	MinuteRetention time.Duration
+	// RollupInterval is how often new points are rolled up.
+	RollupInterval time.Duration
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -52,6 +53,23 @@ func main() {
 	backgroundContext, cancelBackground := context.WithCancel(context.Background())
 	domainEventWorker.Start(backgroundContext)
 
+	tripSweeper := worker.NewTripSweeper(containerDI.CommandBus, appLogger, time.Minute)
+	tripSweeper.Start(backgroundContext)
+
+	locationRollup := worker.NewLocationRollup(containerDI.CommandBus, appLogger, cfg.Location.RollupInterval)
+	locationRollup.Start(backgroundContext)
+
+	go func() {
+		imported, err := containerDI.ImportLocationHistory(backgroundContext)
+		if err != nil {
+			appLogger.Error("failed to import location history", zap.Error(err))
+			return
+		}
+		if imported > 0 {
+			appLogger.Info("imported location history", zap.Int("entries", imported))
+		}
+	}()
+
 	// Initialize Kafka consumer for external events
 	kafkaConsumer := initializeKafkaConsumer(containerDI, appLogger)
 	if kafkaConsumer != nil {
@@ -65,7 +83,7 @@ func main() {
 	}
 
 	mux := registerApiRoutes(containerDI, appLogger)
-	httpServer := startHTTPServer(cfg, middleware.LoggingMiddleware(mux))
+	httpServer := startHTTPServer(cfg, middleware.LoggingMiddleware(middleware.TenantMiddleware(cfg.HTTP.AnonymousTenant)(middleware.UnitsMiddleware(mux))))
 
 	go func() {
 		appLogger.Info("starting http server", zap.String("addr", httpServer.Addr))
@@ -77,6 +95,8 @@ func main() {
 	handleGracefulShutdown(appLogger)
 
 	domainEventWorker.Stop()
+	tripSweeper.Stop()
+	locationRollup.Stop()
 	cancelBackground()
 	cancelHttpServer := shutdownHTTPServer(httpServer, appLogger)
 	cancelHttpServer()
@@ -93,15 +113,44 @@ func loadConfig() config.Config {
 	return config.Config{
 		AppEnv: appEnv,
 		HTTP: config.HTTPConfig{
-			Port:         ":" + appPort,
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 5 * time.Second,
+			Port:            ":" + appPort,
+			ReadTimeout:     5 * time.Second,
+			WriteTimeout:    5 * time.Second,
+			AnonymousTenant: boolFromEnv("HTTP_ANONYMOUS_TENANT"),
 		},
 		Mongo: config.MongoConfig{
 			URI:      mongoURI,
 			Database: mongoDb,
 		},
+		Trip: config.TripConfig{
+			StationaryTimeout: durationFromEnv("TRIP_STATIONARY_TIMEOUT", 5*time.Minute),
+			MinMovementMeters: floatFromEnv("TRIP_MIN_MOVEMENT_METERS", 50),
+		},
+		Location: config.LocationConfig{
+			RawRetention:    durationFromEnv("LOCATION_RAW_RETENTION", 7*24*time.Hour),
+			MinuteRetention: durationFromEnv("LOCATION_MINUTE_RETENTION", 90*24*time.Hour),
+			RollupInterval:  durationFromEnv("LOCATION_ROLLUP_INTERVAL", time.Minute),
+		},
+	}
+}
+
+func boolFromEnv(key string) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	return err == nil && value
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return fallback
+}
+
+func floatFromEnv(key string, fallback float64) float64 {
+	if value, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil && value > 0 {
+		return value
 	}
+	return fallback
 }
 
 func initializeKafkaConsumer(container *di.Container, logger *zap.Logger) *messaging.KafkaConsumer {
@@ -115,9 +164,21 @@ func initializeKafkaConsumer(container *di.Container, logger *zap.Logger) *messa
 	topics := []string{
 		"vehicle.created",
 		"vehicle.location.updated",
+		"vehicle.location.archived",
 		"vehicle.status.changed",
 		"vehicle.mileage.updated",
 		"vehicle.fuel.updated",
+		"vehicle.energy.updated",
+		"driver.assigned",
+		"driver.unassigned",
+		"device.paired",
+		"device.unpaired",
+		"tracking.correction.applied",
+		"vehicle.details.updated",
+		"vehicle.group.changed",
+		"vehicle.tags.changed",
+		"vehicle.deleted",
+		"vehicle.restored",
 	}
 
 	consumer := messaging.NewKafkaConsumer(brokers, "tracking-svc", topics, logger)
@@ -126,12 +187,37 @@ func initializeKafkaConsumer(container *di.Container, logger *zap.Logger) *messa
 		container.VehicleCreatedEventHandler.Handle)
 	consumer.RegisterHandler("vehicle.location.updated",
 		container.VehicleLocationUpdatedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.location.archived",
+		container.VehicleLocationArchivedEventHandler.Handle)
 	consumer.RegisterHandler("vehicle.status.changed",
 		container.VehicleStatusChangedEventHandler.Handle)
 	consumer.RegisterHandler("vehicle.mileage.updated",
 		container.VehicleMileageUpdatedEventHandler.Handle)
+	// Kept so fuel events published before energy levels still drain.
 	consumer.RegisterHandler("vehicle.fuel.updated",
 		container.VehicleFuelLevelUpdatedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.energy.updated",
+		container.VehicleEnergyLevelUpdatedEventHandler.Handle)
+	consumer.RegisterHandler("driver.assigned",
+		container.DriverAssignedEventHandler.Handle)
+	consumer.RegisterHandler("driver.unassigned",
+		container.DriverUnassignedEventHandler.Handle)
+	consumer.RegisterHandler("device.paired",
+		container.DevicePairedEventHandler.Handle)
+	consumer.RegisterHandler("device.unpaired",
+		container.DeviceUnpairedEventHandler.Handle)
+	consumer.RegisterHandler("tracking.correction.applied",
+		container.TrackingCorrectionAppliedHandler.Handle)
+	consumer.RegisterHandler("vehicle.details.updated",
+		container.VehicleDetailsUpdatedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.group.changed",
+		container.VehicleGroupChangedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.tags.changed",
+		container.VehicleTagsChangedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.deleted",
+		container.VehicleDeletedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.restored",
+		container.VehicleRestoredEventHandler.Handle)
 
 	return consumer
 }
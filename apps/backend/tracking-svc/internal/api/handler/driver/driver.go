@@ -0,0 +1,21 @@
+package driver
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type DriverHandler struct {
+	queryBus query.QueryBus
+	logger   *zap.Logger
+}
+
+func InitDriverHandler(
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *DriverHandler {
+	return &DriverHandler{
+		queryBus: queryBus,
+		logger:   logger,
+	}
+}
@@ -0,0 +1,54 @@
+package driver
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+func (d *DriverHandler) GetChangeHistory(w http.ResponseWriter, r *http.Request) {
+	driverID := r.PathValue("id")
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "driver id is required")
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 50
+	offset := 0
+
+	if limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	q := &query.GetDriverChangeHistoryQuery{
+		DriverID: driverID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	result, err := d.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		d.logger.Error("failed to get driver change history",
+			zap.String("driverId", driverID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get driver change history")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
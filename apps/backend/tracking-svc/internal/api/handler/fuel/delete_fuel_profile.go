@@ -0,0 +1,37 @@
+package fuel
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+)
+
+func (h *FuelHandler) DeleteFuelProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleModel := r.PathValue("model")
+
+	if vehicleModel == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle model is required")
+		return
+	}
+
+	cmd := &command.DeleteFuelProfileCommand{
+		VehicleModel: vehicleModel,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to delete fuel profile",
+			zap.String("vehicleModel", vehicleModel),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "DELETE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("fuel profile deleted", zap.String("vehicleModel", vehicleModel))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "fuel profile deleted successfully",
+	})
+}
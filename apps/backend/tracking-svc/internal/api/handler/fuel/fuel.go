@@ -0,0 +1,25 @@
+package fuel
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type FuelHandler struct {
+	commandBus command.CommandBus
+	queryBus   query.QueryBus
+	logger     *zap.Logger
+}
+
+func InitFuelHandler(
+	commandBus command.CommandBus,
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *FuelHandler {
+	return &FuelHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger,
+	}
+}
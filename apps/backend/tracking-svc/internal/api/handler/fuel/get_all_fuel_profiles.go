@@ -0,0 +1,21 @@
+package fuel
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *FuelHandler) GetAllFuelProfiles(w http.ResponseWriter, r *http.Request) {
+	result, err := h.queryBus.Dispatch(r.Context(), &query.GetAllFuelProfilesQuery{})
+	if err != nil {
+		h.logger.Error("failed to get fuel profiles", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get fuel profiles")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
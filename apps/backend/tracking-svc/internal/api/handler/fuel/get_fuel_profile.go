@@ -0,0 +1,34 @@
+package fuel
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *FuelHandler) GetFuelProfile(w http.ResponseWriter, r *http.Request) {
+	vehicleModel := r.PathValue("model")
+	if vehicleModel == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle model is required")
+		return
+	}
+
+	q := &query.GetFuelProfileQuery{
+		VehicleModel: vehicleModel,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get fuel profile",
+			zap.String("vehicleModel", vehicleModel),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get fuel profile")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
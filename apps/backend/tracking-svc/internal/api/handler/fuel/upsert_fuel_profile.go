@@ -0,0 +1,57 @@
+package fuel
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+)
+
+func (h *FuelHandler) UpsertFuelProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleModel := r.PathValue("model")
+
+	if vehicleModel == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle model is required")
+		return
+	}
+
+	var req dto.FuelProfileRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode fuel profile request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	lowLevelPercent := entity.DefaultLowLevelPercent
+	if req.LowLevelPercent != nil {
+		lowLevelPercent = *req.LowLevelPercent
+	}
+
+	cmd := &command.UpsertFuelProfileCommand{
+		VehicleModel:              vehicleModel,
+		TankCapacityLiters:        req.TankCapacityLiters,
+		RefuelThresholdPercent:    req.RefuelThresholdPercent,
+		DropThresholdPercent:      req.DropThresholdPercent,
+		ConsumptionLitersPer100Km: req.ConsumptionLitersPer100Km,
+		ConsumptionTolerance:      req.ConsumptionTolerance,
+		LowLevelPercent:           lowLevelPercent,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to save fuel profile",
+			zap.String("vehicleModel", vehicleModel),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("fuel profile saved", zap.String("vehicleModel", vehicleModel))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "fuel profile saved successfully",
+	})
+}
@@ -0,0 +1,48 @@
+package geofence
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func (h *GeofenceHandler) CreateGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.GeofenceRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode create geofence request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	geofenceID := valueobject.GenerateGeofenceID().String()
+	center, polygon := toGeofencePoints(req)
+	cmd := &command.CreateGeofenceCommand{
+		GeofenceID:    geofenceID,
+		Name:          req.Name,
+		Category:      req.Category,
+		Shape:         req.Shape,
+		Center:        center,
+		RadiusMeters:  req.RadiusMeters,
+		Polygon:       polygon,
+		SpeedLimitKmh: req.SpeedLimitKmh,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to create geofence", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "CREATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("geofence created", zap.String("geofenceId", geofenceID))
+	handler.RespondSuccess(w, http.StatusCreated, map[string]string{
+		"id":      geofenceID,
+		"message": "geofence created successfully",
+	})
+}
@@ -0,0 +1,37 @@
+package geofence
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+)
+
+func (h *GeofenceHandler) DeleteGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	geofenceID := r.PathValue("id")
+
+	if geofenceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Geofence ID is required")
+		return
+	}
+
+	cmd := &command.DeleteGeofenceCommand{
+		GeofenceID: geofenceID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to delete geofence",
+			zap.String("geofenceId", geofenceID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "DELETE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("geofence deleted", zap.String("geofenceId", geofenceID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "geofence deleted successfully",
+	})
+}
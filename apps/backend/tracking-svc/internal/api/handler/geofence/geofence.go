@@ -0,0 +1,40 @@
+package geofence
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type GeofenceHandler struct {
+	commandBus command.CommandBus
+	queryBus   query.QueryBus
+	logger     *zap.Logger
+}
+
+func InitGeofenceHandler(
+	commandBus command.CommandBus,
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *GeofenceHandler {
+	return &GeofenceHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger,
+	}
+}
+
+func toGeofencePoints(req dto.GeofenceRequest) (command.GeofencePoint, []command.GeofencePoint) {
+	var center command.GeofencePoint
+	if req.Center != nil {
+		center = command.GeofencePoint{Latitude: req.Center.Latitude, Longitude: req.Center.Longitude}
+	}
+
+	polygon := make([]command.GeofencePoint, 0, len(req.Polygon))
+	for _, vertex := range req.Polygon {
+		polygon = append(polygon, command.GeofencePoint{Latitude: vertex.Latitude, Longitude: vertex.Longitude})
+	}
+
+	return center, polygon
+}
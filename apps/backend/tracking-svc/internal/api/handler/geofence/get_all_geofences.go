@@ -0,0 +1,42 @@
+package geofence
+
+import (
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *GeofenceHandler) GetAllGeofences(w http.ResponseWriter, r *http.Request) {
+	limit := 50
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	q := &query.GetAllGeofencesQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get geofences", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get geofences")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
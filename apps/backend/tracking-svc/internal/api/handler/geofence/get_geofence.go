@@ -0,0 +1,33 @@
+package geofence
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *GeofenceHandler) GetGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	geofenceID := r.PathValue("id")
+
+	if geofenceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Geofence ID is required")
+		return
+	}
+
+	q := &query.GetGeofenceQuery{
+		GeofenceID: geofenceID,
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, q)
+	if err != nil {
+		h.logger.Error("failed to get geofence", zap.String("geofenceId", geofenceID), zap.Error(err))
+		handler.RespondError(w, http.StatusNotFound, "GEOFENCE_NOT_FOUND", "Geofence not found")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
@@ -0,0 +1,53 @@
+package geofence
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+)
+
+func (h *GeofenceHandler) UpdateGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	geofenceID := r.PathValue("id")
+
+	if geofenceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Geofence ID is required")
+		return
+	}
+
+	var req dto.GeofenceRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode update geofence request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	center, polygon := toGeofencePoints(req)
+	cmd := &command.UpdateGeofenceCommand{
+		GeofenceID:    geofenceID,
+		Name:          req.Name,
+		Category:      req.Category,
+		Shape:         req.Shape,
+		Center:        center,
+		RadiusMeters:  req.RadiusMeters,
+		Polygon:       polygon,
+		SpeedLimitKmh: req.SpeedLimitKmh,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to update geofence",
+			zap.String("geofenceId", geofenceID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("geofence updated", zap.String("geofenceId", geofenceID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "geofence updated successfully",
+	})
+}
@@ -0,0 +1,106 @@
+package vehicle
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/export"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+// exportChunkTimeout is how long writing each chunk of an export may take. It
+// replaces the server's WriteTimeout, which would cut off any export that
+// takes longer than that to stream.
+const exportChunkTimeout = 30 * time.Second
+
+// ExportTrack streams the vehicle's location history as GeoJSON, GPX or KML.
+func (h *VehicleHandler) ExportTrack(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	var from, to time.Time
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		parsed, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "from must be an RFC3339 timestamp")
+			return
+		}
+		from = parsed
+	}
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		parsed, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "to must be an RFC3339 timestamp")
+			return
+		}
+		to = parsed
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "to must not be before from")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if _, err := export.NewFormat(format); err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "format must be geojson, gpx or kml")
+		return
+	}
+
+	q := &query.ExportVehicleTrackQuery{
+		VehicleID: vehicleID,
+		From:      from,
+		To:        to,
+		Format:    format,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to export vehicle track",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to export vehicle track")
+		return
+	}
+	track := result.(*export.Track)
+
+	w.Header().Set("Content-Type", track.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, vehicleID, track.Format.Extension()))
+	w.Header().Set("X-Track-Resolution", track.Resolution)
+
+	out := &startedWriter{ResponseWriter: w, controller: http.NewResponseController(w)}
+	if err := track.Write(out); err != nil {
+		h.logger.Error("failed to write vehicle track",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		if !out.started {
+			w.Header().Del("Content-Disposition")
+			handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to export vehicle track")
+		}
+	}
+}
+
+// startedWriter records whether any of the response has been sent, after
+// which an error can no longer change the status. Each write gets
+// exportChunkTimeout to complete, so a long export is only cut off when the
+// client stops reading.
+type startedWriter struct {
+	http.ResponseWriter
+	controller *http.ResponseController
+	started    bool
+}
+
+func (w *startedWriter) Write(p []byte) (int, error) {
+	w.started = true
+	// Writers without deadlines, such as httptest's recorder, need none.
+	_ = w.controller.SetWriteDeadline(time.Now().Add(exportChunkTimeout))
+	return w.ResponseWriter.Write(p)
+}
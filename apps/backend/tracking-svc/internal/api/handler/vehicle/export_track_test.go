@@ -0,0 +1,82 @@
+package vehicle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/export"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/messaging"
+)
+
+// slowTrackHandler serves a large track that takes a while to read, like a
+// long range streamed from the database.
+type slowTrackHandler struct {
+	points int
+	pause  time.Duration // after every 1000 points
+}
+
+func (h *slowTrackHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &export.Track{
+		VehicleID:  "vehicle-1",
+		Name:       "Truck",
+		Format:     export.FormatGeoJSON,
+		Resolution: "raw",
+		Each: func(fn func(export.Point) error) error {
+			for i := 0; i < h.points; i++ {
+				if i%1000 == 0 {
+					time.Sleep(h.pause)
+				}
+				point := export.Point{Latitude: 10, Longitude: 20 + float64(i)/1e6, Time: start.Add(time.Duration(i) * time.Second)}
+				if err := fn(point); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func TestExportTrack_OutlastsServerWriteTimeout(t *testing.T) {
+	const points = 20000
+	queryBus := messaging.NewInMemoryQueryBus()
+	queryBus.Register((&query.ExportVehicleTrackQuery{}).QueryName(), &slowTrackHandler{points: points, pause: 15 * time.Millisecond})
+	h := InitVehicleHandler(messaging.NewInMemoryCommandBus(), queryBus, zap.NewNop())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/track", h.ExportTrack)
+	server := httptest.NewUnstartedServer(mux)
+	// Both passes over the track take about 600ms.
+	server.Config.WriteTimeout = 200 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/api/v1/vehicles/vehicle-1/track?format=geojson")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var collection struct {
+		Features []struct {
+			Geometry struct {
+				Coordinates [][]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties struct {
+				CoordTimes []string `json:"coordTimes"`
+			} `json:"properties"`
+		} `json:"features"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&collection))
+	require.Len(t, collection.Features, 1)
+	assert.Len(t, collection.Features[0].Geometry.Coordinates, points)
+	assert.Len(t, collection.Features[0].Properties.CoordTimes, points)
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
 )
 
@@ -32,6 +33,7 @@ func (h *VehicleHandler) GetAllVehicles(w http.ResponseWriter, r *http.Request)
 	q := &query.GetAllVehiclesQuery{
 		Limit:  limit,
 		Offset: offset,
+		Units:  string(middleware.GetUnitsFromContext(r)),
 	}
 
 	result, err := h.queryBus.Dispatch(ctx, q)
@@ -1,6 +1,7 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -34,13 +35,26 @@ func (v *VehicleHandler) GetChangeHistory(w http.ResponseWriter, r *http.Request
 		}
 	}
 
+	from, to, resolution, err := parseLocationRange(r)
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
 	q := &query.GetVehicleChangeHistoryQuery{
-		VehicleID: vehicleID,
-		Limit:     limit,
-		Offset:    offset,
+		VehicleID:  vehicleID,
+		Limit:      limit,
+		Offset:     offset,
+		From:       from,
+		To:         to,
+		Resolution: resolution,
 	}
 
 	result, err := v.queryBus.Dispatch(r.Context(), q)
+	if errors.Is(err, query.ErrVehicleNotFound) {
+		handler.RespondError(w, http.StatusNotFound, "VEHICLE_NOT_FOUND", "Vehicle not found")
+		return
+	}
 	if err != nil {
 		v.logger.Error("failed to get vehicle change history",
 			zap.String("vehicleId", vehicleID),
@@ -0,0 +1,34 @@
+package vehicle
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *VehicleHandler) GetGeofences(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	q := &query.GetVehicleGeofencesQuery{
+		VehicleID: vehicleID,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get vehicle geofences",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get vehicle geofences")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
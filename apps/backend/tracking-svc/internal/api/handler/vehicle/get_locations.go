@@ -0,0 +1,80 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// defaultLocationsSpan is how far back location history reaches when no
+// ?from= is given.
+const defaultLocationsSpan = 24 * time.Hour
+
+// GetLocations returns the vehicle's location history from ?from= to ?to=,
+// at ?resolution= or at one picked for the range.
+func (h *VehicleHandler) GetLocations(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	from, to, resolution, err := parseLocationRange(r)
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	q := &query.GetVehicleLocationsQuery{
+		VehicleID:  vehicleID,
+		From:       from,
+		To:         to,
+		Resolution: resolution,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get vehicle locations",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get vehicle locations")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
+
+// parseLocationRange reads ?from=, ?to= and ?resolution= of a location
+// history request. The range defaults to the day up to now.
+func parseLocationRange(r *http.Request) (from, to time.Time, resolution string, err error) {
+	to = time.Now().UTC()
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		if to, err = parseInstant(toStr, "to"); err != nil {
+			return time.Time{}, time.Time{}, "", err
+		}
+	}
+	from = to.Add(-defaultLocationsSpan)
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		if from, err = parseInstant(fromStr, "from"); err != nil {
+			return time.Time{}, time.Time{}, "", err
+		}
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, "", errors.New("to must not be before from")
+	}
+
+	resolution = r.URL.Query().Get("resolution")
+	if resolution != "" {
+		if _, err := valueobject.NewLocationResolution(resolution); err != nil {
+			return time.Time{}, time.Time{}, "", err
+		}
+	}
+	return from, to, resolution, nil
+}
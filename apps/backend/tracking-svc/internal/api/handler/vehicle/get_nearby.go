@@ -0,0 +1,126 @@
+package vehicle
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+// maxSpatialResults caps how many vehicles a nearby or box search returns.
+const maxSpatialResults = 500
+
+// GetNearby lists vehicles within radiusMeters of lat/lng, nearest first.
+func (h *VehicleHandler) GetNearby(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	latitude, err := requiredFloat(params.Get("lat"), "lat")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	longitude, err := requiredFloat(params.Get("lng"), "lng")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	radiusMeters, err := requiredFloat(params.Get("radiusMeters"), "radiusMeters")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	q := &query.GetVehiclesNearQuery{
+		Latitude:     latitude,
+		Longitude:    longitude,
+		RadiusMeters: radiusMeters,
+		Status:       params.Get("status"),
+		Limit:        spatialLimit(params.Get("limit")),
+		Units:        string(middleware.GetUnitsFromContext(r)),
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get nearby vehicles", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "QUERY_FAILED", err.Error())
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, map[string]interface{}{
+		"vehicles": result,
+	})
+}
+
+// GetInBox lists vehicles inside the box minLat/minLng to maxLat/maxLng.
+func (h *VehicleHandler) GetInBox(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	var corners [4]float64
+	for i, name := range []string{"minLat", "minLng", "maxLat", "maxLng"} {
+		value, err := requiredFloat(params.Get(name), name)
+		if err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		corners[i] = value
+	}
+
+	limit := spatialLimit(params.Get("limit"))
+	offset := 0
+	if offsetStr := params.Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	q := &query.GetVehiclesInBoxQuery{
+		MinLatitude:  corners[0],
+		MinLongitude: corners[1],
+		MaxLatitude:  corners[2],
+		MaxLongitude: corners[3],
+		Status:       params.Get("status"),
+		Limit:        limit,
+		Offset:       offset,
+		Units:        string(middleware.GetUnitsFromContext(r)),
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get vehicles in box", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "QUERY_FAILED", err.Error())
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, map[string]interface{}{
+		"vehicles": result,
+		"limit":    limit,
+		"offset":   offset,
+	})
+}
+
+func requiredFloat(value, name string) (float64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("%s is required", name)
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", name)
+	}
+	return parsed, nil
+}
+
+func spatialLimit(value string) int {
+	limit := 50
+	if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+		limit = parsed
+	}
+	if limit > maxSpatialResults {
+		limit = maxSpatialResults
+	}
+	return limit
+}
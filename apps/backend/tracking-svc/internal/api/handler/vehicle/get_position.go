@@ -0,0 +1,126 @@
+package vehicle
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+// defaultPlaybackIntervalSeconds is the frame spacing when none is given.
+const defaultPlaybackIntervalSeconds = 10
+
+// GetPosition estimates where the vehicle was at the instant ?at=.
+func (h *VehicleHandler) GetPosition(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	at, err := parseInstant(r.URL.Query().Get("at"), "at")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	q := &query.GetVehiclePositionQuery{
+		VehicleID: vehicleID,
+		At:        at,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if errors.Is(err, query.ErrNoRecordedPosition) {
+		handler.RespondError(w, http.StatusNotFound, "POSITION_NOT_FOUND", err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to get vehicle position",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get vehicle position")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
+
+// GetPlayback returns positions every ?intervalSeconds= from ?from= to ?to=
+// for replaying the vehicle's movement.
+func (h *VehicleHandler) GetPlayback(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	from, err := parseInstant(r.URL.Query().Get("from"), "from")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	to, err := parseInstant(r.URL.Query().Get("to"), "to")
+	if err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	if to.Before(from) {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "to must not be before from")
+		return
+	}
+
+	interval := int64(defaultPlaybackIntervalSeconds)
+	if intervalStr := r.URL.Query().Get("intervalSeconds"); intervalStr != "" {
+		parsed, err := strconv.ParseInt(intervalStr, 10, 64)
+		if err != nil || parsed <= 0 {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "intervalSeconds must be a positive integer")
+			return
+		}
+		interval = parsed
+	}
+	if (to.Unix()-from.Unix())/interval+1 > query.MaxPlaybackFrames {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST",
+			fmt.Sprintf("playback is limited to %d frames; use a longer intervalSeconds", query.MaxPlaybackFrames))
+		return
+	}
+
+	q := &query.GetVehiclePlaybackQuery{
+		VehicleID:       vehicleID,
+		From:            from,
+		To:              to,
+		IntervalSeconds: interval,
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get vehicle playback",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get vehicle playback")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
+
+// parseInstant reads a required time given as RFC3339 or unix seconds.
+func parseInstant(value, name string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%s is required", name)
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unix, 0).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("%s must be an RFC3339 timestamp or unix seconds", name)
+}
@@ -0,0 +1,79 @@
+package vehicle
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+)
+
+func (h *VehicleHandler) GetTrips(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	var from, to time.Time
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		parsed, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "from must be an RFC3339 timestamp")
+			return
+		}
+		from = parsed
+	}
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		parsed, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "to must be an RFC3339 timestamp")
+			return
+		}
+		to = parsed
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "to must not be before from")
+		return
+	}
+
+	limit := 50
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	q := &query.GetVehicleTripsQuery{
+		VehicleID: vehicleID,
+		From:      from,
+		To:        to,
+		Limit:     limit,
+		Offset:    offset,
+		Units:     string(middleware.GetUnitsFromContext(r)),
+	}
+
+	result, err := h.queryBus.Dispatch(r.Context(), q)
+	if err != nil {
+		h.logger.Error("failed to get vehicle trips",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err),
+		)
+		handler.RespondError(w, http.StatusInternalServerError, "QUERY_FAILED", "failed to get vehicle trips")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
 )
 
@@ -20,6 +21,7 @@ func (h *VehicleHandler) GetVehicle(w http.ResponseWriter, r *http.Request) {
 
 	q := &query.GetVehicleQuery{
 		VehicleID: vehicleID,
+		Units:     string(middleware.GetUnitsFromContext(r)),
 	}
 
 	result, err := h.queryBus.Dispatch(ctx, q)
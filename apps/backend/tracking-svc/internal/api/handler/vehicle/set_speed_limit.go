@@ -0,0 +1,44 @@
+package vehicle
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+)
+
+func (h *VehicleHandler) SetSpeedLimit(w http.ResponseWriter, r *http.Request) {
+	vehicleID := r.PathValue("id")
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "vehicle id is required")
+		return
+	}
+
+	var req dto.SpeedLimitRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode speed limit request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.SetVehicleSpeedLimitCommand{
+		VehicleID:     vehicleID,
+		SpeedLimitKmh: req.SpeedLimitKmh,
+	}
+
+	if err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		h.logger.Error("failed to set vehicle speed limit",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("vehicle speed limit set", zap.String("vehicleId", vehicleID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "speed limit set successfully",
+	})
+}
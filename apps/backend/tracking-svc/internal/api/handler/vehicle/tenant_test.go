@@ -0,0 +1,182 @@
+package vehicle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/service"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/messaging"
+)
+
+// tenantVehicleRepo keeps projections in memory and, like the Mongo
+// repository, only sees those of the tenant in the context.
+type tenantVehicleRepo struct {
+	repository.VehicleRepository
+	vehicles []*entity.Vehicle
+}
+
+func (r *tenantVehicleRepo) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
+	for _, v := range r.vehicles {
+		if v.ID() == id && v.TenantID() == tenant.ID(ctx) {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", repository.ErrVehicleNotFound, id)
+}
+
+func (r *tenantVehicleRepo) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error) {
+	var found []*entity.Vehicle
+	for _, v := range r.vehicles {
+		if v.TenantID() == tenant.ID(ctx) {
+			found = append(found, v)
+		}
+	}
+	return found, nil
+}
+
+// tenantHistoryRepo keeps change history in memory, scoped like
+// tenantVehicleRepo.
+type tenantHistoryRepo struct {
+	repository.VehicleChangeHistoryRepository
+	histories []*entity.VehicleChangeHistory
+}
+
+func (r *tenantHistoryRepo) FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error) {
+	var found []*entity.VehicleChangeHistory
+	for _, h := range r.histories {
+		if h.VehicleID == vehicleID && h.TenantID == tenant.ID(ctx) {
+			found = append(found, h)
+		}
+	}
+	return found, nil
+}
+
+// emptyLocationRepo has no location points.
+type emptyLocationRepo struct {
+	repository.LocationPointRepository
+}
+
+func (r *emptyLocationRepo) EachBetween(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	return nil
+}
+
+func (r *emptyLocationRepo) EachArchivedBetween(ctx context.Context, vehicleID string, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	return nil
+}
+
+func newTenantVehicle(t *testing.T, tenantID, refID string) *entity.Vehicle {
+	t.Helper()
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 20, 0, 0)
+	require.NoError(t, err)
+	mileage, err := valueobject.NewMileage(0)
+	require.NoError(t, err)
+	fuel, err := valueobject.NewFuelLevel(50)
+	require.NoError(t, err)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), tenantID, refID, "1HGBH41JXMN109186",
+		"Truck", "Model", license, valueobject.StatusActive, location, mileage, fuel, valueobject.EnergyICE, 0, nil)
+	require.NoError(t, err)
+	return vehicle
+}
+
+// newTenantHandler serves one vehicle of each of fleet-a and fleet-b, both
+// with change history, through the real query handlers.
+func newTenantHandler(t *testing.T) (h *VehicleHandler, fleetA, fleetB *entity.Vehicle) {
+	t.Helper()
+	fleetA = newTenantVehicle(t, "fleet-a", "vehicle-a")
+	fleetB = newTenantVehicle(t, "fleet-b", "vehicle-b")
+	vehicleRepo := &tenantVehicleRepo{vehicles: []*entity.Vehicle{fleetA, fleetB}}
+	historyRepo := &tenantHistoryRepo{histories: []*entity.VehicleChangeHistory{
+		entity.NewVehicleChangeHistory("fleet-a", "vehicle-a", "1HGBH41JXMN109186", "", "status_changed", nil, nil, 2),
+		entity.NewVehicleChangeHistory("fleet-b", "vehicle-b", "1HGBH41JXMN109186", "", "status_changed", nil, nil, 2),
+	}}
+
+	queryBus := messaging.NewInMemoryQueryBus()
+	queryBus.Register((&query.GetVehicleQuery{}).QueryName(), service.NewGetVehicleQueryHandler(vehicleRepo))
+	queryBus.Register((&query.GetAllVehiclesQuery{}).QueryName(), service.NewGetAllVehiclesQueryHandler(vehicleRepo))
+	queryBus.Register((&query.GetVehicleChangeHistoryQuery{}).QueryName(),
+		service.NewGetVehicleChangeHistoryQueryHandler(historyRepo, vehicleRepo, &emptyLocationRepo{},
+			valueobject.LocationRetention{Raw: 7 * 24 * time.Hour, Minute: 90 * 24 * time.Hour}))
+
+	return InitVehicleHandler(messaging.NewInMemoryCommandBus(), queryBus, zap.NewNop()), fleetA, fleetB
+}
+
+func requestAs(tenantID, target, id string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if id != "" {
+		req.SetPathValue("id", id)
+	}
+	return req.WithContext(tenant.WithID(req.Context(), tenantID))
+}
+
+func TestGetVehicle_OtherTenantGetsNotFound(t *testing.T) {
+	h, fleetA, _ := newTenantHandler(t)
+	id := fleetA.ID().String()
+
+	w := httptest.NewRecorder()
+	h.GetVehicle(w, requestAs("fleet-a", "/api/v1/vehicles/"+id, id))
+	require.Equal(t, http.StatusOK, w.Code)
+	var found dto.VehicleResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&found))
+	assert.Equal(t, id, found.ID)
+
+	w = httptest.NewRecorder()
+	h.GetVehicle(w, requestAs("fleet-b", "/api/v1/vehicles/"+id, id))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetAllVehicles_ListsOnlyOwnTenant(t *testing.T) {
+	h, fleetA, fleetB := newTenantHandler(t)
+
+	list := func(tenantID string) []dto.VehicleResponse {
+		w := httptest.NewRecorder()
+		h.GetAllVehicles(w, requestAs(tenantID, "/api/v1/vehicles", ""))
+		require.Equal(t, http.StatusOK, w.Code)
+		var body struct {
+			Vehicles []dto.VehicleResponse `json:"vehicles"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+		return body.Vehicles
+	}
+
+	listA := list("fleet-a")
+	require.Len(t, listA, 1)
+	assert.Equal(t, fleetA.ID().String(), listA[0].ID)
+	listB := list("fleet-b")
+	require.Len(t, listB, 1)
+	assert.Equal(t, fleetB.ID().String(), listB[0].ID)
+	assert.Empty(t, list("fleet-c"))
+}
+
+func TestGetChangeHistory_OtherTenantGetsNotFound(t *testing.T) {
+	h, fleetA, _ := newTenantHandler(t)
+	id := fleetA.ID().String()
+
+	w := httptest.NewRecorder()
+	h.GetChangeHistory(w, requestAs("fleet-a", "/api/v1/vehicles/"+id+"/history", id))
+	require.Equal(t, http.StatusOK, w.Code)
+	var history dto.VehicleChangeHistoryResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&history))
+	require.Len(t, history.Changes, 1)
+	assert.Equal(t, "vehicle-a", history.Changes[0].VehicleID)
+
+	w = httptest.NewRecorder()
+	h.GetChangeHistory(w, requestAs("fleet-b", "/api/v1/vehicles/"+id+"/history", id))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/resilience"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/security"
 )
@@ -19,7 +21,10 @@ var (
 
 type ctxKey string
 
-const ClaimsContextKey ctxKey = "claims"
+const (
+	ClaimsContextKey ctxKey = "claims"
+	UnitsContextKey  ctxKey = "units"
+)
 
 type responseWriter struct {
 	http.ResponseWriter
@@ -116,6 +121,96 @@ func AuthMiddleware(requiredRole string) func(http.Handler) http.Handler {
 	}
 }
 
+// TenantMiddleware scopes every request to the tenant of its bearer token and
+// rejects requests without a valid one. With allowAnonymous, requests without
+// a token are scoped to the default tenant instead, for callers that predate
+// tenants; a token that does not validate is still rejected. Health checks
+// need no token.
+func TenantMiddleware(allowAnonymous bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/health" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" {
+				if !allowAnonymous {
+					http.Error(w, `{"message":"missing authorization header"}`, http.StatusUnauthorized)
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(tenant.WithID(r.Context(), tenant.DefaultID)))
+				return
+			}
+
+			parts := strings.SplitN(authHeader, " ", 2)
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				http.Error(w, `{"message":"invalid authorization header format"}`, http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := security.ValidateToken(parts[1])
+			if err != nil {
+				log.Printf("Token validation failed: %v", err)
+				http.Error(w, `{"message":"invalid or expired token"}`, http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(tenant.WithID(r.Context(), claims.TenantID)))
+		})
+	}
+}
+
+// UnitsMiddleware picks the unit system distances and volumes are read and
+// written in: the units query parameter, then the Accept-Units header, then
+// the unit preference saved on the caller's account, then metric. The choice
+// is echoed in the Content-Units response header.
+func UnitsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := r.URL.Query().Get("units")
+		if requested == "" {
+			requested = r.Header.Get("Accept-Units")
+		}
+
+		units, err := valueobject.NewUnitSystem(requested)
+		if err != nil {
+			http.Error(w, `{"message":"units must be metric or imperial"}`, http.StatusBadRequest)
+			return
+		}
+		if requested == "" {
+			// A stale preference in an old token is not the caller's fault.
+			if preferred, err := valueobject.NewUnitSystem(tokenUnits(r)); err == nil {
+				units = preferred
+			}
+		}
+
+		w.Header().Set("Content-Units", string(units))
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), UnitsContextKey, units)))
+	})
+}
+
+// GetUnitsFromContext returns metric outside UnitsMiddleware.
+func GetUnitsFromContext(r *http.Request) valueobject.UnitSystem {
+	units, ok := r.Context().Value(UnitsContextKey).(valueobject.UnitSystem)
+	if !ok {
+		return valueobject.UnitsMetric
+	}
+	return units
+}
+
+func tokenUnits(r *http.Request) string {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ""
+	}
+	claims, err := security.ValidateToken(parts[1])
+	if err != nil {
+		return ""
+	}
+	return claims.Units
+}
+
 func GetClaimsFromContext(r *http.Request) *security.Claims {
 	claims, ok := r.Context().Value(ClaimsContextKey).(*security.Claims)
 	if !ok {
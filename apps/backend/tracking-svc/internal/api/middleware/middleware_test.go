@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/security"
+)
+
+func signedToken(t *testing.T, tenantID string) string {
+	t.Helper()
+	claims := security.Claims{
+		UserID:   "user@acme.com",
+		Role:     "operator",
+		TenantID: tenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(security.JWTSecret))
+	require.NoError(t, err)
+	return token
+}
+
+// serveTenant runs a request through TenantMiddleware and returns the status
+// and the tenant the handler saw, empty when it was not reached.
+func serveTenant(allowAnonymous bool, path, authorization string) (int, string) {
+	var seen string
+	handler := TenantMiddleware(allowAnonymous)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = tenant.ID(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder.Code, seen
+}
+
+func TestTenantMiddlewareScopesToTokenTenant(t *testing.T) {
+	status, seen := serveTenant(false, "/api/v1/vehicles", "Bearer "+signedToken(t, "acme"))
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "acme", seen)
+}
+
+func TestTenantMiddlewareRejectsMissingToken(t *testing.T) {
+	status, seen := serveTenant(false, "/api/v1/vehicles", "")
+
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Empty(t, seen)
+}
+
+func TestTenantMiddlewareRejectsInvalidToken(t *testing.T) {
+	for _, allowAnonymous := range []bool{false, true} {
+		status, seen := serveTenant(allowAnonymous, "/api/v1/vehicles", "Bearer not-a-token")
+
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Empty(t, seen)
+	}
+}
+
+func TestTenantMiddlewareAnonymousUsesDefaultTenantWhenAllowed(t *testing.T) {
+	status, seen := serveTenant(true, "/api/v1/vehicles", "")
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, tenant.DefaultID, seen)
+}
+
+func TestTenantMiddlewareLeavesHealthOpen(t *testing.T) {
+	status, _ := serveTenant(false, "/health", "")
+
+	assert.Equal(t, http.StatusOK, status)
+}
@@ -5,6 +5,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler/driver"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler/fuel"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler/geofence"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/handler/vehicle"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
@@ -18,13 +21,39 @@ func RegisterRoutes(
 	logger *zap.Logger,
 ) {
 	h := vehicle.InitVehicleHandler(commandBus, queryBus, logger)
+	dh := driver.InitDriverHandler(queryBus, logger)
+	gh := geofence.InitGeofenceHandler(commandBus, queryBus, logger)
+	fh := fuel.InitFuelHandler(commandBus, queryBus, logger)
 	authMiddleware := middleware.AuthMiddleware("")
 
 	mux.HandleFunc("GET /health", healthCheck)
 
 	mux.HandleFunc("GET /api/v1/vehicles", h.GetAllVehicles)
+	mux.HandleFunc("GET /api/v1/vehicles/near", h.GetNearby)
+	mux.HandleFunc("GET /api/v1/vehicles/in-box", h.GetInBox)
 	mux.HandleFunc("GET /api/v1/vehicles/{id}", h.GetVehicle)
 	mux.HandleFunc("GET /api/v1/vehicles/{id}/history", h.GetChangeHistory)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/geofences", h.GetGeofences)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/speed", h.GetSpeed)
+	mux.HandleFunc("PUT /api/v1/vehicles/{id}/speed-limit", h.SetSpeedLimit)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/locations", h.GetLocations)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/track", h.ExportTrack)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/position", h.GetPosition)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/playback", h.GetPlayback)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/trips", h.GetTrips)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/refuels", h.GetRefuels)
+	mux.HandleFunc("GET /api/v1/drivers/{id}/history", dh.GetChangeHistory)
+
+	mux.HandleFunc("POST /api/v1/geofences", gh.CreateGeofence)
+	mux.HandleFunc("GET /api/v1/geofences", gh.GetAllGeofences)
+	mux.HandleFunc("GET /api/v1/geofences/{id}", gh.GetGeofence)
+	mux.HandleFunc("PUT /api/v1/geofences/{id}", gh.UpdateGeofence)
+	mux.HandleFunc("DELETE /api/v1/geofences/{id}", gh.DeleteGeofence)
+
+	mux.HandleFunc("GET /api/v1/fuel-profiles", fh.GetAllFuelProfiles)
+	mux.HandleFunc("GET /api/v1/fuel-profiles/{model}", fh.GetFuelProfile)
+	mux.HandleFunc("PUT /api/v1/fuel-profiles/{model}", fh.UpsertFuelProfile)
+	mux.HandleFunc("DELETE /api/v1/fuel-profiles/{model}", fh.DeleteFuelProfile)
 
 	adminMux := http.NewServeMux()
 	adminMux.HandleFunc("GET /api/v1/admin/vehicles", h.GetAllVehicles)
@@ -0,0 +1,12 @@
+package command
+
+import "time"
+
+type ArchiveVehicleCommand struct {
+	VehicleID  string // vehicle-svc vehicle id
+	ArchivedAt time.Time
+}
+
+func (c *ArchiveVehicleCommand) CommandName() string {
+	return "ArchiveVehicle"
+}
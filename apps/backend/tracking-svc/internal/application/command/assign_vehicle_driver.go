@@ -0,0 +1,10 @@
+package command
+
+type AssignVehicleDriverCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	DriverID  string
+}
+
+func (c *AssignVehicleDriverCommand) CommandName() string {
+	return "AssignVehicleDriver"
+}
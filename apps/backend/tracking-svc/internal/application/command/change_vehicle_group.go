@@ -0,0 +1,10 @@
+package command
+
+type ChangeVehicleGroupCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Group     string // empty when the vehicle left its group
+}
+
+func (c *ChangeVehicleGroupCommand) CommandName() string {
+	return "ChangeVehicleGroup"
+}
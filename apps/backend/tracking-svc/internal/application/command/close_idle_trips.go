@@ -0,0 +1,9 @@
+package command
+
+// CloseIdleTripsCommand ends trips whose vehicle stopped reporting before the
+// stationary period elapsed, since no further fix will arrive to close them.
+type CloseIdleTripsCommand struct{}
+
+func (c *CloseIdleTripsCommand) CommandName() string {
+	return "CloseIdleTrips"
+}
@@ -0,0 +1,14 @@
+package command
+
+// CorrectFuelReadingCommand applies an operator correction from vehicle-svc
+// to the fuel state. Field is "mileage" or "fuel_level".
+type CorrectFuelReadingCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Field     string
+	Value     float64
+	Timestamp int64
+}
+
+func (c *CorrectFuelReadingCommand) CommandName() string {
+	return "CorrectFuelReading"
+}
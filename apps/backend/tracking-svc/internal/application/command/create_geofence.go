@@ -0,0 +1,21 @@
+package command
+
+type GeofencePoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type CreateGeofenceCommand struct {
+	GeofenceID    string
+	Name          string
+	Category      string
+	Shape         string // circle, polygon
+	Center        GeofencePoint
+	RadiusMeters  float64
+	Polygon       []GeofencePoint
+	SpeedLimitKmh float64 // 0 for none
+}
+
+func (c *CreateGeofenceCommand) CommandName() string {
+	return "CreateGeofence"
+}
@@ -1,17 +1,20 @@
 package command
 
 type CreateVehicleCommand struct {
-	RefID         string
-	VIN           string
-	VehicleName   string
-	VehicleModel  string
-	LicenseNumber string
-	Status        string
-	Latitude      float64
-	Longitude     float64
-	Altitude      float64
-	Mileage       float64
-	FuelLevel     float64
+	RefID          string
+	VIN            string
+	VehicleName    string
+	VehicleModel   string
+	LicenseNumber  string
+	Status         string
+	Latitude       float64
+	Longitude      float64
+	Altitude       float64
+	Mileage        float64
+	FuelLevel      float64
+	EnergyType     string
+	EnergyCapacity float64
+	Attributes     map[string]interface{}
 }
 
 func (c *CreateVehicleCommand) CommandName() string {
@@ -0,0 +1,9 @@
+package command
+
+type DeleteFuelProfileCommand struct {
+	VehicleModel string
+}
+
+func (c *DeleteFuelProfileCommand) CommandName() string {
+	return "DeleteFuelProfile"
+}
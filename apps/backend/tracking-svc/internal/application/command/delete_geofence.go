@@ -0,0 +1,9 @@
+package command
+
+type DeleteGeofenceCommand struct {
+	GeofenceID string
+}
+
+func (c *DeleteGeofenceCommand) CommandName() string {
+	return "DeleteGeofence"
+}
@@ -0,0 +1,12 @@
+package command
+
+type EvaluateFuelLevelCommand struct {
+	VehicleID  string  // vehicle-svc vehicle id
+	FuelLevel  float64 // state of charge for a BEV
+	EnergyType string  // empty means ice
+	Timestamp  int64
+}
+
+func (c *EvaluateFuelLevelCommand) CommandName() string {
+	return "EvaluateFuelLevel"
+}
@@ -0,0 +1,13 @@
+package command
+
+type EvaluateSpeedCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Latitude  float64
+	Longitude float64
+	Timestamp int64
+	SpeedKmh  *float64 // nil when the device did not report it
+}
+
+func (c *EvaluateSpeedCommand) CommandName() string {
+	return "EvaluateSpeed"
+}
@@ -0,0 +1,10 @@
+package command
+
+type RecordFuelMileageCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Mileage   float64
+}
+
+func (c *RecordFuelMileageCommand) CommandName() string {
+	return "RecordFuelMileage"
+}
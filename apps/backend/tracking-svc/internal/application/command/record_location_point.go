@@ -0,0 +1,16 @@
+package command
+
+type RecordLocationPointCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	Timestamp int64 // device time, unix seconds
+	SpeedKmh  *float64
+	Heading   *float64
+	Archived  bool // out of order: the vehicle already had a newer position
+}
+
+func (c *RecordLocationPointCommand) CommandName() string {
+	return "RecordLocationPoint"
+}
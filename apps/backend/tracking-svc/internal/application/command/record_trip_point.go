@@ -0,0 +1,12 @@
+package command
+
+type RecordTripPointCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Latitude  float64
+	Longitude float64
+	Timestamp int64
+}
+
+func (c *RecordTripPointCommand) CommandName() string {
+	return "RecordTripPoint"
+}
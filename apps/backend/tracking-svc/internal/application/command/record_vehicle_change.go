@@ -3,7 +3,8 @@ package command
 type RecordVehicleChangeCommand struct {
 	VehicleID  string
 	VIN        string
-	ChangeType string                       // created, location_updated, status_changed, mileage_updated, fuel_updated
+	DriverID   string // optional, resolved from the vehicle projection when empty
+	ChangeType string // created, status_changed, mileage_updated, fuel_updated, driver_assigned, driver_unassigned, device_paired, device_unpaired
 	OldValue   map[string]interface{}
 	NewValue   map[string]interface{}
 	Version    int64
@@ -0,0 +1,10 @@
+package command
+
+type ReplaceVehicleTagsCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	Tags      []string
+}
+
+func (c *ReplaceVehicleTagsCommand) CommandName() string {
+	return "ReplaceVehicleTags"
+}
@@ -0,0 +1,9 @@
+package command
+
+// RollUpLocationsCommand folds the location points saved since the last pass
+// into the minute and hour rollups.
+type RollUpLocationsCommand struct{}
+
+func (c *RollUpLocationsCommand) CommandName() string {
+	return "RollUpLocations"
+}
@@ -0,0 +1,10 @@
+package command
+
+type SetVehicleSpeedLimitCommand struct {
+	VehicleID     string
+	SpeedLimitKmh float64 // 0 removes the limit
+}
+
+func (c *SetVehicleSpeedLimitCommand) CommandName() string {
+	return "SetVehicleSpeedLimit"
+}
@@ -0,0 +1,9 @@
+package command
+
+type UnarchiveVehicleCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+}
+
+func (c *UnarchiveVehicleCommand) CommandName() string {
+	return "UnarchiveVehicle"
+}
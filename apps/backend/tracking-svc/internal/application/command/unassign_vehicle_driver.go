@@ -0,0 +1,10 @@
+package command
+
+type UnassignVehicleDriverCommand struct {
+	VehicleID string // vehicle-svc vehicle id
+	DriverID  string
+}
+
+func (c *UnassignVehicleDriverCommand) CommandName() string {
+	return "UnassignVehicleDriver"
+}
@@ -0,0 +1,16 @@
+package command
+
+type UpdateGeofenceCommand struct {
+	GeofenceID    string
+	Name          string
+	Category      string
+	Shape         string
+	Center        GeofencePoint
+	RadiusMeters  float64
+	Polygon       []GeofencePoint
+	SpeedLimitKmh float64 // 0 for none
+}
+
+func (c *UpdateGeofenceCommand) CommandName() string {
+	return "UpdateGeofence"
+}
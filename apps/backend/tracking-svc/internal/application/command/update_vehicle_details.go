@@ -0,0 +1,15 @@
+package command
+
+// UpdateVehicleDetailsCommand leaves empty fields untouched. Attributes holds
+// only the changed custom attributes; a nil value removes one.
+type UpdateVehicleDetailsCommand struct {
+	VehicleID     string // vehicle-svc vehicle id
+	VehicleName   string
+	VehicleModel  string
+	LicenseNumber string
+	Attributes    map[string]interface{}
+}
+
+func (c *UpdateVehicleDetailsCommand) CommandName() string {
+	return "UpdateVehicleDetails"
+}
@@ -0,0 +1,12 @@
+package command
+
+type UpdateVehicleEnergyLevelCommand struct {
+	VehicleID        string // vehicle-svc vehicle id
+	Level            float64
+	ChargingState    string
+	EstimatedRangeKm *float64
+}
+
+func (c *UpdateVehicleEnergyLevelCommand) CommandName() string {
+	return "UpdateVehicleEnergyLevel"
+}
@@ -0,0 +1,15 @@
+package command
+
+type UpsertFuelProfileCommand struct {
+	VehicleModel              string
+	TankCapacityLiters        float64
+	RefuelThresholdPercent    float64
+	DropThresholdPercent      float64
+	ConsumptionLitersPer100Km float64
+	ConsumptionTolerance      float64
+	LowLevelPercent           float64
+}
+
+func (c *UpsertFuelProfileCommand) CommandName() string {
+	return "UpsertFuelProfile"
+}
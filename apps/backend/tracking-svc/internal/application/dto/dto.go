@@ -60,21 +60,38 @@ type ChangeVehicleStatusResponse struct {
 }
 
 type VehicleResponse struct {
-	ID            string    `json:"id"`
-	RefID         string    `json:"refId"`
-	VIN           string    `json:"vin"`
-	VehicleName   string    `json:"vehicleName"`
-	VehicleModel  string    `json:"vehicleModel"`
-	LicenseNumber string    `json:"licenseNumber"`
-	Status        string    `json:"status"`
-	Latitude      float64   `json:"latitude"`
-	Longitude     float64   `json:"longitude"`
-	Altitude      float64   `json:"altitude"`
-	Mileage       float64   `json:"mileage"`
-	FuelLevel     float64   `json:"fuelLevel"`
-	Version       int64     `json:"version"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID             string                 `json:"id"`
+	RefID          string                 `json:"refId"`
+	VIN            string                 `json:"vin"`
+	VehicleName    string                 `json:"vehicleName"`
+	VehicleModel   string                 `json:"vehicleModel"`
+	LicenseNumber  string                 `json:"licenseNumber"`
+	Status         string                 `json:"status"`
+	Latitude       float64                `json:"latitude"`
+	Longitude      float64                `json:"longitude"`
+	Altitude       float64                `json:"altitude"`
+	Mileage        float64                `json:"mileage"`
+	MileageUnit    string                 `json:"mileageUnit"` // km or mi
+	FuelLevel      float64                `json:"fuelLevel"`   // battery state of charge for a bev
+	EnergyType     string                 `json:"energyType"`
+	EnergyCapacity float64                `json:"energyCapacity,omitempty"`
+	CapacityUnit   string                 `json:"capacityUnit"` // L, gal or kWh
+	ChargingState  string                 `json:"chargingState,omitempty"`
+	EstimatedRange *float64               `json:"estimatedRange,omitempty"` // in MileageUnit
+	DriverID       string                 `json:"currentDriverId,omitempty"`
+	Version        int64                  `json:"version"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	ArchivedAt     *time.Time             `json:"archivedAt,omitempty"`
+	Group          string                 `json:"group,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Attributes     map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// NearbyVehicleResponse is a vehicle found by a nearby search.
+type NearbyVehicleResponse struct {
+	*VehicleResponse
+	DistanceMeters float64 `json:"distanceMeters"`
 }
 
 type UpdateVehicleMileageRequest struct {
@@ -110,15 +127,192 @@ type VehicleChangeHistoryResponse struct {
 	VehicleID string                `json:"vehicleId"`
 	Changes   []VehicleChangeRecord `json:"changes"`
 	Total     int                   `json:"total"`
+	// Locations is the track over the requested range, at a resolution
+	// picked for it. ArchivedLocations are the out-of-order positions
+	// recorded in the range, which are not on the track.
+	Locations         *LocationHistoryResponse `json:"locations"`
+	ArchivedLocations []*LocationPointResponse `json:"archivedLocations"`
+}
+
+type DriverChangeHistoryResponse struct {
+	DriverID string                `json:"driverId"`
+	Changes  []VehicleChangeRecord `json:"changes"`
+	Total    int                   `json:"total"`
 }
 
 type VehicleChangeRecord struct {
 	ID         string                 `json:"id"`
 	VehicleID  string                 `json:"vehicleId"`
 	VIN        string                 `json:"vin"`
+	DriverID   string                 `json:"driverId,omitempty"`
 	ChangeType string                 `json:"changeType"`
 	OldValue   map[string]interface{} `json:"oldValue"`
 	NewValue   map[string]interface{} `json:"newValue"`
 	ChangedAt  string                 `json:"changedAt"`
 	Version    int64                  `json:"version"`
 }
+
+type CoordinateDTO struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type GeofenceRequest struct {
+	Name          string          `json:"name"`
+	Category      string          `json:"category"`
+	Shape         string          `json:"shape"`
+	Center        *CoordinateDTO  `json:"center,omitempty"`
+	RadiusMeters  float64         `json:"radiusMeters,omitempty"`
+	Polygon       []CoordinateDTO `json:"polygon,omitempty"`
+	SpeedLimitKmh float64         `json:"speedLimitKmh,omitempty"` // 0 or omitted for none
+}
+
+type GeofenceResponse struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Category      string          `json:"category"`
+	Shape         string          `json:"shape"`
+	Center        *CoordinateDTO  `json:"center,omitempty"`
+	RadiusMeters  float64         `json:"radiusMeters,omitempty"`
+	Polygon       []CoordinateDTO `json:"polygon,omitempty"`
+	SpeedLimitKmh float64         `json:"speedLimitKmh,omitempty"`
+	Version       int64           `json:"version"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+type VehicleGeofencesResponse struct {
+	VehicleID string              `json:"vehicleId"`
+	Geofences []*GeofenceResponse `json:"geofences"`
+	UpdatedAt *time.Time          `json:"updatedAt,omitempty"`
+}
+
+type SpeedLimitRequest struct {
+	SpeedLimitKmh float64 `json:"speedLimitKmh"` // 0 removes the limit
+}
+
+// VehicleSpeedResponse shows the vehicle's own limit; zone limits are on the
+// geofences.
+type VehicleSpeedResponse struct {
+	VehicleID     string     `json:"vehicleId"`
+	SpeedLimitKmh float64    `json:"speedLimitKmh"`
+	Speeding      bool       `json:"speeding"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+type TrackPointDTO struct {
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// PositionResponse is an estimated position. GapSeconds is the span of the
+// fixes it is interpolated between, or how far it is from the fix it is held
+// at.
+type PositionResponse struct {
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	Timestamp  time.Time `json:"timestamp"`
+	Method     string    `json:"method"`     // fix, interpolated, last_known or first_known
+	Confidence string    `json:"confidence"` // exact, high, medium or low
+	GapSeconds int64     `json:"gapSeconds"`
+}
+
+type VehiclePositionResponse struct {
+	VehicleID string `json:"vehicleId"`
+	*PositionResponse
+}
+
+type PlaybackResponse struct {
+	VehicleID       string              `json:"vehicleId"`
+	From            time.Time           `json:"from"`
+	To              time.Time           `json:"to"`
+	IntervalSeconds int64               `json:"intervalSeconds"`
+	Frames          []*PositionResponse `json:"frames"` // empty when the vehicle has no positions
+}
+
+// LocationPointResponse is a recorded position, or at a coarser resolution
+// the last position of its minute or hour. Count is how many recorded points
+// it stands for.
+type LocationPointResponse struct {
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	Altitude    float64   `json:"altitude"`
+	Timestamp   time.Time `json:"timestamp"`
+	SpeedKmh    *float64  `json:"speedKmh,omitempty"`
+	Heading     *float64  `json:"heading,omitempty"`
+	Count       int       `json:"count"`
+	MaxSpeedKmh *float64  `json:"maxSpeedKmh,omitempty"`
+}
+
+type LocationHistoryResponse struct {
+	VehicleID  string                   `json:"vehicleId"`
+	From       time.Time                `json:"from"`
+	To         time.Time                `json:"to"`
+	Resolution string                   `json:"resolution"` // raw, 1m or 1h
+	Points     []*LocationPointResponse `json:"points"`
+	Truncated  bool                     `json:"truncated"` // more points than one response holds
+}
+
+type TripResponse struct {
+	ID              string          `json:"id"`
+	VehicleID       string          `json:"vehicleId"`
+	Status          string          `json:"status"`
+	StartPoint      TrackPointDTO   `json:"startPoint"`
+	EndPoint        TrackPointDTO   `json:"endPoint"`
+	StartedAt       time.Time       `json:"startedAt"`
+	EndedAt         *time.Time      `json:"endedAt,omitempty"`
+	DistanceMeters  float64         `json:"distanceMeters"`
+	Distance        float64         `json:"distance"` // in DistanceUnit
+	DistanceUnit    string          `json:"distanceUnit"`
+	DurationSeconds int64           `json:"durationSeconds"`
+	MaxSpeedKmh     float64         `json:"maxSpeedKmh"`
+	Polyline        []TrackPointDTO `json:"polyline"`
+}
+
+type VehicleTripsResponse struct {
+	VehicleID string          `json:"vehicleId"`
+	Trips     []*TripResponse `json:"trips"`
+	Total     int             `json:"total"`
+}
+
+type FuelProfileRequest struct {
+	TankCapacityLiters        float64  `json:"tankCapacityLiters"`
+	RefuelThresholdPercent    float64  `json:"refuelThresholdPercent"`
+	DropThresholdPercent      float64  `json:"dropThresholdPercent"`
+	ConsumptionLitersPer100Km float64  `json:"consumptionLitersPer100Km"`
+	ConsumptionTolerance      float64  `json:"consumptionTolerance"`
+	LowLevelPercent           *float64 `json:"lowLevelPercent"` // omitted means the default; 0 disables low energy alerts
+}
+
+type FuelProfileResponse struct {
+	VehicleModel              string     `json:"vehicleModel"`
+	TankCapacityLiters        float64    `json:"tankCapacityLiters"`
+	RefuelThresholdPercent    float64    `json:"refuelThresholdPercent"`
+	DropThresholdPercent      float64    `json:"dropThresholdPercent"`
+	ConsumptionLitersPer100Km float64    `json:"consumptionLitersPer100Km"`
+	ConsumptionTolerance      float64    `json:"consumptionTolerance"`
+	LowLevelPercent           float64    `json:"lowLevelPercent"`
+	IsDefault                 bool       `json:"isDefault"`
+	Version                   int64      `json:"version"`
+	UpdatedAt                 *time.Time `json:"updatedAt,omitempty"`
+}
+
+type RefuelResponse struct {
+	ID           string    `json:"id"`
+	VehicleID    string    `json:"vehicleId"`
+	FromLevel    float64   `json:"fromLevel"`
+	ToLevel      float64   `json:"toLevel"`
+	VolumeLiters float64   `json:"volumeLiters"`
+	Volume       float64   `json:"volume"` // in VolumeUnit
+	VolumeUnit   string    `json:"volumeUnit"`
+	Mileage      float64   `json:"mileage"`
+	MileageUnit  string    `json:"mileageUnit"`
+	OccurredAt   time.Time `json:"occurredAt"`
+}
+
+type VehicleRefuelsResponse struct {
+	VehicleID string            `json:"vehicleId"`
+	Refuels   []*RefuelResponse `json:"refuels"`
+	Total     int               `json:"total"`
+}
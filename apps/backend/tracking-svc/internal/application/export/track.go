@@ -0,0 +1,200 @@
+// Package export writes vehicle tracks in formats GIS tools read. Writers
+// stream points straight from their source, so a track of any length is
+// exported in constant memory.
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatGeoJSON Format = "geojson"
+	FormatGPX     Format = "gpx"
+	FormatKML     Format = "kml"
+)
+
+// NewFormat parses a format name; empty means GeoJSON.
+func NewFormat(format string) (Format, error) {
+	f := Format(format)
+	switch f {
+	case "":
+		return FormatGeoJSON, nil
+	case FormatGeoJSON, FormatGPX, FormatKML:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid export format: %s", format)
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGPX:
+		return "application/gpx+xml"
+	case FormatKML:
+		return "application/vnd.google-earth.kml+xml"
+	default:
+		return "application/geo+json"
+	}
+}
+
+func (f Format) Extension() string {
+	return string(f)
+}
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	Time      time.Time
+}
+
+// Track is a vehicle's positions over a time range. Each calls fn for every
+// point, oldest first; it may be called more than once and reads the points
+// afresh each time.
+type Track struct {
+	VehicleID  string
+	Name       string
+	Format     Format
+	Resolution string // raw, 1m or 1h; what the points were read at
+	Each       func(fn func(Point) error) error
+}
+
+// Write encodes the track to w in its format.
+func (t *Track) Write(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+
+	var err error
+	switch t.Format {
+	case FormatGPX:
+		err = t.writeGPX(buf)
+	case FormatKML:
+		err = t.writeKML(buf)
+	default:
+		err = t.writeGeoJSON(buf)
+	}
+	if err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// writeGeoJSON writes a FeatureCollection holding one LineString feature.
+// GeoJSON has no place for times on coordinates, so they follow in the
+// feature's coordTimes property, which QGIS and togeojson both read. That
+// takes a second pass over the points.
+func (t *Track) writeGeoJSON(w *bufio.Writer) error {
+	name, _ := json.Marshal(t.Name)
+	vehicleID, _ := json.Marshal(t.VehicleID)
+
+	var scratch []byte
+	first := true
+	err := t.Each(func(p Point) error {
+		if first {
+			w.WriteString(`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"LineString","coordinates":[`)
+			first = false
+		} else {
+			w.WriteByte(',')
+		}
+		scratch = append(scratch[:0], '[')
+		scratch = strconv.AppendFloat(scratch, p.Longitude, 'f', -1, 64)
+		scratch = append(scratch, ',')
+		scratch = strconv.AppendFloat(scratch, p.Latitude, 'f', -1, 64)
+		scratch = append(scratch, ',')
+		scratch = strconv.AppendFloat(scratch, p.Altitude, 'f', -1, 64)
+		scratch = append(scratch, ']')
+		_, err := w.Write(scratch)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if first {
+		// A LineString needs positions; an empty track has no features.
+		_, err := w.WriteString(`{"type":"FeatureCollection","features":[]}`)
+		return err
+	}
+
+	fmt.Fprintf(w, `]},"properties":{"vehicleId":%s,"name":%s,"coordTimes":[`, vehicleID, name)
+	first = true
+	err = t.Each(func(p Point) error {
+		if !first {
+			w.WriteByte(',')
+		}
+		first = false
+		_, err := fmt.Fprintf(w, `"%s"`, formatTime(p.Time))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.WriteString(`]}}]}`)
+	return err
+}
+
+// writeGPX writes a GPX 1.1 document with one track segment.
+func (t *Track) writeGPX(w *bufio.Writer) error {
+	w.WriteString(xml.Header)
+	w.WriteString(`<gpx version="1.1" creator="mvta tracking-svc" xmlns="http://www.topografix.com/GPX/1/1">` + "\n")
+	w.WriteString("<trk><name>")
+	xml.EscapeText(w, []byte(t.Name))
+	w.WriteString("</name><trkseg>\n")
+
+	err := t.Each(func(p Point) error {
+		_, err := fmt.Fprintf(w, `<trkpt lat="%s" lon="%s"><ele>%s</ele><time>%s</time></trkpt>`+"\n",
+			formatFloat(p.Latitude), formatFloat(p.Longitude), formatFloat(p.Altitude), formatTime(p.Time))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString("</trkseg></trk>\n</gpx>\n")
+	return err
+}
+
+// writeKML writes a gx:Track, which keeps the time of each position. KML
+// lists all times before all coordinates, so the points are read twice.
+func (t *Track) writeKML(w *bufio.Writer) error {
+	w.WriteString(xml.Header)
+	w.WriteString(`<kml xmlns="http://www.opengis.net/kml/2.2" xmlns:gx="http://www.google.com/kml/ext/2.2">` + "\n")
+	w.WriteString("<Document><name>")
+	xml.EscapeText(w, []byte(t.Name))
+	w.WriteString("</name>\n<Placemark><name>")
+	xml.EscapeText(w, []byte(t.Name))
+	w.WriteString("</name>\n<gx:Track><altitudeMode>absolute</altitudeMode>\n")
+
+	err := t.Each(func(p Point) error {
+		_, err := fmt.Fprintf(w, "<when>%s</when>\n", formatTime(p.Time))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	err = t.Each(func(p Point) error {
+		_, err := fmt.Fprintf(w, "<gx:coord>%s %s %s</gx:coord>\n",
+			formatFloat(p.Longitude), formatFloat(p.Latitude), formatFloat(p.Altitude))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString("</gx:Track></Placemark>\n</Document>\n</kml>\n")
+	return err
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
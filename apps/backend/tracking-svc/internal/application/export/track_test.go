@@ -0,0 +1,113 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTrack(format Format, points ...Point) *Track {
+	return &Track{
+		VehicleID: "vehicle-1",
+		Name:      "Truck <7>",
+		Format:    format,
+		Each: func(fn func(Point) error) error {
+			for _, p := range points {
+				if err := fn(p); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+var testPoints = []Point{
+	{Latitude: 52.52, Longitude: 13.405, Altitude: 34, Time: time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)},
+	{Latitude: 52.53, Longitude: 13.41, Altitude: 36.5, Time: time.Date(2024, 6, 1, 8, 0, 30, 0, time.UTC)},
+}
+
+func TestTrackWrite_GeoJSON(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, testTrack(FormatGeoJSON, testPoints...).Write(&out))
+
+	var doc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string      `json:"type"`
+				Coordinates [][]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties struct {
+				Name       string   `json:"name"`
+				CoordTimes []string `json:"coordTimes"`
+			} `json:"properties"`
+		} `json:"features"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &doc))
+	require.Len(t, doc.Features, 1)
+	feature := doc.Features[0]
+	assert.Equal(t, "LineString", feature.Geometry.Type)
+	assert.Equal(t, [][]float64{{13.405, 52.52, 34}, {13.41, 52.53, 36.5}}, feature.Geometry.Coordinates)
+	assert.Equal(t, []string{"2024-06-01T08:00:00Z", "2024-06-01T08:00:30Z"}, feature.Properties.CoordTimes)
+	assert.Equal(t, "Truck <7>", feature.Properties.Name)
+
+	out.Reset()
+	require.NoError(t, testTrack(FormatGeoJSON).Write(&out))
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, out.String())
+}
+
+func TestTrackWrite_GPX(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, testTrack(FormatGPX, testPoints...).Write(&out))
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Track   struct {
+			Name   string `xml:"name"`
+			Points []struct {
+				Lat  float64 `xml:"lat,attr"`
+				Lon  float64 `xml:"lon,attr"`
+				Time string  `xml:"time"`
+			} `xml:"trkseg>trkpt"`
+		} `xml:"trk"`
+	}
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &doc))
+	assert.Equal(t, "1.1", doc.Version)
+	assert.Equal(t, "Truck <7>", doc.Track.Name)
+	require.Len(t, doc.Track.Points, 2)
+	assert.Equal(t, 13.41, doc.Track.Points[1].Lon)
+	assert.Equal(t, "2024-06-01T08:00:30Z", doc.Track.Points[1].Time)
+}
+
+func TestTrackWrite_KML(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, testTrack(FormatKML, testPoints...).Write(&out))
+
+	var doc struct {
+		Placemark struct {
+			Track struct {
+				When  []string `xml:"when"`
+				Coord []string `xml:"http://www.google.com/kml/ext/2.2 coord"`
+			} `xml:"http://www.google.com/kml/ext/2.2 Track"`
+		} `xml:"Document>Placemark"`
+	}
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &doc))
+	assert.Equal(t, []string{"2024-06-01T08:00:00Z", "2024-06-01T08:00:30Z"}, doc.Placemark.Track.When)
+	assert.Equal(t, []string{"13.405 52.52 34", "13.41 52.53 36.5"}, doc.Placemark.Track.Coord)
+	assert.Contains(t, out.String(), "Truck &lt;7&gt;")
+}
+
+func TestNewFormat(t *testing.T) {
+	format, err := NewFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatGeoJSON, format)
+
+	_, err = NewFormat("shp")
+	assert.Error(t, err)
+}
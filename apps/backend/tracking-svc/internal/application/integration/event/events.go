@@ -1,33 +1,54 @@
 package event
 
 type VehicleCreatedEvent struct {
-	VehicleID     string  `json:"vehicleId"`
-	VIN           string  `json:"vin"`
-	VehicleName   string  `json:"vehicleName"`
-	VehicleModel  string  `json:"vehicleModel"`
-	LicenseNumber string  `json:"licenseNumber"`
-	Status        string  `json:"status"`
-	Latitude      float64 `json:"latitude"`
-	Longitude     float64 `json:"longitude"`
-	Mileage       float64 `json:"mileage"`
-	FuelLevel     float64 `json:"fuelLevel"`
-	Timestamp     int64   `json:"timestamp"`
+	VehicleID      string                 `json:"vehicleId"`
+	VIN            string                 `json:"vin"`
+	VehicleName    string                 `json:"vehicleName"`
+	VehicleModel   string                 `json:"vehicleModel"`
+	LicenseNumber  string                 `json:"licenseNumber"`
+	Status         string                 `json:"status"`
+	Latitude       float64                `json:"latitude"`
+	Longitude      float64                `json:"longitude"`
+	Mileage        float64                `json:"mileage"`
+	FuelLevel      float64                `json:"fuelLevel"`
+	EnergyType     string                 `json:"energyType"`
+	EnergyCapacity float64                `json:"energyCapacity"`
+	Attributes     map[string]interface{} `json:"attributes,omitempty"`
+	Timestamp      int64                  `json:"timestamp"`
 }
 
 type VehicleLocationUpdatedEvent struct {
-	VehicleID string  `json:"vehicleId"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Altitude  float64 `json:"altitude"`
-	Timestamp int64   `json:"timestamp"`
-	UpdatedAt int64   `json:"updatedAt"`
-	Version   int64   `json:"version"`
+	VehicleID  string   `json:"vehicleId"`
+	Latitude   float64  `json:"latitude"`
+	Longitude  float64  `json:"longitude"`
+	Altitude   float64  `json:"altitude"`
+	SpeedKmh   *float64 `json:"speedKmh,omitempty"`
+	Heading    *float64 `json:"heading,omitempty"`
+	Satellites *int     `json:"satellites,omitempty"`
+	HDOP       *float64 `json:"hdop,omitempty"`
+	Timestamp  int64    `json:"timestamp"`
+	UpdatedAt  int64    `json:"updatedAt"`
+	Version    int64    `json:"version"`
+}
+
+// VehicleLocationArchivedEvent is a position older than the vehicle's current
+// one; it belongs in history but did not move the vehicle.
+type VehicleLocationArchivedEvent struct {
+	VehicleID  string  `json:"vehicleId"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Altitude   float64 `json:"altitude"`
+	Timestamp  int64   `json:"timestamp"`
+	ArchivedAt int64   `json:"archivedAt"`
+	Version    int64   `json:"version"`
 }
 
 type VehicleStatusChangedEvent struct {
 	VehicleID string `json:"vehicleId"`
 	OldStatus string `json:"oldStatus"`
 	NewStatus string `json:"newStatus"`
+	Reason    string `json:"reason"`
+	Actor     string `json:"actor"`
 	ChangedAt int64  `json:"changedAt"`
 	Version   int64  `json:"version"`
 }
@@ -39,6 +60,8 @@ type VehicleMileageUpdatedEvent struct {
 	Version   int64   `json:"version"`
 }
 
+// VehicleFuelLevelUpdatedEvent is only sent by vehicle-svc versions that
+// predate VehicleEnergyLevelUpdatedEvent.
 type VehicleFuelLevelUpdatedEvent struct {
 	VehicleID string  `json:"vehicleId"`
 	FuelLevel float64 `json:"fuelLevel"`
@@ -47,13 +70,106 @@ type VehicleFuelLevelUpdatedEvent struct {
 	Version   int64   `json:"version"`
 }
 
-type TrackingCorrectionAppliedEvent struct {
-	VehicleID string `json:"vehicle_id"`
-	Field     string `json:"field"` // e.g., "mileage", "fuel_level"
-	OldValue  string `json:"old_value"`
-	NewValue  string `json:"new_value"`
+// VehicleEnergyLevelUpdatedEvent carries the fuel level, or the battery state
+// of charge for a BEV, with the charging state and range vehicle-svc holds.
+type VehicleEnergyLevelUpdatedEvent struct {
+	VehicleID        string   `json:"vehicleId"`
+	EnergyType       string   `json:"energyType"`
+	Level            float64  `json:"level"`
+	ChargingState    string   `json:"chargingState,omitempty"`
+	EstimatedRangeKm *float64 `json:"estimatedRangeKm,omitempty"`
+	UpdatedAt        int64    `json:"updatedAt"`
+	Version          int64    `json:"version"`
+}
+
+type DriverAssignedEvent struct {
+	DriverID   string `json:"driverId"`
+	DriverName string `json:"driverName"`
+	VehicleID  string `json:"vehicleId"`
+	AssignedAt int64  `json:"assignedAt"`
+	Version    int64  `json:"version"`
+}
+
+type DriverUnassignedEvent struct {
+	DriverID     string `json:"driverId"`
+	VehicleID    string `json:"vehicleId"`
+	UnassignedAt int64  `json:"unassignedAt"`
+	Version      int64  `json:"version"`
+}
+
+type DevicePairedEvent struct {
+	DeviceID   string `json:"deviceId"`
+	HardwareID string `json:"hardwareId"`
+	Protocol   string `json:"protocol"`
+	VehicleID  string `json:"vehicleId"`
+	PairedAt   int64  `json:"pairedAt"`
+	Version    int64  `json:"version"`
+}
+
+type DeviceUnpairedEvent struct {
+	DeviceID   string `json:"deviceId"`
+	HardwareID string `json:"hardwareId"`
+	VehicleID  string `json:"vehicleId"`
+	UnpairedAt int64  `json:"unpairedAt"`
+	Version    int64  `json:"version"`
+}
+
+type VehicleDetailsUpdatedEvent struct {
+	VehicleID string            `json:"vehicleId"`
+	OldValues map[string]string `json:"oldValues"` // keyed by vehicleName, vehicleModel, licenseNumber
+	NewValues map[string]string `json:"newValues"`
+	// Custom attributes that changed; a nil new value means removed.
+	OldAttributes map[string]interface{} `json:"oldAttributes,omitempty"`
+	NewAttributes map[string]interface{} `json:"newAttributes,omitempty"`
+	Actor         string                 `json:"actor"`
+	UpdatedAt     int64                  `json:"updatedAt"`
+	Version       int64                  `json:"version"`
+}
+
+type VehicleGroupChangedEvent struct {
+	VehicleID string `json:"vehicleId"`
+	OldGroup  string `json:"oldGroup"`
+	NewGroup  string `json:"newGroup"`
+	Actor     string `json:"actor"`
+	ChangedAt int64  `json:"changedAt"`
+	Version   int64  `json:"version"`
+}
+
+type VehicleTagsChangedEvent struct {
+	VehicleID string   `json:"vehicleId"`
+	Tags      []string `json:"tags"` // full set after the change
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Actor     string   `json:"actor"`
+	ChangedAt int64    `json:"changedAt"`
+	Version   int64    `json:"version"`
+}
+
+type VehicleDeletedEvent struct {
+	VehicleID string `json:"vehicleId"`
 	Reason    string `json:"reason"`
-	Timestamp int64  `json:"timestamp"`
+	Actor     string `json:"actor"`
+	DeletedAt int64  `json:"deletedAt"`
+	Version   int64  `json:"version"`
+}
+
+type VehicleRestoredEvent struct {
+	VehicleID  string `json:"vehicleId"`
+	Actor      string `json:"actor"`
+	RestoredAt int64  `json:"restoredAt"`
+	Version    int64  `json:"version"`
+}
+
+type TrackingCorrectionAppliedEvent struct {
+	CorrectionID string `json:"correction_id"`
+	VehicleID    string `json:"vehicle_id"`
+	Field        string `json:"field"` // e.g., "mileage", "fuel_level"
+	OldValue     string `json:"old_value"`
+	NewValue     string `json:"new_value"`
+	Reason       string `json:"reason"`
+	Actor        string `json:"actor"`
+	Timestamp    int64  `json:"timestamp"`
+	Version      int64  `json:"version"`
 }
 
 type TrackingAlertEvent struct {
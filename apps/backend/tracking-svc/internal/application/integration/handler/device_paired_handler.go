@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type DevicePairedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewDevicePairedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *DevicePairedEventHandler {
+	return &DevicePairedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *DevicePairedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.DevicePairedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal device paired event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("device paired event received",
+		zap.String("device_id", evt.DeviceID),
+		zap.String("vehicle_id", evt.VehicleID),
+	)
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "device_paired",
+		OldValue:   map[string]interface{}{},
+		NewValue: map[string]interface{}{
+			"deviceId":   evt.DeviceID,
+			"hardwareId": evt.HardwareID,
+			"protocol":   evt.Protocol,
+			"pairedAt":   evt.PairedAt,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
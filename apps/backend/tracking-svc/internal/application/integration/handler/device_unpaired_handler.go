@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type DeviceUnpairedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewDeviceUnpairedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *DeviceUnpairedEventHandler {
+	return &DeviceUnpairedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *DeviceUnpairedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.DeviceUnpairedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal device unpaired event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("device unpaired event received",
+		zap.String("device_id", evt.DeviceID),
+		zap.String("vehicle_id", evt.VehicleID),
+	)
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "device_unpaired",
+		OldValue: map[string]interface{}{
+			"deviceId":   evt.DeviceID,
+			"hardwareId": evt.HardwareID,
+		},
+		NewValue: map[string]interface{}{
+			"unpairedAt": evt.UnpairedAt,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
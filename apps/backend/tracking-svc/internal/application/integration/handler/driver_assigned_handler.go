@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type DriverAssignedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewDriverAssignedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *DriverAssignedEventHandler {
+	return &DriverAssignedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *DriverAssignedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.DriverAssignedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal driver assigned event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("driver assigned event received",
+		zap.String("driver_id", evt.DriverID),
+		zap.String("vehicle_id", evt.VehicleID),
+	)
+
+	assignCmd := &command.AssignVehicleDriverCommand{
+		VehicleID: evt.VehicleID,
+		DriverID:  evt.DriverID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, assignCmd); err != nil {
+		h.logger.Error("failed to assign driver to vehicle", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		DriverID:   evt.DriverID,
+		ChangeType: "driver_assigned",
+		OldValue:   map[string]interface{}{},
+		NewValue: map[string]interface{}{
+			"driverId":   evt.DriverID,
+			"driverName": evt.DriverName,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type DriverUnassignedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewDriverUnassignedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *DriverUnassignedEventHandler {
+	return &DriverUnassignedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *DriverUnassignedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.DriverUnassignedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal driver unassigned event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("driver unassigned event received",
+		zap.String("driver_id", evt.DriverID),
+		zap.String("vehicle_id", evt.VehicleID),
+	)
+
+	unassignCmd := &command.UnassignVehicleDriverCommand{
+		VehicleID: evt.VehicleID,
+		DriverID:  evt.DriverID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, unassignCmd); err != nil {
+		h.logger.Error("failed to unassign driver from vehicle", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		DriverID:   evt.DriverID,
+		ChangeType: "driver_unassigned",
+		OldValue: map[string]interface{}{
+			"driverId": evt.DriverID,
+		},
+		NewValue: map[string]interface{}{},
+		Version:  evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type TrackingCorrectionAppliedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewTrackingCorrectionAppliedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *TrackingCorrectionAppliedEventHandler {
+	return &TrackingCorrectionAppliedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *TrackingCorrectionAppliedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.TrackingCorrectionAppliedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal tracking correction applied event", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "correction_applied",
+		OldValue: map[string]interface{}{
+			evt.Field: correctionValue(evt.OldValue),
+		},
+		NewValue: map[string]interface{}{
+			evt.Field:      correctionValue(evt.NewValue),
+			"reason":       evt.Reason,
+			"actor":        evt.Actor,
+			"correctionId": evt.CorrectionID,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	if evt.Field != "mileage" && evt.Field != "fuel_level" {
+		return nil
+	}
+	value, err := strconv.ParseFloat(evt.NewValue, 64)
+	if err != nil {
+		h.logger.Error("dropping correction with a non-numeric reading",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.String("field", evt.Field),
+			zap.String("value", evt.NewValue),
+		)
+		return nil
+	}
+
+	fuelCmd := &command.CorrectFuelReadingCommand{
+		VehicleID: evt.VehicleID,
+		Field:     evt.Field,
+		Value:     value,
+		Timestamp: evt.Timestamp,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, fuelCmd); err != nil {
+		h.logger.Error("failed to correct fuel reading",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
+// correctionValue keeps numeric readings numeric in the history timeline; the
+// event carries them as strings.
+func correctionValue(value string) interface{} {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
@@ -31,17 +31,20 @@ func (h *VehicleCreatedEventHandler) Handle(ctx context.Context, payload []byte)
 	)
 
 	createCmd := &command.CreateVehicleCommand{
-		RefID:         evt.VehicleID,
-		VIN:           evt.VIN,
-		VehicleName:   evt.VehicleName,
-		VehicleModel:  evt.VehicleModel,
-		LicenseNumber: evt.LicenseNumber,
-		Status:        evt.Status,
-		Latitude:      evt.Latitude,
-		Longitude:     evt.Longitude,
-		Altitude:      0,
-		Mileage:       evt.Mileage,
-		FuelLevel:     evt.FuelLevel,
+		RefID:          evt.VehicleID,
+		VIN:            evt.VIN,
+		VehicleName:    evt.VehicleName,
+		VehicleModel:   evt.VehicleModel,
+		LicenseNumber:  evt.LicenseNumber,
+		Status:         evt.Status,
+		Latitude:       evt.Latitude,
+		Longitude:      evt.Longitude,
+		Altitude:       0,
+		Mileage:        evt.Mileage,
+		FuelLevel:      evt.FuelLevel,
+		EnergyType:     evt.EnergyType,
+		EnergyCapacity: evt.EnergyCapacity,
+		Attributes:     evt.Attributes,
 	}
 
 	if err := h.commandBus.Dispatch(ctx, createCmd); err != nil {
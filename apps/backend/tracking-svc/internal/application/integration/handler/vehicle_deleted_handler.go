@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleDeletedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleDeletedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleDeletedEventHandler {
+	return &VehicleDeletedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleDeletedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleDeletedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle deleted event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("vehicle deleted event received",
+		zap.String("vehicle_id", evt.VehicleID),
+		zap.String("reason", evt.Reason),
+	)
+
+	// Record the deletion first so the archive step below hides it together
+	// with the rest of the vehicle's history.
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "vehicle_deleted",
+		OldValue:   map[string]interface{}{},
+		NewValue: map[string]interface{}{
+			"reason": evt.Reason,
+			"actor":  evt.Actor,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	archiveCmd := &command.ArchiveVehicleCommand{
+		VehicleID:  evt.VehicleID,
+		ArchivedAt: time.Unix(evt.DeletedAt, 0).UTC(),
+	}
+
+	if err := h.commandBus.Dispatch(ctx, archiveCmd); err != nil {
+		h.logger.Error("failed to archive vehicle", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleDetailsUpdatedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleDetailsUpdatedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleDetailsUpdatedEventHandler {
+	return &VehicleDetailsUpdatedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleDetailsUpdatedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleDetailsUpdatedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle details updated event", zap.Error(err))
+		return err
+	}
+
+	detailsCmd := &command.UpdateVehicleDetailsCommand{
+		VehicleID:     evt.VehicleID,
+		VehicleName:   evt.NewValues["vehicleName"],
+		VehicleModel:  evt.NewValues["vehicleModel"],
+		LicenseNumber: evt.NewValues["licenseNumber"],
+		Attributes:    evt.NewAttributes,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, detailsCmd); err != nil {
+		h.logger.Error("failed to update vehicle details", zap.Error(err))
+		return err
+	}
+
+	oldValue := make(map[string]interface{}, len(evt.OldValues))
+	for field, value := range evt.OldValues {
+		oldValue[field] = value
+	}
+	newValue := make(map[string]interface{}, len(evt.NewValues)+1)
+	for field, value := range evt.NewValues {
+		newValue[field] = value
+	}
+	if len(evt.NewAttributes) > 0 {
+		oldValue["attributes"] = evt.OldAttributes
+		newValue["attributes"] = evt.NewAttributes
+	}
+	newValue["actor"] = evt.Actor
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "details_updated",
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		Version:    evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleEnergyLevelUpdatedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleEnergyLevelUpdatedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleEnergyLevelUpdatedEventHandler {
+	return &VehicleEnergyLevelUpdatedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleEnergyLevelUpdatedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleEnergyLevelUpdatedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle energy level updated event", zap.Error(err))
+		return err
+	}
+
+	energyCmd := &command.UpdateVehicleEnergyLevelCommand{
+		VehicleID:        evt.VehicleID,
+		Level:            evt.Level,
+		ChargingState:    evt.ChargingState,
+		EstimatedRangeKm: evt.EstimatedRangeKm,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, energyCmd); err != nil {
+		h.logger.Error("failed to update vehicle energy level", zap.Error(err))
+		return err
+	}
+
+	newValue := map[string]interface{}{
+		"energyType": evt.EnergyType,
+		"level":      evt.Level,
+	}
+	if evt.ChargingState != "" {
+		newValue["chargingState"] = evt.ChargingState
+	}
+	if evt.EstimatedRangeKm != nil {
+		newValue["estimatedRangeKm"] = *evt.EstimatedRangeKm
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "energy_updated",
+		OldValue:   map[string]interface{}{},
+		NewValue:   newValue,
+		Version:    evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	evaluateCmd := &command.EvaluateFuelLevelCommand{
+		VehicleID:  evt.VehicleID,
+		FuelLevel:  evt.Level,
+		EnergyType: evt.EnergyType,
+		Timestamp:  evt.UpdatedAt,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, evaluateCmd); err != nil {
+		h.logger.Error("failed to evaluate energy level",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
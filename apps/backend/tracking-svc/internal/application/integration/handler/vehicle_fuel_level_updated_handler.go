@@ -41,5 +41,18 @@ func (h *VehicleFuelLevelUpdatedEventHandler) Handle(ctx context.Context, payloa
 		h.logger.Error("failed to record vehicle change history", zap.Error(err))
 	}
 
+	fuelCmd := &command.EvaluateFuelLevelCommand{
+		VehicleID: evt.VehicleID,
+		FuelLevel: evt.FuelLevel,
+		Timestamp: evt.UpdatedAt,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, fuelCmd); err != nil {
+		h.logger.Error("failed to evaluate fuel level",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
 	return nil
 }
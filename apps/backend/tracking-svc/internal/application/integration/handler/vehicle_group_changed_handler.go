@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleGroupChangedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleGroupChangedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleGroupChangedEventHandler {
+	return &VehicleGroupChangedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleGroupChangedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleGroupChangedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle group changed event", zap.Error(err))
+		return err
+	}
+
+	groupCmd := &command.ChangeVehicleGroupCommand{
+		VehicleID: evt.VehicleID,
+		Group:     evt.NewGroup,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, groupCmd); err != nil {
+		h.logger.Error("failed to change vehicle group", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "group_changed",
+		OldValue: map[string]interface{}{
+			"group": evt.OldGroup,
+		},
+		NewValue: map[string]interface{}{
+			"group": evt.NewGroup,
+			"actor": evt.Actor,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+// VehicleLocationArchivedEventHandler records out-of-order positions as
+// archived location points, which are kept but stay off the vehicle's track.
+// Geofences and trips follow the current position only, so they are not
+// evaluated.
+type VehicleLocationArchivedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleLocationArchivedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleLocationArchivedEventHandler {
+	return &VehicleLocationArchivedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleLocationArchivedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleLocationArchivedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle location archived event", zap.Error(err))
+		return err
+	}
+
+	locationCmd := &command.RecordLocationPointCommand{
+		VehicleID: evt.VehicleID,
+		Latitude:  evt.Latitude,
+		Longitude: evt.Longitude,
+		Altitude:  evt.Altitude,
+		Timestamp: evt.Timestamp,
+		Archived:  true,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, locationCmd); err != nil {
+		h.logger.Error("failed to record location point",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
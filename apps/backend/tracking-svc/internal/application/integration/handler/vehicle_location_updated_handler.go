@@ -25,21 +25,69 @@ func (h *VehicleLocationUpdatedEventHandler) Handle(ctx context.Context, payload
 		return err
 	}
 
-	changeCmd := &command.RecordVehicleChangeCommand{
-		VehicleID:  evt.VehicleID,
-		VIN:        "",
-		ChangeType: "location_updated",
-		OldValue:   map[string]interface{}{},
-		NewValue: map[string]interface{}{
-			"latitude":  evt.Latitude,
-			"longitude": evt.Longitude,
-			"altitude":  evt.Altitude,
-		},
-		Version: evt.Version,
-	}
-
-	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
-		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	timestamp := evt.Timestamp
+	if timestamp == 0 {
+		timestamp = evt.UpdatedAt
+	}
+
+	locationCmd := &command.RecordLocationPointCommand{
+		VehicleID: evt.VehicleID,
+		Latitude:  evt.Latitude,
+		Longitude: evt.Longitude,
+		Altitude:  evt.Altitude,
+		Timestamp: timestamp,
+		SpeedKmh:  evt.SpeedKmh,
+		Heading:   evt.Heading,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, locationCmd); err != nil {
+		h.logger.Error("failed to record location point",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	geofenceCmd := &command.EvaluateGeofencesCommand{
+		VehicleID: evt.VehicleID,
+		Latitude:  evt.Latitude,
+		Longitude: evt.Longitude,
+		Timestamp: timestamp,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, geofenceCmd); err != nil {
+		h.logger.Error("failed to evaluate geofences",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	speedCmd := &command.EvaluateSpeedCommand{
+		VehicleID: evt.VehicleID,
+		Latitude:  evt.Latitude,
+		Longitude: evt.Longitude,
+		Timestamp: timestamp,
+		SpeedKmh:  evt.SpeedKmh,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, speedCmd); err != nil {
+		h.logger.Error("failed to evaluate speed",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
+	tripCmd := &command.RecordTripPointCommand{
+		VehicleID: evt.VehicleID,
+		Latitude:  evt.Latitude,
+		Longitude: evt.Longitude,
+		Timestamp: timestamp,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, tripCmd); err != nil {
+		h.logger.Error("failed to record trip point",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
 	}
 
 	return nil
@@ -40,5 +40,17 @@ func (h *VehicleMileageUpdatedEventHandler) Handle(ctx context.Context, payload
 		h.logger.Error("failed to record vehicle change history", zap.Error(err))
 	}
 
+	fuelCmd := &command.RecordFuelMileageCommand{
+		VehicleID: evt.VehicleID,
+		Mileage:   evt.Mileage,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, fuelCmd); err != nil {
+		h.logger.Error("failed to record fuel mileage",
+			zap.String("vehicleId", evt.VehicleID),
+			zap.Error(err),
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleRestoredEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleRestoredEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleRestoredEventHandler {
+	return &VehicleRestoredEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleRestoredEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleRestoredEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle restored event", zap.Error(err))
+		return err
+	}
+
+	h.logger.Info("vehicle restored event received",
+		zap.String("vehicle_id", evt.VehicleID),
+	)
+
+	unarchiveCmd := &command.UnarchiveVehicleCommand{
+		VehicleID: evt.VehicleID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, unarchiveCmd); err != nil {
+		h.logger.Error("failed to unarchive vehicle", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "vehicle_restored",
+		OldValue:   map[string]interface{}{},
+		NewValue: map[string]interface{}{
+			"actor": evt.Actor,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
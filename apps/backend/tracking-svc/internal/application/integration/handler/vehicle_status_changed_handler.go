@@ -34,6 +34,8 @@ func (h *VehicleStatusChangedEventHandler) Handle(ctx context.Context, payload [
 		},
 		NewValue: map[string]interface{}{
 			"status": evt.NewStatus,
+			"reason": evt.Reason,
+			"actor":  evt.Actor,
 		},
 		Version: evt.Version,
 	}
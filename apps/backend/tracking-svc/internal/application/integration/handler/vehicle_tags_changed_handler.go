@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+type VehicleTagsChangedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleTagsChangedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleTagsChangedEventHandler {
+	return &VehicleTagsChangedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleTagsChangedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleTagsChangedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle tags changed event", zap.Error(err))
+		return err
+	}
+
+	tagsCmd := &command.ReplaceVehicleTagsCommand{
+		VehicleID: evt.VehicleID,
+		Tags:      evt.Tags,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, tagsCmd); err != nil {
+		h.logger.Error("failed to replace vehicle tags", zap.Error(err))
+		return err
+	}
+
+	changeCmd := &command.RecordVehicleChangeCommand{
+		VehicleID:  evt.VehicleID,
+		VIN:        "",
+		ChangeType: "tags_changed",
+		OldValue: map[string]interface{}{
+			"removed": evt.Removed,
+		},
+		NewValue: map[string]interface{}{
+			"added": evt.Added,
+			"tags":  evt.Tags,
+			"actor": evt.Actor,
+		},
+		Version: evt.Version,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, changeCmd); err != nil {
+		h.logger.Error("failed to record vehicle change history", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package query
+
+import "time"
+
+type ExportVehicleTrackQuery struct {
+	VehicleID string
+	From      time.Time // zero for no lower bound
+	To        time.Time // zero for now
+	Format    string    // geojson, gpx or kml; empty means geojson
+}
+
+func (q *ExportVehicleTrackQuery) QueryName() string {
+	return "ExportVehicleTrack"
+}
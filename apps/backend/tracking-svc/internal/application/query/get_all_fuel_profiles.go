@@ -0,0 +1,7 @@
+package query
+
+type GetAllFuelProfilesQuery struct{}
+
+func (q *GetAllFuelProfilesQuery) QueryName() string {
+	return "GetAllFuelProfiles"
+}
@@ -0,0 +1,10 @@
+package query
+
+type GetAllGeofencesQuery struct {
+	Limit  int
+	Offset int
+}
+
+func (q *GetAllGeofencesQuery) QueryName() string {
+	return "GetAllGeofences"
+}
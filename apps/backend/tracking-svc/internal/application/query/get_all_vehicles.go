@@ -3,6 +3,7 @@ package query
 type GetAllVehiclesQuery struct {
 	Limit  int
 	Offset int
+	Units  string
 }
 
 func (q *GetAllVehiclesQuery) QueryName() string {
@@ -0,0 +1,11 @@
+package query
+
+type GetDriverChangeHistoryQuery struct {
+	DriverID string
+	Limit    int
+	Offset   int
+}
+
+func (q *GetDriverChangeHistoryQuery) QueryName() string {
+	return "GetDriverChangeHistory"
+}
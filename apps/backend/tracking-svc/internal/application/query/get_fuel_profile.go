@@ -0,0 +1,9 @@
+package query
+
+type GetFuelProfileQuery struct {
+	VehicleModel string
+}
+
+func (q *GetFuelProfileQuery) QueryName() string {
+	return "GetFuelProfile"
+}
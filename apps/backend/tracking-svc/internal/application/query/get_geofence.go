@@ -0,0 +1,9 @@
+package query
+
+type GetGeofenceQuery struct {
+	GeofenceID string
+}
+
+func (q *GetGeofenceQuery) QueryName() string {
+	return "GetGeofence"
+}
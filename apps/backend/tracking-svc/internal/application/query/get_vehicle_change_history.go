@@ -1,9 +1,23 @@
 package query
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrVehicleNotFound is returned when the caller's tenant has no vehicle with
+// the requested id.
+var ErrVehicleNotFound = errors.New("vehicle not found")
+
+// GetVehicleChangeHistoryQuery pages through a vehicle's change history and
+// reads its locations from From to To, as GetVehicleLocationsQuery does.
 type GetVehicleChangeHistoryQuery struct {
-	VehicleID string
-	Limit     int
-	Offset    int
+	VehicleID  string
+	Limit      int
+	Offset     int
+	From       time.Time
+	To         time.Time
+	Resolution string // raw, 1m or 1h; empty picks one for the range
 }
 
 func (q *GetVehicleChangeHistoryQuery) QueryName() string {
@@ -0,0 +1,9 @@
+package query
+
+type GetVehicleGeofencesQuery struct {
+	VehicleID string
+}
+
+func (q *GetVehicleGeofencesQuery) QueryName() string {
+	return "GetVehicleGeofences"
+}
@@ -0,0 +1,17 @@
+package query
+
+import "time"
+
+// MaxLocationPoints bounds the points one location history response holds.
+const MaxLocationPoints = 20000
+
+type GetVehicleLocationsQuery struct {
+	VehicleID  string
+	From       time.Time
+	To         time.Time
+	Resolution string // raw, 1m or 1h; empty picks one for the range
+}
+
+func (q *GetVehicleLocationsQuery) QueryName() string {
+	return "GetVehicleLocations"
+}
@@ -0,0 +1,17 @@
+package query
+
+import "time"
+
+// MaxPlaybackFrames bounds the frames one playback request may ask for.
+const MaxPlaybackFrames = 5000
+
+type GetVehiclePlaybackQuery struct {
+	VehicleID       string
+	From            time.Time
+	To              time.Time
+	IntervalSeconds int64
+}
+
+func (q *GetVehiclePlaybackQuery) QueryName() string {
+	return "GetVehiclePlayback"
+}
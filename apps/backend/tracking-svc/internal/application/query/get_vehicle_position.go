@@ -0,0 +1,19 @@
+package query
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoRecordedPosition is returned when a vehicle has no position history to
+// estimate from.
+var ErrNoRecordedPosition = errors.New("vehicle has no recorded positions")
+
+type GetVehiclePositionQuery struct {
+	VehicleID string
+	At        time.Time
+}
+
+func (q *GetVehiclePositionQuery) QueryName() string {
+	return "GetVehiclePosition"
+}
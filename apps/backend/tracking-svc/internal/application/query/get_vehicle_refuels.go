@@ -0,0 +1,12 @@
+package query
+
+type GetVehicleRefuelsQuery struct {
+	VehicleID string
+	Limit     int
+	Offset    int
+	Units     string
+}
+
+func (q *GetVehicleRefuelsQuery) QueryName() string {
+	return "GetVehicleRefuels"
+}
@@ -0,0 +1,9 @@
+package query
+
+type GetVehicleSpeedQuery struct {
+	VehicleID string
+}
+
+func (q *GetVehicleSpeedQuery) QueryName() string {
+	return "GetVehicleSpeed"
+}
@@ -0,0 +1,16 @@
+package query
+
+import "time"
+
+type GetVehicleTripsQuery struct {
+	VehicleID string
+	From      time.Time
+	To        time.Time
+	Limit     int
+	Offset    int
+	Units     string
+}
+
+func (q *GetVehicleTripsQuery) QueryName() string {
+	return "GetVehicleTrips"
+}
@@ -0,0 +1,16 @@
+package query
+
+type GetVehiclesInBoxQuery struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+	Status       string // empty matches any
+	Limit        int
+	Offset       int
+	Units        string
+}
+
+func (q *GetVehiclesInBoxQuery) QueryName() string {
+	return "GetVehiclesInBox"
+}
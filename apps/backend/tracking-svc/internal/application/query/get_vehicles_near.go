@@ -0,0 +1,14 @@
+package query
+
+type GetVehiclesNearQuery struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Status       string // empty matches any
+	Limit        int
+	Units        string
+}
+
+func (q *GetVehiclesNearQuery) QueryName() string {
+	return "GetVehiclesNear"
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type ArchiveVehicleCommandHandler struct {
+	vehicleRepo       repository.VehicleRepository
+	changeHistoryRepo repository.VehicleChangeHistoryRepository
+	locationRepo      repository.LocationPointRepository
+}
+
+func NewArchiveVehicleCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	changeHistoryRepo repository.VehicleChangeHistoryRepository,
+	locationRepo repository.LocationPointRepository,
+) *ArchiveVehicleCommandHandler {
+	return &ArchiveVehicleCommandHandler{vehicleRepo: vehicleRepo, changeHistoryRepo: changeHistoryRepo, locationRepo: locationRepo}
+}
+
+func (h *ArchiveVehicleCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	archiveCmd, ok := cmd.(*command.ArchiveVehicleCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for ArchiveVehicleCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, archiveCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if vehicle.Archive(archiveCmd.ArchivedAt) {
+		if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+			return err
+		}
+	}
+
+	if err := h.changeHistoryRepo.SetArchived(ctx, archiveCmd.VehicleID, true); err != nil {
+		return err
+	}
+	return h.locationRepo.SetVehicleArchived(ctx, archiveCmd.VehicleID, true)
+}
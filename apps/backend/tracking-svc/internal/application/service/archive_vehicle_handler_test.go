@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+)
+
+func TestArchiveVehicle_ArchivesHistoryAndLocationsUntilRestored(t *testing.T) {
+	vehicleRepo := &stubVehicleRepo{vehicle: newStubVehicle(t, "vehicle-1")}
+	historyRepo := &stubHistoryRepo{}
+	locationRepo := &stubLocationRepo{}
+
+	archive := NewArchiveVehicleCommandHandler(vehicleRepo, historyRepo, locationRepo)
+	require.NoError(t, archive.Handle(context.Background(), &command.ArchiveVehicleCommand{
+		VehicleID: "vehicle-1", ArchivedAt: time.Now(),
+	}))
+	assert.True(t, historyRepo.archived["vehicle-1"])
+	assert.True(t, locationRepo.archived["vehicle-1"])
+
+	unarchive := NewUnarchiveVehicleCommandHandler(vehicleRepo, historyRepo, locationRepo)
+	require.NoError(t, unarchive.Handle(context.Background(), &command.UnarchiveVehicleCommand{VehicleID: "vehicle-1"}))
+	assert.False(t, historyRepo.archived["vehicle-1"])
+	assert.False(t, locationRepo.archived["vehicle-1"])
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type AssignVehicleDriverCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewAssignVehicleDriverCommandHandler(vehicleRepo repository.VehicleRepository) *AssignVehicleDriverCommandHandler {
+	return &AssignVehicleDriverCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *AssignVehicleDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	assignCmd, ok := cmd.(*command.AssignVehicleDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for AssignVehicleDriverCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, assignCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if vehicle.CurrentDriverID() == assignCmd.DriverID {
+		return nil
+	}
+
+	if err := vehicle.AssignDriver(assignCmd.DriverID); err != nil {
+		return err
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type ChangeVehicleGroupCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewChangeVehicleGroupCommandHandler(vehicleRepo repository.VehicleRepository) *ChangeVehicleGroupCommandHandler {
+	return &ChangeVehicleGroupCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *ChangeVehicleGroupCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	groupCmd, ok := cmd.(*command.ChangeVehicleGroupCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for ChangeVehicleGroupCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, groupCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if !vehicle.ChangeGroup(groupCmd.Group) {
+		return nil
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
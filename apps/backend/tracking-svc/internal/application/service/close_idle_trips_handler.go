@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+type CloseIdleTripsCommandHandler struct {
+	tripRepo          repository.TripRepository
+	motionRepo        repository.VehicleMotionStateRepository
+	stationaryTimeout time.Duration
+}
+
+func NewCloseIdleTripsCommandHandler(
+	tripRepo repository.TripRepository,
+	motionRepo repository.VehicleMotionStateRepository,
+	stationaryTimeout time.Duration,
+) *CloseIdleTripsCommandHandler {
+	return &CloseIdleTripsCommandHandler{
+		tripRepo:          tripRepo,
+		motionRepo:        motionRepo,
+		stationaryTimeout: stationaryTimeout,
+	}
+}
+
+func (h *CloseIdleTripsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	if _, ok := cmd.(*command.CloseIdleTripsCommand); !ok {
+		return fmt.Errorf("invalid command type for CloseIdleTripsCommandHandler")
+	}
+
+	cutoff := time.Now().Add(-h.stationaryTimeout)
+	trips, err := h.tripRepo.FindOpenIdleSince(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to find idle trips: %w", err)
+	}
+
+	for _, trip := range trips {
+		// The sweep spans every tenant; each trip is closed within its own.
+		tripCtx := tenant.WithID(ctx, trip.TenantID())
+
+		trip.Complete()
+		if err := h.tripRepo.Save(tripCtx, trip); err != nil {
+			return fmt.Errorf("failed to save trip: %w", err)
+		}
+
+		motion, err := h.motionRepo.FindByVehicleID(tripCtx, trip.VehicleID())
+		if err != nil {
+			return fmt.Errorf("failed to find vehicle motion state: %w", err)
+		}
+		if motion == nil {
+			motion = entity.NewVehicleMotionState(trip.TenantID(), trip.VehicleID())
+		}
+		motion.Park(trip.EndPoint())
+		if err := h.motionRepo.Save(tripCtx, motion); err != nil {
+			return fmt.Errorf("failed to save vehicle motion state: %w", err)
+		}
+	}
+
+	return nil
+}
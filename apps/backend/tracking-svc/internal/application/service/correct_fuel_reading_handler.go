@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+// CorrectFuelReadingCommandHandler re-baselines the fuel state on a corrected
+// reading, so later readings are judged against the corrected value.
+type CorrectFuelReadingCommandHandler struct {
+	stateRepo repository.VehicleFuelStateRepository
+}
+
+func NewCorrectFuelReadingCommandHandler(stateRepo repository.VehicleFuelStateRepository) *CorrectFuelReadingCommandHandler {
+	return &CorrectFuelReadingCommandHandler{stateRepo: stateRepo}
+}
+
+func (h *CorrectFuelReadingCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	correctCmd, ok := cmd.(*command.CorrectFuelReadingCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for CorrectFuelReadingCommandHandler")
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, correctCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle fuel state: %w", err)
+	}
+	if state == nil {
+		state = entity.NewVehicleFuelState(tenant.ID(ctx), correctCmd.VehicleID)
+	}
+
+	var changed bool
+	switch correctCmd.Field {
+	case "mileage":
+		changed = state.CorrectMileage(correctCmd.Value)
+	case "fuel_level":
+		changed = state.CorrectFuelLevel(correctCmd.Value, correctCmd.Timestamp)
+	default:
+		return fmt.Errorf("unsupported correction field: %s", correctCmd.Field)
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := h.stateRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save vehicle fuel state: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type CreateGeofenceCommandHandler struct {
+	geofenceRepo repository.GeofenceRepository
+}
+
+func NewCreateGeofenceCommandHandler(geofenceRepo repository.GeofenceRepository) *CreateGeofenceCommandHandler {
+	return &CreateGeofenceCommandHandler{geofenceRepo: geofenceRepo}
+}
+
+func (h *CreateGeofenceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	createCmd, ok := cmd.(*command.CreateGeofenceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for CreateGeofenceCommandHandler")
+	}
+
+	geofenceID := valueobject.GenerateGeofenceID()
+	if createCmd.GeofenceID != "" {
+		id, err := valueobject.NewGeofenceID(createCmd.GeofenceID)
+		if err != nil {
+			return fmt.Errorf("invalid geofence id: %w", err)
+		}
+		geofenceID = id
+	}
+
+	category, err := valueobject.NewGeofenceCategory(createCmd.Category)
+	if err != nil {
+		return err
+	}
+	shape, err := valueobject.NewGeofenceShape(createCmd.Shape)
+	if err != nil {
+		return err
+	}
+
+	var geofence *entity.Geofence
+	switch shape {
+	case valueobject.ShapeCircle:
+		center, err := valueobject.NewCoordinate(createCmd.Center.Latitude, createCmd.Center.Longitude)
+		if err != nil {
+			return fmt.Errorf("invalid center: %w", err)
+		}
+		geofence, err = entity.NewCircleGeofence(geofenceID, tenant.ID(ctx), createCmd.Name, category, center, createCmd.RadiusMeters)
+		if err != nil {
+			return err
+		}
+	case valueobject.ShapePolygon:
+		polygon, err := toCoordinates(createCmd.Polygon)
+		if err != nil {
+			return err
+		}
+		geofence, err = entity.NewPolygonGeofence(geofenceID, tenant.ID(ctx), createCmd.Name, category, polygon)
+		if err != nil {
+			return err
+		}
+	}
+	if err := geofence.SetSpeedLimit(createCmd.SpeedLimitKmh); err != nil {
+		return err
+	}
+
+	if err := h.geofenceRepo.Save(ctx, geofence); err != nil {
+		return fmt.Errorf("failed to save geofence: %w", err)
+	}
+
+	return nil
+}
+
+func toCoordinates(points []command.GeofencePoint) ([]valueobject.Coordinate, error) {
+	coordinates := make([]valueobject.Coordinate, 0, len(points))
+	for i, point := range points {
+		coordinate, err := valueobject.NewCoordinate(point.Latitude, point.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid polygon vertex %d: %w", i, err)
+		}
+		coordinates = append(coordinates, coordinate)
+	}
+	return coordinates, nil
+}
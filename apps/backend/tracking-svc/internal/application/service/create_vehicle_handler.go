@@ -6,6 +6,7 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
 
@@ -58,8 +59,14 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		return err
 	}
 
+	energyType, err := valueobject.NewEnergyType(command.EnergyType)
+	if err != nil {
+		return err
+	}
+
 	vehicle, err := entity.NewVehicle(
 		vehicleID,
+		tenant.ID(ctx),
 		command.RefID,
 		command.VIN,
 		command.VehicleName,
@@ -69,6 +76,9 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		location,
 		mileage,
 		fuelLevel,
+		energyType,
+		command.EnergyCapacity,
+		command.Attributes,
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type DeleteFuelProfileCommandHandler struct {
+	profileRepo repository.FuelProfileRepository
+}
+
+func NewDeleteFuelProfileCommandHandler(profileRepo repository.FuelProfileRepository) *DeleteFuelProfileCommandHandler {
+	return &DeleteFuelProfileCommandHandler{profileRepo: profileRepo}
+}
+
+func (h *DeleteFuelProfileCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	deleteCmd, ok := cmd.(*command.DeleteFuelProfileCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DeleteFuelProfileCommandHandler")
+	}
+
+	if err := h.profileRepo.Delete(ctx, deleteCmd.VehicleModel); err != nil {
+		return fmt.Errorf("failed to delete fuel profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type DeleteGeofenceCommandHandler struct {
+	geofenceRepo repository.GeofenceRepository
+}
+
+func NewDeleteGeofenceCommandHandler(geofenceRepo repository.GeofenceRepository) *DeleteGeofenceCommandHandler {
+	return &DeleteGeofenceCommandHandler{geofenceRepo: geofenceRepo}
+}
+
+func (h *DeleteGeofenceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	deleteCmd, ok := cmd.(*command.DeleteGeofenceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DeleteGeofenceCommandHandler")
+	}
+
+	geofenceID, err := valueobject.NewGeofenceID(deleteCmd.GeofenceID)
+	if err != nil {
+		return fmt.Errorf("invalid geofence id: %w", err)
+	}
+
+	// Memberships that still reference the zone are dropped the next time
+	// each vehicle reports a position.
+	if err := h.geofenceRepo.Delete(ctx, geofenceID); err != nil {
+		return fmt.Errorf("failed to delete geofence: %w", err)
+	}
+
+	return nil
+}
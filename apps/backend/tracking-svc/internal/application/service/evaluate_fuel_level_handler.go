@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type EvaluateFuelLevelCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	profileRepo repository.FuelProfileRepository
+	stateRepo   repository.VehicleFuelStateRepository
+	refuelRepo  repository.RefuelRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewEvaluateFuelLevelCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	profileRepo repository.FuelProfileRepository,
+	stateRepo repository.VehicleFuelStateRepository,
+	refuelRepo repository.RefuelRepository,
+	outboxRepo repository.OutboxRepository,
+) *EvaluateFuelLevelCommandHandler {
+	return &EvaluateFuelLevelCommandHandler{
+		vehicleRepo: vehicleRepo,
+		profileRepo: profileRepo,
+		stateRepo:   stateRepo,
+		refuelRepo:  refuelRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *EvaluateFuelLevelCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	evaluateCmd, ok := cmd.(*command.EvaluateFuelLevelCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for EvaluateFuelLevelCommandHandler")
+	}
+
+	profile, err := h.findProfile(ctx, evaluateCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, evaluateCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle fuel state: %w", err)
+	}
+	if state == nil {
+		state = entity.NewVehicleFuelState(tenant.ID(ctx), evaluateCmd.VehicleID)
+	}
+
+	energyType, err := valueobject.NewEnergyType(evaluateCmd.EnergyType)
+	if err != nil {
+		return err
+	}
+
+	refuel, changed := state.RecordFuelLevel(evaluateCmd.FuelLevel, evaluateCmd.Timestamp, profile, energyType, valueobject.GenerateRefuelID())
+	if !changed {
+		return nil
+	}
+
+	if err := h.stateRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save vehicle fuel state: %w", err)
+	}
+
+	if refuel != nil {
+		if err := h.refuelRepo.Save(ctx, refuel); err != nil {
+			return fmt.Errorf("failed to save refuel: %w", err)
+		}
+	}
+
+	for _, event := range state.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, evaluateCmd.VehicleID, event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findProfile picks the fuel profile for the vehicle's model, falling back to
+// the defaults when the model is unknown or has no profile configured.
+func (h *EvaluateFuelLevelCommandHandler) findProfile(ctx context.Context, vehicleID string) (*entity.FuelProfile, error) {
+	model := ""
+	if vehicle, err := h.vehicleRepo.FindByRefID(ctx, vehicleID); err == nil {
+		model = vehicle.VehicleModel()
+	}
+	if model == "" {
+		return entity.DefaultFuelProfile(tenant.ID(ctx), model), nil
+	}
+
+	profile, err := h.profileRepo.FindByVehicleModel(ctx, model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fuel profile: %w", err)
+	}
+	if profile == nil {
+		return entity.DefaultFuelProfile(tenant.ID(ctx), model), nil
+	}
+
+	return profile, nil
+}
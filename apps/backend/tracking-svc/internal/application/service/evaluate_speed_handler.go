@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type EvaluateSpeedCommandHandler struct {
+	geofenceRepo repository.GeofenceRepository
+	stateRepo    repository.VehicleSpeedStateRepository
+	outboxRepo   repository.OutboxRepository
+}
+
+func NewEvaluateSpeedCommandHandler(
+	geofenceRepo repository.GeofenceRepository,
+	stateRepo repository.VehicleSpeedStateRepository,
+	outboxRepo repository.OutboxRepository,
+) *EvaluateSpeedCommandHandler {
+	return &EvaluateSpeedCommandHandler{
+		geofenceRepo: geofenceRepo,
+		stateRepo:    stateRepo,
+		outboxRepo:   outboxRepo,
+	}
+}
+
+func (h *EvaluateSpeedCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	evaluateCmd, ok := cmd.(*command.EvaluateSpeedCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for EvaluateSpeedCommandHandler")
+	}
+
+	position, err := valueobject.NewCoordinate(evaluateCmd.Latitude, evaluateCmd.Longitude)
+	if err != nil {
+		return fmt.Errorf("invalid position: %w", err)
+	}
+
+	zones, err := h.geofenceRepo.FindAll(ctx, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to find geofences: %w", err)
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, evaluateCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle speed state: %w", err)
+	}
+	if state == nil {
+		state = entity.NewVehicleSpeedState(tenant.ID(ctx), evaluateCmd.VehicleID)
+	}
+
+	if !state.Observe(position, evaluateCmd.Timestamp, evaluateCmd.SpeedKmh, zones) {
+		return nil
+	}
+
+	if err := h.stateRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save vehicle speed state: %w", err)
+	}
+
+	for _, event := range state.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, evaluateCmd.VehicleID, event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
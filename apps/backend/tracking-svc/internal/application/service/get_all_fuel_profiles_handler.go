@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type GetAllFuelProfilesQueryHandler struct {
+	profileRepo repository.FuelProfileRepository
+}
+
+func NewGetAllFuelProfilesQueryHandler(profileRepo repository.FuelProfileRepository) *GetAllFuelProfilesQueryHandler {
+	return &GetAllFuelProfilesQueryHandler{profileRepo: profileRepo}
+}
+
+func (h *GetAllFuelProfilesQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	if _, ok := q.(*query.GetAllFuelProfilesQuery); !ok {
+		return nil, fmt.Errorf("invalid query type for GetAllFuelProfilesQueryHandler")
+	}
+
+	profiles, err := h.profileRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fuel profiles: %w", err)
+	}
+
+	responses := make([]*dto.FuelProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		responses = append(responses, toFuelProfileResponse(profile))
+	}
+
+	return responses, nil
+}
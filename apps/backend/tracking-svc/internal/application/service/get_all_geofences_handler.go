@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type GetAllGeofencesQueryHandler struct {
+	geofenceRepo repository.GeofenceRepository
+}
+
+func NewGetAllGeofencesQueryHandler(geofenceRepo repository.GeofenceRepository) *GetAllGeofencesQueryHandler {
+	return &GetAllGeofencesQueryHandler{geofenceRepo: geofenceRepo}
+}
+
+func (h *GetAllGeofencesQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	allQuery, ok := q.(*query.GetAllGeofencesQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetAllGeofencesQueryHandler")
+	}
+
+	geofences, err := h.geofenceRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofences: %w", err)
+	}
+
+	responses := make([]*dto.GeofenceResponse, 0, len(geofences))
+	for _, geofence := range geofences {
+		responses = append(responses, toGeofenceResponse(geofence))
+	}
+
+	return responses, nil
+}
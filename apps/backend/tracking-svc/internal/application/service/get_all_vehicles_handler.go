@@ -7,6 +7,7 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
 
 type GetAllVehiclesQueryHandler struct {
@@ -23,6 +24,11 @@ func (h *GetAllVehiclesQueryHandler) Handle(ctx context.Context, q query.Query)
 		return nil, fmt.Errorf("invalid query type for GetAllVehiclesQueryHandler")
 	}
 
+	units, err := valueobject.NewUnitSystem(allQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
 	vehicles, err := h.vehicleRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find vehicles: %w", err)
@@ -30,23 +36,7 @@ func (h *GetAllVehiclesQueryHandler) Handle(ctx context.Context, q query.Query)
 
 	var responses []*dto.VehicleResponse
 	for _, vehicle := range vehicles {
-		responses = append(responses, &dto.VehicleResponse{
-			ID:            vehicle.ID().String(),
-			RefID:         vehicle.RefID(),
-			VIN:           vehicle.VIN(),
-			VehicleName:   vehicle.VehicleName(),
-			VehicleModel:  vehicle.VehicleModel(),
-			LicenseNumber: vehicle.LicenseNumber().String(),
-			Status:        string(vehicle.Status()),
-			Latitude:      vehicle.CurrentLocation().Latitude(),
-			Longitude:     vehicle.CurrentLocation().Longitude(),
-			Altitude:      vehicle.CurrentLocation().Altitude(),
-			Mileage:       vehicle.Mileage().Kilometers(),
-			FuelLevel:     vehicle.FuelLevel().Percentage(),
-			Version:       vehicle.Version().Value(),
-			CreatedAt:     vehicle.CreatedAt(),
-			UpdatedAt:     vehicle.UpdatedAt(),
-		})
+		responses = append(responses, toVehicleResponse(vehicle, units))
 	}
 
 	return responses, nil
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type GetDriverChangeHistoryQueryHandler struct {
+	changeHistoryRepo repository.VehicleChangeHistoryRepository
+}
+
+func NewGetDriverChangeHistoryQueryHandler(changeHistoryRepo repository.VehicleChangeHistoryRepository) *GetDriverChangeHistoryQueryHandler {
+	return &GetDriverChangeHistoryQueryHandler{changeHistoryRepo: changeHistoryRepo}
+}
+
+func (h *GetDriverChangeHistoryQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	query, ok := q.(*query.GetDriverChangeHistoryQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetDriverChangeHistoryQueryHandler")
+	}
+
+	histories, err := h.changeHistoryRepo.FindByDriverID(ctx, query.DriverID, query.Limit, query.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]dto.VehicleChangeRecord, len(histories))
+	for i, history := range histories {
+		changes[i] = dto.VehicleChangeRecord{
+			ID:         history.ID,
+			VehicleID:  history.VehicleID,
+			VIN:        history.VIN,
+			DriverID:   history.DriverID,
+			ChangeType: history.ChangeType,
+			OldValue:   history.OldValue,
+			NewValue:   history.NewValue,
+			ChangedAt:  history.ChangedAt.String(),
+			Version:    history.Version,
+		}
+	}
+
+	return &dto.DriverChangeHistoryResponse{
+		DriverID: query.DriverID,
+		Changes:  changes,
+		Total:    len(changes),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+type GetFuelProfileQueryHandler struct {
+	profileRepo repository.FuelProfileRepository
+}
+
+func NewGetFuelProfileQueryHandler(profileRepo repository.FuelProfileRepository) *GetFuelProfileQueryHandler {
+	return &GetFuelProfileQueryHandler{profileRepo: profileRepo}
+}
+
+// Handle returns the profile in effect for the model, which is the defaults
+// when none has been configured.
+func (h *GetFuelProfileQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetFuelProfileQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetFuelProfileQueryHandler")
+	}
+
+	profile, err := h.profileRepo.FindByVehicleModel(ctx, getQuery.VehicleModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fuel profile: %w", err)
+	}
+	if profile == nil {
+		response := toFuelProfileResponse(entity.DefaultFuelProfile(tenant.ID(ctx), getQuery.VehicleModel))
+		response.IsDefault = true
+		return response, nil
+	}
+
+	return toFuelProfileResponse(profile), nil
+}
+
+func toFuelProfileResponse(profile *entity.FuelProfile) *dto.FuelProfileResponse {
+	response := &dto.FuelProfileResponse{
+		VehicleModel:              profile.VehicleModel(),
+		TankCapacityLiters:        profile.TankCapacityLiters(),
+		RefuelThresholdPercent:    profile.RefuelThresholdPercent(),
+		DropThresholdPercent:      profile.DropThresholdPercent(),
+		ConsumptionLitersPer100Km: profile.ConsumptionLitersPer100Km(),
+		ConsumptionTolerance:      profile.ConsumptionTolerance(),
+		LowLevelPercent:           profile.LowLevelPercent(),
+		Version:                   profile.Version().Value(),
+	}
+	if !profile.UpdatedAt().IsZero() {
+		updatedAt := profile.UpdatedAt().UTC()
+		response.UpdatedAt = &updatedAt
+	}
+
+	return response
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetGeofenceQueryHandler struct {
+	geofenceRepo repository.GeofenceRepository
+}
+
+func NewGetGeofenceQueryHandler(geofenceRepo repository.GeofenceRepository) *GetGeofenceQueryHandler {
+	return &GetGeofenceQueryHandler{geofenceRepo: geofenceRepo}
+}
+
+func (h *GetGeofenceQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetGeofenceQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetGeofenceQueryHandler")
+	}
+
+	geofenceID, err := valueobject.NewGeofenceID(getQuery.GeofenceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geofence id: %w", err)
+	}
+
+	geofence, err := h.geofenceRepo.FindByID(ctx, geofenceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofence: %w", err)
+	}
+
+	return toGeofenceResponse(geofence), nil
+}
+
+func toGeofenceResponse(geofence *entity.Geofence) *dto.GeofenceResponse {
+	response := &dto.GeofenceResponse{
+		ID:            geofence.ID().String(),
+		Name:          geofence.Name(),
+		Category:      string(geofence.Category()),
+		Shape:         string(geofence.Shape()),
+		SpeedLimitKmh: geofence.SpeedLimitKmh(),
+		Version:       geofence.Version().Value(),
+		CreatedAt:     geofence.CreatedAt(),
+		UpdatedAt:     geofence.UpdatedAt(),
+	}
+
+	switch geofence.Shape() {
+	case valueobject.ShapeCircle:
+		response.Center = &dto.CoordinateDTO{
+			Latitude:  geofence.Center().Latitude(),
+			Longitude: geofence.Center().Longitude(),
+		}
+		response.RadiusMeters = geofence.RadiusMeters()
+	case valueobject.ShapePolygon:
+		for _, vertex := range geofence.Polygon() {
+			response.Polygon = append(response.Polygon, dto.CoordinateDTO{
+				Latitude:  vertex.Latitude(),
+				Longitude: vertex.Longitude(),
+			})
+		}
+	}
+
+	return response
+}
@@ -2,45 +2,62 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
 
+// GetVehicleChangeHistoryQueryHandler answers with the vehicle's change
+// history and, from the location store, its locations over the requested
+// range at a resolution picked for it.
 type GetVehicleChangeHistoryQueryHandler struct {
 	changeHistoryRepo repository.VehicleChangeHistoryRepository
 	vehicleRepo       repository.VehicleRepository
+	locationRepo      repository.LocationPointRepository
+	retention         valueobject.LocationRetention
 }
 
-func NewGetVehicleChangeHistoryQueryHandler(changeHistoryRepo repository.VehicleChangeHistoryRepository, vehicleRepo repository.VehicleRepository) *GetVehicleChangeHistoryQueryHandler {
+func NewGetVehicleChangeHistoryQueryHandler(
+	changeHistoryRepo repository.VehicleChangeHistoryRepository,
+	vehicleRepo repository.VehicleRepository,
+	locationRepo repository.LocationPointRepository,
+	retention valueobject.LocationRetention,
+) *GetVehicleChangeHistoryQueryHandler {
 	return &GetVehicleChangeHistoryQueryHandler{
 		changeHistoryRepo: changeHistoryRepo,
 		vehicleRepo:       vehicleRepo,
+		locationRepo:      locationRepo,
+		retention:         retention,
 	}
 }
 
 func (h *GetVehicleChangeHistoryQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
-	query := q.(*query.GetVehicleChangeHistoryQuery)
+	historyQuery := q.(*query.GetVehicleChangeHistoryQuery)
 
-	vehicleID, err := valueobject.NewVehicleID(query.VehicleID)
+	vehicleID, err := valueobject.NewVehicleID(historyQuery.VehicleID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid vehicle id: %w", err)
 	}
 
 	existingVehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if errors.Is(err, repository.ErrVehicleNotFound) {
+		return nil, fmt.Errorf("%w: %s", query.ErrVehicleNotFound, historyQuery.VehicleID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
 	refID := existingVehicle.RefID()
 	if refID == "" {
-		refID = query.VehicleID
+		refID = historyQuery.VehicleID
 	}
 
-	histories, err := h.changeHistoryRepo.FindByVehicleID(ctx, refID, query.Limit, query.Offset)
+	histories, err := h.changeHistoryRepo.FindByVehicleID(ctx, refID, historyQuery.Limit, historyQuery.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +68,7 @@ func (h *GetVehicleChangeHistoryQueryHandler) Handle(ctx context.Context, q quer
 			ID:         history.ID,
 			VehicleID:  history.VehicleID,
 			VIN:        history.VIN,
+			DriverID:   history.DriverID,
 			ChangeType: history.ChangeType,
 			OldValue:   history.OldValue,
 			NewValue:   history.NewValue,
@@ -59,9 +77,29 @@ func (h *GetVehicleChangeHistoryQueryHandler) Handle(ctx context.Context, q quer
 		}
 	}
 
+	locations, err := readLocations(ctx, h.locationRepo, h.retention, historyQuery.VehicleID, refID,
+		historyQuery.From, historyQuery.To, historyQuery.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := []*dto.LocationPointResponse{}
+	err = h.locationRepo.EachArchivedBetween(ctx, refID, historyQuery.From, historyQuery.To, func(point *entity.LocationPoint) error {
+		if len(archived) == query.MaxLocationPoints {
+			return errEnoughPoints
+		}
+		archived = append(archived, toLocationPointResponse(point))
+		return nil
+	})
+	if err != nil && !errors.Is(err, errEnoughPoints) {
+		return nil, fmt.Errorf("failed to read archived location points: %w", err)
+	}
+
 	return &dto.VehicleChangeHistoryResponse{
-		VehicleID: query.VehicleID,
-		Changes:   changes,
-		Total:     len(changes),
+		VehicleID:         historyQuery.VehicleID,
+		Changes:           changes,
+		Total:             len(changes),
+		Locations:         locations,
+		ArchivedLocations: archived,
 	}, nil
 }
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// stubVehicleRepo finds a single vehicle projection.
+type stubVehicleRepo struct {
+	repository.VehicleRepository
+	vehicle *entity.Vehicle
+}
+
+func (r *stubVehicleRepo) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
+	if r.vehicle.ID() != id {
+		return nil, repository.ErrVehicleNotFound
+	}
+	return r.vehicle, nil
+}
+
+func (r *stubVehicleRepo) FindByRefID(ctx context.Context, refID string) (*entity.Vehicle, error) {
+	if r.vehicle.RefID() != refID {
+		return nil, repository.ErrVehicleNotFound
+	}
+	return r.vehicle, nil
+}
+
+func (r *stubVehicleRepo) Save(ctx context.Context, vehicle *entity.Vehicle) error {
+	r.vehicle = vehicle
+	return nil
+}
+
+// stubHistoryRepo returns the same change history for any vehicle.
+type stubHistoryRepo struct {
+	repository.VehicleChangeHistoryRepository
+	histories []*entity.VehicleChangeHistory
+	archived  map[string]bool
+}
+
+func (r *stubHistoryRepo) SetArchived(ctx context.Context, vehicleID string, archived bool) error {
+	if r.archived == nil {
+		r.archived = make(map[string]bool)
+	}
+	r.archived[vehicleID] = archived
+	return nil
+}
+
+func (r *stubHistoryRepo) FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error) {
+	return r.histories, nil
+}
+
+func newStubVehicle(t *testing.T, refID string) *entity.Vehicle {
+	t.Helper()
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 106, 0, 0)
+	require.NoError(t, err)
+	mileage, err := valueobject.NewMileage(0)
+	require.NoError(t, err)
+	fuel, err := valueobject.NewFuelLevel(50)
+	require.NoError(t, err)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), "fleet-a", refID, "1HGBH41JXMN109186",
+		"Truck", "Model", license, valueobject.StatusActive, location, mileage, fuel, valueobject.EnergyICE, 0, nil)
+	require.NoError(t, err)
+	return vehicle
+}
+
+func TestGetVehicleChangeHistory_ReadsLocationsAtResolutionForRange(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	vehicle := newStubVehicle(t, "vehicle-1")
+	onTrack := entity.NewLocationPoint("fleet-a", "vehicle-1", now.Add(-time.Hour), 10, 106, 0, nil, nil)
+	outOfOrder := entity.NewLocationPoint("fleet-a", "vehicle-1", now.Add(-2*time.Hour), 9, 105, 0, nil, nil)
+	outOfOrder.Archived = true
+	locationRepo := &stubLocationRepo{points: []recordedPoint{{point: onTrack}, {point: outOfOrder}}}
+	historyRepo := &stubHistoryRepo{histories: []*entity.VehicleChangeHistory{
+		entity.NewVehicleChangeHistory("fleet-a", "vehicle-1", "1HGBH41JXMN109186", "", "status_changed", nil, nil, 2),
+	}}
+	retention := valueobject.LocationRetention{Raw: 7 * 24 * time.Hour, Minute: 90 * 24 * time.Hour}
+	h := NewGetVehicleChangeHistoryQueryHandler(historyRepo, &stubVehicleRepo{vehicle: vehicle}, locationRepo, retention)
+
+	result, err := h.Handle(context.Background(), &query.GetVehicleChangeHistoryQuery{
+		VehicleID: vehicle.ID().String(), Limit: 50, From: now.Add(-3 * time.Hour), To: now,
+	})
+	require.NoError(t, err)
+	history := result.(*dto.VehicleChangeHistoryResponse)
+	require.Len(t, history.Changes, 1)
+	assert.Equal(t, string(valueobject.ResolutionRaw), history.Locations.Resolution)
+	require.Len(t, history.Locations.Points, 1)
+	assert.Equal(t, onTrack.Timestamp, history.Locations.Points[0].Timestamp)
+	require.Len(t, history.ArchivedLocations, 1)
+	assert.Equal(t, outOfOrder.Timestamp, history.ArchivedLocations[0].Timestamp)
+
+	// A month back is read from the minute rollups.
+	_, err = h.Handle(context.Background(), &query.GetVehicleChangeHistoryQuery{
+		VehicleID: vehicle.ID().String(), Limit: 50, From: now.Add(-30 * 24 * time.Hour), To: now.Add(-29 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ResolutionMinute, locationRepo.resolutions[len(locationRepo.resolutions)-1])
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehicleGeofencesQueryHandler struct {
+	vehicleRepo  repository.VehicleRepository
+	geofenceRepo repository.GeofenceRepository
+	stateRepo    repository.VehicleGeofenceStateRepository
+}
+
+func NewGetVehicleGeofencesQueryHandler(
+	vehicleRepo repository.VehicleRepository,
+	geofenceRepo repository.GeofenceRepository,
+	stateRepo repository.VehicleGeofenceStateRepository,
+) *GetVehicleGeofencesQueryHandler {
+	return &GetVehicleGeofencesQueryHandler{
+		vehicleRepo:  vehicleRepo,
+		geofenceRepo: geofenceRepo,
+		stateRepo:    stateRepo,
+	}
+}
+
+func (h *GetVehicleGeofencesQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetVehicleGeofencesQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleGeofencesQueryHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(getQuery.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	refID := vehicle.RefID()
+	if refID == "" {
+		refID = getQuery.VehicleID
+	}
+
+	response := &dto.VehicleGeofencesResponse{
+		VehicleID: getQuery.VehicleID,
+		Geofences: []*dto.GeofenceResponse{},
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, refID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle geofence state: %w", err)
+	}
+	if state == nil {
+		return response, nil
+	}
+
+	updatedAt := state.UpdatedAt()
+	response.UpdatedAt = &updatedAt
+	for _, id := range state.ZoneIDs() {
+		geofenceID, err := valueobject.NewGeofenceID(id)
+		if err != nil {
+			continue
+		}
+		geofence, err := h.geofenceRepo.FindByID(ctx, geofenceID)
+		if err != nil {
+			// Zone was deleted since the last position report.
+			continue
+		}
+		response.Geofences = append(response.Geofences, toGeofenceResponse(geofence))
+	}
+
+	return response, nil
+}
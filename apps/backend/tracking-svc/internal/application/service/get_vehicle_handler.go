@@ -6,6 +6,7 @@ import (
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
@@ -24,6 +25,11 @@ func (h *GetVehicleQueryHandler) Handle(ctx context.Context, q query.Query) (que
 		return nil, fmt.Errorf("invalid query type for GetVehicleQueryHandler")
 	}
 
+	units, err := valueobject.NewUnitSystem(getQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
 	vehicleID, err := valueobject.NewVehicleID(getQuery.VehicleID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid vehicle id: %w", err)
@@ -34,21 +40,61 @@ func (h *GetVehicleQueryHandler) Handle(ctx context.Context, q query.Query) (que
 		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
+	return toVehicleResponse(vehicle, units), nil
+}
+
+func toVehicleResponse(vehicle *entity.Vehicle, units valueobject.UnitSystem) *dto.VehicleResponse {
 	return &dto.VehicleResponse{
-		ID:            vehicle.ID().String(),
-		RefID:         vehicle.RefID(),
-		VIN:           vehicle.VIN(),
-		VehicleName:   vehicle.VehicleName(),
-		VehicleModel:  vehicle.VehicleModel(),
-		LicenseNumber: vehicle.LicenseNumber().String(),
-		Status:        string(vehicle.Status()),
-		Latitude:      vehicle.CurrentLocation().Latitude(),
-		Longitude:     vehicle.CurrentLocation().Longitude(),
-		Altitude:      vehicle.CurrentLocation().Altitude(),
-		Mileage:       vehicle.Mileage().Kilometers(),
-		FuelLevel:     vehicle.FuelLevel().Percentage(),
-		Version:       vehicle.Version().Value(),
-		CreatedAt:     vehicle.CreatedAt(),
-		UpdatedAt:     vehicle.UpdatedAt(),
-	}, nil
+		ID:             vehicle.ID().String(),
+		RefID:          vehicle.RefID(),
+		VIN:            vehicle.VIN(),
+		VehicleName:    vehicle.VehicleName(),
+		VehicleModel:   vehicle.VehicleModel(),
+		LicenseNumber:  vehicle.LicenseNumber().String(),
+		Status:         string(vehicle.Status()),
+		Latitude:       vehicle.CurrentLocation().Latitude(),
+		Longitude:      vehicle.CurrentLocation().Longitude(),
+		Altitude:       vehicle.CurrentLocation().Altitude(),
+		Mileage:        vehicle.Mileage().In(units),
+		MileageUnit:    units.DistanceUnit(),
+		FuelLevel:      vehicle.FuelLevel().Percentage(),
+		EnergyType:     string(vehicle.EnergyType()),
+		EnergyCapacity: capacityIn(vehicle, units),
+		CapacityUnit:   capacityUnitIn(vehicle, units),
+		ChargingState:  vehicle.ChargingState(),
+		EstimatedRange: rangeIn(vehicle, units),
+		DriverID:       vehicle.CurrentDriverID(),
+		Version:        vehicle.Version().Value(),
+		CreatedAt:      vehicle.CreatedAt(),
+		UpdatedAt:      vehicle.UpdatedAt(),
+		ArchivedAt:     vehicle.ArchivedAt(),
+		Group:          vehicle.Group(),
+		Tags:           vehicle.Tags(),
+		Attributes:     vehicle.Attributes(),
+	}
+}
+
+// capacityIn returns the tank capacity in the client's unit system. Battery
+// capacity is always kWh.
+func capacityIn(vehicle *entity.Vehicle, units valueobject.UnitSystem) float64 {
+	if vehicle.EnergyType() == valueobject.EnergyBEV {
+		return vehicle.EnergyCapacity()
+	}
+	return units.FromLiters(vehicle.EnergyCapacity())
+}
+
+func capacityUnitIn(vehicle *entity.Vehicle, units valueobject.UnitSystem) string {
+	if vehicle.EnergyType() == valueobject.EnergyBEV {
+		return vehicle.EnergyType().CapacityUnit()
+	}
+	return units.VolumeUnit()
+}
+
+func rangeIn(vehicle *entity.Vehicle, units valueobject.UnitSystem) *float64 {
+	km := vehicle.EstimatedRangeKm()
+	if km == nil {
+		return nil
+	}
+	distance := units.FromKilometers(*km)
+	return &distance
 }
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// errEnoughPoints stops reading once a response is full.
+var errEnoughPoints = errors.New("enough points")
+
+type GetVehicleLocationsQueryHandler struct {
+	locationRepo repository.LocationPointRepository
+	vehicleRepo  repository.VehicleRepository
+	retention    valueobject.LocationRetention
+}
+
+func NewGetVehicleLocationsQueryHandler(
+	locationRepo repository.LocationPointRepository,
+	vehicleRepo repository.VehicleRepository,
+	retention valueobject.LocationRetention,
+) *GetVehicleLocationsQueryHandler {
+	return &GetVehicleLocationsQueryHandler{
+		locationRepo: locationRepo,
+		vehicleRepo:  vehicleRepo,
+		retention:    retention,
+	}
+}
+
+func (h *GetVehicleLocationsQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	locationsQuery, ok := q.(*query.GetVehicleLocationsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleLocationsQueryHandler")
+	}
+
+	refID, err := resolveRefID(ctx, h.vehicleRepo, locationsQuery.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return readLocations(ctx, h.locationRepo, h.retention, locationsQuery.VehicleID, refID,
+		locationsQuery.From, locationsQuery.To, locationsQuery.Resolution)
+}
+
+// readLocations reads the track of the vehicle with refID within [from, to]
+// at the requested resolution, or at one picked for the range when none is.
+func readLocations(
+	ctx context.Context,
+	locationRepo repository.LocationPointRepository,
+	retention valueobject.LocationRetention,
+	vehicleID string,
+	refID string,
+	from, to time.Time,
+	requested string,
+) (*dto.LocationHistoryResponse, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("to must not be before from")
+	}
+
+	resolution := retention.Resolution(from, to, time.Now())
+	if requested != "" {
+		parsed, err := valueobject.NewLocationResolution(requested)
+		if err != nil {
+			return nil, err
+		}
+		resolution = parsed
+	}
+
+	response := &dto.LocationHistoryResponse{
+		VehicleID:  vehicleID,
+		From:       from,
+		To:         to,
+		Resolution: string(resolution),
+		Points:     []*dto.LocationPointResponse{},
+	}
+
+	err := locationRepo.EachBetween(ctx, refID, resolution, from, to, func(point *entity.LocationPoint) error {
+		if len(response.Points) == query.MaxLocationPoints {
+			response.Truncated = true
+			return errEnoughPoints
+		}
+		response.Points = append(response.Points, toLocationPointResponse(point))
+		return nil
+	})
+	if err != nil && !errors.Is(err, errEnoughPoints) {
+		return nil, fmt.Errorf("failed to read location points: %w", err)
+	}
+
+	return response, nil
+}
+
+func toLocationPointResponse(point *entity.LocationPoint) *dto.LocationPointResponse {
+	return &dto.LocationPointResponse{
+		Latitude:    point.Latitude,
+		Longitude:   point.Longitude,
+		Altitude:    point.Altitude,
+		Timestamp:   point.Timestamp,
+		SpeedKmh:    point.SpeedKmh,
+		Heading:     point.Heading,
+		Count:       point.Count,
+		MaxSpeedKmh: point.MaxSpeedKmh,
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehiclePlaybackQueryHandler struct {
+	locationRepo repository.LocationPointRepository
+	vehicleRepo  repository.VehicleRepository
+	retention    valueobject.LocationRetention
+}
+
+func NewGetVehiclePlaybackQueryHandler(
+	locationRepo repository.LocationPointRepository,
+	vehicleRepo repository.VehicleRepository,
+	retention valueobject.LocationRetention,
+) *GetVehiclePlaybackQueryHandler {
+	return &GetVehiclePlaybackQueryHandler{
+		locationRepo: locationRepo,
+		vehicleRepo:  vehicleRepo,
+		retention:    retention,
+	}
+}
+
+// Handle estimates a position every IntervalSeconds from From to To. The
+// fixes in between are streamed in order and each frame is placed between the
+// two fixes around it, so memory follows the number of frames rather than the
+// number of fixes.
+func (h *GetVehiclePlaybackQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	playbackQuery, ok := q.(*query.GetVehiclePlaybackQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehiclePlaybackQueryHandler")
+	}
+
+	from, to := playbackQuery.From.Unix(), playbackQuery.To.Unix()
+	interval := playbackQuery.IntervalSeconds
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive: %d", interval)
+	}
+	if to < from {
+		return nil, fmt.Errorf("to must not be before from")
+	}
+	if (to-from)/interval+1 > query.MaxPlaybackFrames {
+		return nil, fmt.Errorf("playback is limited to %d frames; use a longer interval", query.MaxPlaybackFrames)
+	}
+
+	refID, err := resolveRefID(ctx, h.vehicleRepo, playbackQuery.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PlaybackResponse{
+		VehicleID:       playbackQuery.VehicleID,
+		From:            playbackQuery.From,
+		To:              playbackQuery.To,
+		IntervalSeconds: interval,
+		Frames:          []*dto.PositionResponse{},
+	}
+
+	resolution := h.retention.Resolution(playbackQuery.From, playbackQuery.To, time.Now())
+	before, _, err := h.locationRepo.FindAround(ctx, refID, resolution, playbackQuery.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recorded positions: %w", err)
+	}
+	previous := fixFromPoint(before)
+	next := from
+
+	// emitUntil adds the frames before the fix following previous, or all
+	// remaining frames when there is none.
+	emitUntil := func(following *valueobject.TrackPoint) {
+		for ; next <= to && (following == nil || next < following.Timestamp()); next += interval {
+			position, ok := entity.EstimatePosition(previous, following, next)
+			if !ok {
+				continue
+			}
+			response.Frames = append(response.Frames, toPositionResponse(position))
+		}
+	}
+
+	err = h.locationRepo.EachBetween(ctx, refID, resolution, playbackQuery.From, playbackQuery.To,
+		func(point *entity.LocationPoint) error {
+			fix := fixFromPoint(point)
+			if fix == nil {
+				return nil
+			}
+			emitUntil(fix)
+			previous = fix
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recorded positions: %w", err)
+	}
+
+	_, after, err := h.locationRepo.FindAround(ctx, refID, resolution, playbackQuery.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recorded positions: %w", err)
+	}
+	emitUntil(fixFromPoint(after))
+	emitUntil(nil)
+
+	return response, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehiclePositionQueryHandler struct {
+	locationRepo repository.LocationPointRepository
+	vehicleRepo  repository.VehicleRepository
+	retention    valueobject.LocationRetention
+}
+
+func NewGetVehiclePositionQueryHandler(
+	locationRepo repository.LocationPointRepository,
+	vehicleRepo repository.VehicleRepository,
+	retention valueobject.LocationRetention,
+) *GetVehiclePositionQueryHandler {
+	return &GetVehiclePositionQueryHandler{
+		locationRepo: locationRepo,
+		vehicleRepo:  vehicleRepo,
+		retention:    retention,
+	}
+}
+
+func (h *GetVehiclePositionQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	positionQuery, ok := q.(*query.GetVehiclePositionQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehiclePositionQueryHandler")
+	}
+
+	refID, err := resolveRefID(ctx, h.vehicleRepo, positionQuery.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older moments are only kept as rollups, which the estimate's gap and
+	// confidence reflect.
+	resolution := h.retention.Resolution(positionQuery.At, positionQuery.At, time.Now())
+	before, after, err := h.locationRepo.FindAround(ctx, refID, resolution, positionQuery.At)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recorded positions: %w", err)
+	}
+
+	position, ok := entity.EstimatePosition(fixFromPoint(before), fixFromPoint(after), positionQuery.At.Unix())
+	if !ok {
+		return nil, query.ErrNoRecordedPosition
+	}
+
+	return &dto.VehiclePositionResponse{
+		VehicleID:        positionQuery.VehicleID,
+		PositionResponse: toPositionResponse(position),
+	}, nil
+}
+
+// resolveRefID maps a projection id to the vehicle-svc id that history and
+// locations are recorded under.
+func resolveRefID(ctx context.Context, vehicleRepo repository.VehicleRepository, id string) (string, error) {
+	vehicleID, err := valueobject.NewVehicleID(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	vehicle, err := vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if refID := vehicle.RefID(); refID != "" {
+		return refID, nil
+	}
+	return id, nil
+}
+
+// fixFromPoint reads a location point as a fix; nil for no point or one
+// without a usable position.
+func fixFromPoint(point *entity.LocationPoint) *valueobject.TrackPoint {
+	if point == nil {
+		return nil
+	}
+	fix, err := valueobject.NewTrackPoint(point.Latitude, point.Longitude, point.Timestamp.Unix())
+	if err != nil {
+		return nil
+	}
+	return &fix
+}
+
+func toPositionResponse(position entity.EstimatedPosition) *dto.PositionResponse {
+	return &dto.PositionResponse{
+		Latitude:   position.Coordinate.Latitude(),
+		Longitude:  position.Coordinate.Longitude(),
+		Timestamp:  time.Unix(position.Timestamp, 0).UTC(),
+		Method:     string(position.Method),
+		Confidence: string(position.Confidence),
+		GapSeconds: position.GapSeconds,
+	}
+}
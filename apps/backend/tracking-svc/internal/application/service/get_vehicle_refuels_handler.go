@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehicleRefuelsQueryHandler struct {
+	refuelRepo  repository.RefuelRepository
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewGetVehicleRefuelsQueryHandler(refuelRepo repository.RefuelRepository, vehicleRepo repository.VehicleRepository) *GetVehicleRefuelsQueryHandler {
+	return &GetVehicleRefuelsQueryHandler{
+		refuelRepo:  refuelRepo,
+		vehicleRepo: vehicleRepo,
+	}
+}
+
+func (h *GetVehicleRefuelsQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	refuelsQuery, ok := q.(*query.GetVehicleRefuelsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleRefuelsQueryHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(refuelsQuery.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	existingVehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	refID := existingVehicle.RefID()
+	if refID == "" {
+		refID = refuelsQuery.VehicleID
+	}
+
+	units, err := valueobject.NewUnitSystem(refuelsQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	refuels, err := h.refuelRepo.FindByVehicleID(ctx, refID, refuelsQuery.Limit, refuelsQuery.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.RefuelResponse, 0, len(refuels))
+	for _, refuel := range refuels {
+		responses = append(responses, &dto.RefuelResponse{
+			ID:           refuel.ID().String(),
+			VehicleID:    refuel.VehicleID(),
+			FromLevel:    refuel.FromLevel(),
+			ToLevel:      refuel.ToLevel(),
+			VolumeLiters: refuel.VolumeLiters(),
+			Volume:       units.FromLiters(refuel.VolumeLiters()),
+			VolumeUnit:   units.VolumeUnit(),
+			Mileage:      units.FromKilometers(refuel.Mileage()),
+			MileageUnit:  units.DistanceUnit(),
+			OccurredAt:   refuel.OccurredAt().UTC(),
+		})
+	}
+
+	return &dto.VehicleRefuelsResponse{
+		VehicleID: refuelsQuery.VehicleID,
+		Refuels:   responses,
+		Total:     len(responses),
+	}, nil
+}
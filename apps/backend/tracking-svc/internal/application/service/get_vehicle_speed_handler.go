@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehicleSpeedQueryHandler struct {
+	vehicleRepo repository.VehicleRepository
+	stateRepo   repository.VehicleSpeedStateRepository
+}
+
+func NewGetVehicleSpeedQueryHandler(
+	vehicleRepo repository.VehicleRepository,
+	stateRepo repository.VehicleSpeedStateRepository,
+) *GetVehicleSpeedQueryHandler {
+	return &GetVehicleSpeedQueryHandler{
+		vehicleRepo: vehicleRepo,
+		stateRepo:   stateRepo,
+	}
+}
+
+func (h *GetVehicleSpeedQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetVehicleSpeedQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleSpeedQueryHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(getQuery.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	refID := vehicle.RefID()
+	if refID == "" {
+		refID = getQuery.VehicleID
+	}
+
+	response := &dto.VehicleSpeedResponse{VehicleID: getQuery.VehicleID}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, refID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle speed state: %w", err)
+	}
+	if state == nil {
+		return response, nil
+	}
+
+	updatedAt := state.UpdatedAt()
+	response.SpeedLimitKmh = state.SpeedLimitKmh()
+	response.Speeding = state.Speeding()
+	response.UpdatedAt = &updatedAt
+
+	return response, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehicleTripsQueryHandler struct {
+	tripRepo    repository.TripRepository
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewGetVehicleTripsQueryHandler(tripRepo repository.TripRepository, vehicleRepo repository.VehicleRepository) *GetVehicleTripsQueryHandler {
+	return &GetVehicleTripsQueryHandler{
+		tripRepo:    tripRepo,
+		vehicleRepo: vehicleRepo,
+	}
+}
+
+func (h *GetVehicleTripsQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	tripsQuery, ok := q.(*query.GetVehicleTripsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleTripsQueryHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(tripsQuery.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	existingVehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	refID := existingVehicle.RefID()
+	if refID == "" {
+		refID = tripsQuery.VehicleID
+	}
+
+	units, err := valueobject.NewUnitSystem(tripsQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	trips, err := h.tripRepo.FindByVehicleID(ctx, refID, tripsQuery.From, tripsQuery.To, tripsQuery.Limit, tripsQuery.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.TripResponse, 0, len(trips))
+	for _, trip := range trips {
+		responses = append(responses, toTripResponse(trip, units))
+	}
+
+	return &dto.VehicleTripsResponse{
+		VehicleID: tripsQuery.VehicleID,
+		Trips:     responses,
+		Total:     len(responses),
+	}, nil
+}
+
+func toTripResponse(trip *entity.Trip, units valueobject.UnitSystem) *dto.TripResponse {
+	response := &dto.TripResponse{
+		ID:              trip.ID().String(),
+		VehicleID:       trip.VehicleID(),
+		Status:          string(trip.Status()),
+		StartPoint:      toTrackPointDTO(trip.StartPoint()),
+		EndPoint:        toTrackPointDTO(trip.EndPoint()),
+		StartedAt:       time.Unix(trip.StartedAt(), 0).UTC(),
+		DistanceMeters:  trip.DistanceMeters(),
+		Distance:        units.FromKilometers(trip.DistanceMeters() / 1000),
+		DistanceUnit:    units.DistanceUnit(),
+		DurationSeconds: trip.DurationSeconds(),
+		MaxSpeedKmh:     trip.MaxSpeedKmh(),
+	}
+	if endedAt := trip.EndedAt(); endedAt != nil {
+		t := time.Unix(*endedAt, 0).UTC()
+		response.EndedAt = &t
+	}
+
+	response.Polyline = make([]dto.TrackPointDTO, 0, len(trip.Polyline()))
+	for _, point := range trip.Polyline() {
+		response.Polyline = append(response.Polyline, toTrackPointDTO(point))
+	}
+
+	return response
+}
+
+func toTrackPointDTO(point valueobject.TrackPoint) dto.TrackPointDTO {
+	return dto.TrackPointDTO{
+		Latitude:  point.Latitude(),
+		Longitude: point.Longitude(),
+		Timestamp: time.Unix(point.Timestamp(), 0).UTC(),
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// maxBoxSpanDegrees keeps a box inside one hemisphere, beyond which a GeoJSON
+// polygon no longer describes the area between its corners.
+const maxBoxSpanDegrees = 180
+
+type GetVehiclesInBoxQueryHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewGetVehiclesInBoxQueryHandler(vehicleRepo repository.VehicleRepository) *GetVehiclesInBoxQueryHandler {
+	return &GetVehiclesInBoxQueryHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *GetVehiclesInBoxQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	boxQuery, ok := q.(*query.GetVehiclesInBoxQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehiclesInBoxQueryHandler")
+	}
+
+	units, err := valueobject.NewUnitSystem(boxQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	southWest, err := valueobject.NewCoordinate(boxQuery.MinLatitude, boxQuery.MinLongitude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid south-west corner: %w", err)
+	}
+	northEast, err := valueobject.NewCoordinate(boxQuery.MaxLatitude, boxQuery.MaxLongitude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid north-east corner: %w", err)
+	}
+	if southWest.Latitude() >= northEast.Latitude() || southWest.Longitude() >= northEast.Longitude() {
+		return nil, fmt.Errorf("box minimums must be below its maximums")
+	}
+	if northEast.Longitude()-southWest.Longitude() >= maxBoxSpanDegrees {
+		return nil, fmt.Errorf("box must span less than %d degrees of longitude", maxBoxSpanDegrees)
+	}
+
+	status, err := optionalStatus(boxQuery.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles, err := h.vehicleRepo.FindInBox(ctx, southWest, northEast, status, boxQuery.Limit, boxQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicles: %w", err)
+	}
+
+	responses := make([]*dto.VehicleResponse, 0, len(vehicles))
+	for _, vehicle := range vehicles {
+		responses = append(responses, toVehicleResponse(vehicle, units))
+	}
+
+	return responses, nil
+}
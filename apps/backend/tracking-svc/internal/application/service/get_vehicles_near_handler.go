@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GetVehiclesNearQueryHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewGetVehiclesNearQueryHandler(vehicleRepo repository.VehicleRepository) *GetVehiclesNearQueryHandler {
+	return &GetVehiclesNearQueryHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *GetVehiclesNearQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	nearQuery, ok := q.(*query.GetVehiclesNearQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehiclesNearQueryHandler")
+	}
+
+	units, err := valueobject.NewUnitSystem(nearQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	center, err := valueobject.NewCoordinate(nearQuery.Latitude, nearQuery.Longitude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid position: %w", err)
+	}
+	if nearQuery.RadiusMeters <= 0 {
+		return nil, fmt.Errorf("radius must be positive: %f", nearQuery.RadiusMeters)
+	}
+
+	status, err := optionalStatus(nearQuery.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.vehicleRepo.FindNear(ctx, center, nearQuery.RadiusMeters, status, nearQuery.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicles: %w", err)
+	}
+
+	responses := make([]*dto.NearbyVehicleResponse, 0, len(found))
+	for _, result := range found {
+		responses = append(responses, &dto.NearbyVehicleResponse{
+			VehicleResponse: toVehicleResponse(result.Vehicle, units),
+			DistanceMeters:  result.DistanceMeters,
+		})
+	}
+
+	return responses, nil
+}
+
+// optionalStatus parses a status filter, where empty matches any status.
+func optionalStatus(status string) (valueobject.VehicleStatus, error) {
+	if status == "" {
+		return "", nil
+	}
+	return valueobject.NewVehicleStatus(status)
+}
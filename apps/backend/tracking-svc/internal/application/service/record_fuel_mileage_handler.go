@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+type RecordFuelMileageCommandHandler struct {
+	stateRepo repository.VehicleFuelStateRepository
+}
+
+func NewRecordFuelMileageCommandHandler(stateRepo repository.VehicleFuelStateRepository) *RecordFuelMileageCommandHandler {
+	return &RecordFuelMileageCommandHandler{stateRepo: stateRepo}
+}
+
+func (h *RecordFuelMileageCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	recordCmd, ok := cmd.(*command.RecordFuelMileageCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for RecordFuelMileageCommandHandler")
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, recordCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle fuel state: %w", err)
+	}
+	if state == nil {
+		state = entity.NewVehicleFuelState(tenant.ID(ctx), recordCmd.VehicleID)
+	}
+
+	if !state.RecordMileage(recordCmd.Mileage) {
+		return nil
+	}
+
+	if err := h.stateRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save vehicle fuel state: %w", err)
+	}
+
+	return nil
+}
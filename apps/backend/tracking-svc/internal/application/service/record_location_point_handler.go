@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+type RecordLocationPointCommandHandler struct {
+	locationRepo repository.LocationPointRepository
+}
+
+func NewRecordLocationPointCommandHandler(locationRepo repository.LocationPointRepository) *RecordLocationPointCommandHandler {
+	return &RecordLocationPointCommandHandler{locationRepo: locationRepo}
+}
+
+func (h *RecordLocationPointCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	recordCmd, ok := cmd.(*command.RecordLocationPointCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for RecordLocationPointCommandHandler")
+	}
+
+	point := entity.NewLocationPoint(
+		tenant.ID(ctx),
+		recordCmd.VehicleID,
+		time.Unix(recordCmd.Timestamp, 0),
+		recordCmd.Latitude,
+		recordCmd.Longitude,
+		recordCmd.Altitude,
+		recordCmd.SpeedKmh,
+		recordCmd.Heading,
+	)
+	point.Archived = recordCmd.Archived
+
+	if err := h.locationRepo.Save(ctx, point); err != nil {
+		return fmt.Errorf("failed to save location point: %w", err)
+	}
+
+	return nil
+}
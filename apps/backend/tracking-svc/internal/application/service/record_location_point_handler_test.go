@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+func TestRecordLocationPoint_KeepsArchivedFlag(t *testing.T) {
+	repo := &stubLocationRepo{}
+	h := NewRecordLocationPointCommandHandler(repo)
+	ctx := tenant.WithID(context.Background(), "fleet-a")
+	deviceTime := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	require.NoError(t, h.Handle(ctx, &command.RecordLocationPointCommand{
+		VehicleID: "vehicle-1", Latitude: 10, Longitude: 106, Timestamp: deviceTime.Unix(),
+	}))
+	require.NoError(t, h.Handle(ctx, &command.RecordLocationPointCommand{
+		VehicleID: "vehicle-1", Latitude: 9, Longitude: 105, Timestamp: deviceTime.Add(-time.Hour).Unix(), Archived: true,
+	}))
+
+	require.Len(t, repo.points, 2)
+	assert.False(t, repo.points[0].point.Archived)
+	assert.True(t, repo.points[1].point.Archived)
+	assert.Equal(t, "fleet-a", repo.points[1].point.TenantID)
+	assert.Equal(t, deviceTime.Add(-time.Hour), repo.points[1].point.Timestamp)
+}
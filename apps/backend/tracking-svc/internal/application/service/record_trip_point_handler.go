@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type RecordTripPointCommandHandler struct {
+	tripRepo          repository.TripRepository
+	motionRepo        repository.VehicleMotionStateRepository
+	stationaryTimeout time.Duration
+	minMovementMeters float64
+}
+
+func NewRecordTripPointCommandHandler(
+	tripRepo repository.TripRepository,
+	motionRepo repository.VehicleMotionStateRepository,
+	stationaryTimeout time.Duration,
+	minMovementMeters float64,
+) *RecordTripPointCommandHandler {
+	return &RecordTripPointCommandHandler{
+		tripRepo:          tripRepo,
+		motionRepo:        motionRepo,
+		stationaryTimeout: stationaryTimeout,
+		minMovementMeters: minMovementMeters,
+	}
+}
+
+func (h *RecordTripPointCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	recordCmd, ok := cmd.(*command.RecordTripPointCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for RecordTripPointCommandHandler")
+	}
+
+	point, err := valueobject.NewTrackPoint(recordCmd.Latitude, recordCmd.Longitude, recordCmd.Timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid position: %w", err)
+	}
+
+	motion, err := h.motionRepo.FindByVehicleID(ctx, recordCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle motion state: %w", err)
+	}
+	if motion == nil {
+		motion = entity.NewVehicleMotionState(tenant.ID(ctx), recordCmd.VehicleID)
+	}
+
+	if !motion.Accept(point) {
+		return nil
+	}
+
+	trip, err := h.tripRepo.FindOpenByVehicleID(ctx, recordCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find open trip: %w", err)
+	}
+
+	if trip != nil && trip.IdleSince(point.Timestamp()) >= h.stationaryTimeout {
+		trip.Complete()
+		if err := h.tripRepo.Save(ctx, trip); err != nil {
+			return fmt.Errorf("failed to save trip: %w", err)
+		}
+		motion.Park(trip.EndPoint())
+		trip = nil
+	}
+
+	if trip != nil {
+		if trip.Extend(point, h.minMovementMeters) {
+			if err := h.tripRepo.Save(ctx, trip); err != nil {
+				return fmt.Errorf("failed to save trip: %w", err)
+			}
+		}
+	} else if departure := motion.Depart(point, h.minMovementMeters); departure != nil {
+		trip, err = entity.StartTrip(valueobject.GenerateTripID(), tenant.ID(ctx), recordCmd.VehicleID, *departure, point)
+		if err != nil {
+			return err
+		}
+		if err := h.tripRepo.Save(ctx, trip); err != nil {
+			return fmt.Errorf("failed to save trip: %w", err)
+		}
+	}
+
+	if err := h.motionRepo.Save(ctx, motion); err != nil {
+		return fmt.Errorf("failed to save vehicle motion state: %w", err)
+	}
+
+	return nil
+}
@@ -7,14 +7,22 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
 )
 
 type RecordVehicleChangeCommandHandler struct {
 	changeHistoryRepo repository.VehicleChangeHistoryRepository
+	vehicleRepo       repository.VehicleRepository
 }
 
-func NewRecordVehicleChangeCommandHandler(changeHistoryRepo repository.VehicleChangeHistoryRepository) *RecordVehicleChangeCommandHandler {
-	return &RecordVehicleChangeCommandHandler{changeHistoryRepo: changeHistoryRepo}
+func NewRecordVehicleChangeCommandHandler(
+	changeHistoryRepo repository.VehicleChangeHistoryRepository,
+	vehicleRepo repository.VehicleRepository,
+) *RecordVehicleChangeCommandHandler {
+	return &RecordVehicleChangeCommandHandler{
+		changeHistoryRepo: changeHistoryRepo,
+		vehicleRepo:       vehicleRepo,
+	}
 }
 
 func (h *RecordVehicleChangeCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
@@ -23,9 +31,20 @@ func (h *RecordVehicleChangeCommandHandler) Handle(ctx context.Context, cmd comm
 		return fmt.Errorf("invalid command type for RecordVehicleChangeCommandHandler")
 	}
 
+	driverID := recordCmd.DriverID
+	if driverID == "" {
+		// History is still recorded when the projection is missing; it just
+		// won't be attributed to a driver.
+		if vehicle, err := h.vehicleRepo.FindByRefID(ctx, recordCmd.VehicleID); err == nil {
+			driverID = vehicle.CurrentDriverID()
+		}
+	}
+
 	history := entity.NewVehicleChangeHistory(
+		tenant.ID(ctx),
 		recordCmd.VehicleID,
 		recordCmd.VIN,
+		driverID,
 		recordCmd.ChangeType,
 		recordCmd.OldValue,
 		recordCmd.NewValue,
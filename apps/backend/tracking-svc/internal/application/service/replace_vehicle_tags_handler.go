@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type ReplaceVehicleTagsCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewReplaceVehicleTagsCommandHandler(vehicleRepo repository.VehicleRepository) *ReplaceVehicleTagsCommandHandler {
+	return &ReplaceVehicleTagsCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *ReplaceVehicleTagsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	tagsCmd, ok := cmd.(*command.ReplaceVehicleTagsCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for ReplaceVehicleTagsCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, tagsCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if !vehicle.ReplaceTags(tagsCmd.Tags) {
+		return nil
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+const (
+	// rollupSettle leaves points that are being saved right now to the next
+	// pass.
+	rollupSettle = 5 * time.Second
+	// maxRollupWindow bounds how many points one pass holds buckets for when
+	// catching up after downtime.
+	maxRollupWindow = time.Hour
+)
+
+// RollUpLocationsCommandHandler merges raw points into the minute and hour
+// rollups by when they were saved rather than by device time, so points a
+// device delivers late still reach the buckets they belong to.
+type RollUpLocationsCommandHandler struct {
+	locationRepo repository.LocationPointRepository
+}
+
+func NewRollUpLocationsCommandHandler(locationRepo repository.LocationPointRepository) *RollUpLocationsCommandHandler {
+	return &RollUpLocationsCommandHandler{locationRepo: locationRepo}
+}
+
+func (h *RollUpLocationsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	if _, ok := cmd.(*command.RollUpLocationsCommand); !ok {
+		return fmt.Errorf("invalid command type for RollUpLocationsCommandHandler")
+	}
+
+	progress, err := h.locationRepo.FindRollupProgress(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find rollup progress: %w", err)
+	}
+
+	for {
+		// A pass that did not finish is repeated over the same window, which
+		// skips the rollups it already merged into.
+		caughtUp := false
+		if progress.Pending.IsZero() {
+			end := time.Now().Add(-rollupSettle).UTC()
+			caughtUp = true
+			if !progress.Done.IsZero() && end.Sub(progress.Done) > maxRollupWindow {
+				end, caughtUp = progress.Done.Add(maxRollupWindow), false
+			}
+			if !end.After(progress.Done) {
+				return nil
+			}
+			claimed := repository.RollupProgress{Done: progress.Done, Pending: end}
+			ok, err := h.locationRepo.SwapRollupProgress(ctx, progress, claimed)
+			if err != nil {
+				return fmt.Errorf("failed to save rollup progress: %w", err)
+			}
+			if !ok {
+				// Another replica started a pass since; it rolls up these points.
+				return nil
+			}
+			progress = claimed
+		}
+
+		if err := h.rollUp(ctx, progress.Done, progress.Pending); err != nil {
+			return err
+		}
+		next := repository.RollupProgress{Done: progress.Pending}
+		ok, err := h.locationRepo.SwapRollupProgress(ctx, progress, next)
+		if err != nil {
+			return fmt.Errorf("failed to save rollup progress: %w", err)
+		}
+		if !ok {
+			// Another replica repeating the same pass finished it first.
+			return nil
+		}
+		if caughtUp {
+			return nil
+		}
+		progress = next
+	}
+}
+
+func (h *RollUpLocationsCommandHandler) rollUp(ctx context.Context, from, to time.Time) error {
+	rollups := []*entity.LocationRollup{
+		entity.NewLocationRollup(valueobject.ResolutionMinute),
+		entity.NewLocationRollup(valueobject.ResolutionHour),
+	}
+
+	err := h.locationRepo.EachRecordedBetween(ctx, from, to, func(point *entity.LocationPoint) error {
+		for _, rollup := range rollups {
+			rollup.Add(point)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read location points: %w", err)
+	}
+
+	for _, rollup := range rollups {
+		if err := h.locationRepo.MergeRollups(ctx, rollup.Resolution(), rollup.Points(), to); err != nil {
+			return fmt.Errorf("failed to merge %s rollups: %w", rollup.Resolution(), err)
+		}
+	}
+	return nil
+}
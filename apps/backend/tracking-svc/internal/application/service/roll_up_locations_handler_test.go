@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// recordedPoint is a raw point with when it was saved.
+type recordedPoint struct {
+	point      *entity.LocationPoint
+	recordedAt time.Time
+}
+
+// stubLocationRepo keeps raw points and rollup progress in memory and
+// records each pass.
+type stubLocationRepo struct {
+	repository.LocationPointRepository
+	points        []recordedPoint
+	done, pending time.Time
+	windows       [][2]time.Time
+	merged        map[valueobject.LocationResolution][]*entity.LocationPoint
+	resolutions   []valueobject.LocationResolution // read by EachBetween
+	archived      map[string]bool                  // by vehicle, set by SetVehicleArchived
+	taken         *repository.RollupProgress
+}
+
+func (r *stubLocationRepo) SetVehicleArchived(ctx context.Context, vehicleID string, archived bool) error {
+	if r.archived == nil {
+		r.archived = make(map[string]bool)
+	}
+	r.archived[vehicleID] = archived
+	return nil
+}
+
+func (r *stubLocationRepo) Save(ctx context.Context, point *entity.LocationPoint) error {
+	r.points = append(r.points, recordedPoint{point: point, recordedAt: time.Now().UTC()})
+	return nil
+}
+
+func (r *stubLocationRepo) EachBetween(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	r.resolutions = append(r.resolutions, resolution)
+	return r.each(vehicleID, false, from, to, fn)
+}
+
+func (r *stubLocationRepo) EachArchivedBetween(ctx context.Context, vehicleID string, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	return r.each(vehicleID, true, from, to, fn)
+}
+
+func (r *stubLocationRepo) each(vehicleID string, archived bool, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	for _, p := range r.points {
+		if p.point.VehicleID != vehicleID || p.point.Archived != archived || p.point.Timestamp.Before(from) || p.point.Timestamp.After(to) {
+			continue
+		}
+		if err := fn(p.point); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *stubLocationRepo) EachRecordedBetween(ctx context.Context, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	r.windows = append(r.windows, [2]time.Time{from, to})
+	for _, p := range r.points {
+		if p.recordedAt.After(from) && !p.recordedAt.After(to) {
+			if err := fn(p.point); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *stubLocationRepo) MergeRollups(ctx context.Context, resolution valueobject.LocationResolution, rollups []*entity.LocationPoint, run time.Time) error {
+	if r.merged == nil {
+		r.merged = make(map[valueobject.LocationResolution][]*entity.LocationPoint)
+	}
+	r.merged[resolution] = append(r.merged[resolution], rollups...)
+	return nil
+}
+
+func (r *stubLocationRepo) FindRollupProgress(ctx context.Context) (repository.RollupProgress, error) {
+	return repository.RollupProgress{Done: r.done, Pending: r.pending}, nil
+}
+
+// SwapRollupProgress lets taken stand for the progress another replica wrote
+// after this one read it.
+func (r *stubLocationRepo) SwapRollupProgress(ctx context.Context, old, next repository.RollupProgress) (bool, error) {
+	if r.taken != nil {
+		r.done, r.pending = r.taken.Done, r.taken.Pending
+		r.taken = nil
+	}
+	if !old.Done.Equal(r.done) || !old.Pending.Equal(r.pending) {
+		return false, nil
+	}
+	r.done, r.pending = next.Done, next.Pending
+	return true, nil
+}
+
+func TestRollUpLocations_MergesNewPointsIntoBothResolutions(t *testing.T) {
+	now := time.Now().UTC()
+	deviceTime := now.Add(-3 * time.Hour).Truncate(time.Hour)
+	repo := &stubLocationRepo{
+		done: now.Add(-10 * time.Minute),
+		points: []recordedPoint{
+			// Rolled up by an earlier pass.
+			{entity.NewLocationPoint("fleet-a", "vehicle-1", deviceTime, 10, 106, 0, nil, nil), now.Add(-20 * time.Minute)},
+			// Delivered late: device time hours back, saved just now.
+			{entity.NewLocationPoint("fleet-a", "vehicle-1", deviceTime.Add(10*time.Second), 10.1, 106.1, 0, nil, nil), now.Add(-time.Minute)},
+			{entity.NewLocationPoint("fleet-a", "vehicle-1", deviceTime.Add(70*time.Second), 10.2, 106.2, 0, nil, nil), now.Add(-time.Minute)},
+		},
+	}
+
+	h := NewRollUpLocationsCommandHandler(repo)
+	require.NoError(t, h.Handle(context.Background(), &command.RollUpLocationsCommand{}))
+
+	require.Len(t, repo.merged[valueobject.ResolutionMinute], 2)
+	require.Len(t, repo.merged[valueobject.ResolutionHour], 1)
+	hour := repo.merged[valueobject.ResolutionHour][0]
+	assert.Equal(t, 2, hour.Count)
+	assert.Equal(t, 10.2, hour.Latitude)
+
+	assert.True(t, repo.pending.IsZero())
+	assert.WithinDuration(t, now.Add(-rollupSettle), repo.done, time.Second)
+}
+
+func TestRollUpLocations_RepeatsUnfinishedPassThenCatchesUp(t *testing.T) {
+	now := time.Now().UTC()
+	done := now.Add(-150 * time.Minute)
+	pending := done.Add(30 * time.Minute)
+	repo := &stubLocationRepo{done: done, pending: pending}
+
+	h := NewRollUpLocationsCommandHandler(repo)
+	require.NoError(t, h.Handle(context.Background(), &command.RollUpLocationsCommand{}))
+
+	require.Len(t, repo.windows, 3)
+	assert.Equal(t, [2]time.Time{done, pending}, repo.windows[0])
+	assert.Equal(t, [2]time.Time{pending, pending.Add(maxRollupWindow)}, repo.windows[1])
+	assert.Equal(t, pending.Add(maxRollupWindow), repo.windows[2][0])
+	assert.WithinDuration(t, now.Add(-rollupSettle), repo.windows[2][1], time.Second)
+	assert.True(t, repo.pending.IsZero())
+}
+
+func TestRollUpLocations_LeavesPassToReplicaThatClaimedItFirst(t *testing.T) {
+	now := time.Now().UTC()
+	done := now.Add(-10 * time.Minute)
+	other := repository.RollupProgress{Done: done, Pending: now.Add(-time.Minute)}
+	repo := &stubLocationRepo{
+		done:   done,
+		taken:  &other,
+		points: []recordedPoint{{entity.NewLocationPoint("fleet-a", "vehicle-1", now, 10, 106, 0, nil, nil), now.Add(-2 * time.Minute)}},
+	}
+
+	h := NewRollUpLocationsCommandHandler(repo)
+	require.NoError(t, h.Handle(context.Background(), &command.RollUpLocationsCommand{}))
+
+	assert.Empty(t, repo.windows)
+	assert.Empty(t, repo.merged)
+	assert.Equal(t, other.Pending, repo.pending)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type SetVehicleSpeedLimitCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	stateRepo   repository.VehicleSpeedStateRepository
+}
+
+func NewSetVehicleSpeedLimitCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	stateRepo repository.VehicleSpeedStateRepository,
+) *SetVehicleSpeedLimitCommandHandler {
+	return &SetVehicleSpeedLimitCommandHandler{
+		vehicleRepo: vehicleRepo,
+		stateRepo:   stateRepo,
+	}
+}
+
+func (h *SetVehicleSpeedLimitCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	setCmd, ok := cmd.(*command.SetVehicleSpeedLimitCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for SetVehicleSpeedLimitCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(setCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	refID := vehicle.RefID()
+	if refID == "" {
+		refID = setCmd.VehicleID
+	}
+
+	state, err := h.stateRepo.FindByVehicleID(ctx, refID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle speed state: %w", err)
+	}
+	if state == nil {
+		state = entity.NewVehicleSpeedState(tenant.ID(ctx), refID)
+	}
+
+	if err := state.SetSpeedLimit(setCmd.SpeedLimitKmh); err != nil {
+		return err
+	}
+
+	if err := h.stateRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save vehicle speed state: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type UnarchiveVehicleCommandHandler struct {
+	vehicleRepo       repository.VehicleRepository
+	changeHistoryRepo repository.VehicleChangeHistoryRepository
+	locationRepo      repository.LocationPointRepository
+}
+
+func NewUnarchiveVehicleCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	changeHistoryRepo repository.VehicleChangeHistoryRepository,
+	locationRepo repository.LocationPointRepository,
+) *UnarchiveVehicleCommandHandler {
+	return &UnarchiveVehicleCommandHandler{vehicleRepo: vehicleRepo, changeHistoryRepo: changeHistoryRepo, locationRepo: locationRepo}
+}
+
+func (h *UnarchiveVehicleCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	unarchiveCmd, ok := cmd.(*command.UnarchiveVehicleCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UnarchiveVehicleCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, unarchiveCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if vehicle.Unarchive() {
+		if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+			return err
+		}
+	}
+
+	if err := h.changeHistoryRepo.SetArchived(ctx, unarchiveCmd.VehicleID, false); err != nil {
+		return err
+	}
+	return h.locationRepo.SetVehicleArchived(ctx, unarchiveCmd.VehicleID, false)
+}
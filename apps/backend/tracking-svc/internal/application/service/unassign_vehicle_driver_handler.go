@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+)
+
+type UnassignVehicleDriverCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewUnassignVehicleDriverCommandHandler(vehicleRepo repository.VehicleRepository) *UnassignVehicleDriverCommandHandler {
+	return &UnassignVehicleDriverCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *UnassignVehicleDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	unassignCmd, ok := cmd.(*command.UnassignVehicleDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UnassignVehicleDriverCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, unassignCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if vehicle.CurrentDriverID() != unassignCmd.DriverID {
+		return nil
+	}
+
+	if err := vehicle.UnassignDriver(unassignCmd.DriverID); err != nil {
+		return err
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
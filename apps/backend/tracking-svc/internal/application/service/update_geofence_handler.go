@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type UpdateGeofenceCommandHandler struct {
+	geofenceRepo repository.GeofenceRepository
+}
+
+func NewUpdateGeofenceCommandHandler(geofenceRepo repository.GeofenceRepository) *UpdateGeofenceCommandHandler {
+	return &UpdateGeofenceCommandHandler{geofenceRepo: geofenceRepo}
+}
+
+func (h *UpdateGeofenceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	updateCmd, ok := cmd.(*command.UpdateGeofenceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateGeofenceCommandHandler")
+	}
+
+	geofenceID, err := valueobject.NewGeofenceID(updateCmd.GeofenceID)
+	if err != nil {
+		return fmt.Errorf("invalid geofence id: %w", err)
+	}
+	category, err := valueobject.NewGeofenceCategory(updateCmd.Category)
+	if err != nil {
+		return err
+	}
+	shape, err := valueobject.NewGeofenceShape(updateCmd.Shape)
+	if err != nil {
+		return err
+	}
+
+	var center valueobject.Coordinate
+	if shape == valueobject.ShapeCircle {
+		center, err = valueobject.NewCoordinate(updateCmd.Center.Latitude, updateCmd.Center.Longitude)
+		if err != nil {
+			return fmt.Errorf("invalid center: %w", err)
+		}
+	}
+	polygon, err := toCoordinates(updateCmd.Polygon)
+	if err != nil {
+		return err
+	}
+
+	geofence, err := h.geofenceRepo.FindByID(ctx, geofenceID)
+	if err != nil {
+		return fmt.Errorf("failed to find geofence: %w", err)
+	}
+
+	if err := geofence.Redefine(updateCmd.Name, category, shape, center, updateCmd.RadiusMeters, polygon); err != nil {
+		return err
+	}
+	if err := geofence.SetSpeedLimit(updateCmd.SpeedLimitKmh); err != nil {
+		return err
+	}
+
+	if err := h.geofenceRepo.Save(ctx, geofence); err != nil {
+		return fmt.Errorf("failed to save geofence: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type UpdateVehicleDetailsCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewUpdateVehicleDetailsCommandHandler(vehicleRepo repository.VehicleRepository) *UpdateVehicleDetailsCommandHandler {
+	return &UpdateVehicleDetailsCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *UpdateVehicleDetailsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	detailsCmd, ok := cmd.(*command.UpdateVehicleDetailsCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateVehicleDetailsCommandHandler")
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, detailsCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	vehicleName := vehicle.VehicleName()
+	if detailsCmd.VehicleName != "" {
+		vehicleName = detailsCmd.VehicleName
+	}
+	vehicleModel := vehicle.VehicleModel()
+	if detailsCmd.VehicleModel != "" {
+		vehicleModel = detailsCmd.VehicleModel
+	}
+	licenseNumber := vehicle.LicenseNumber()
+	if detailsCmd.LicenseNumber != "" {
+		licenseNumber, err = valueobject.NewLicenseNumber(detailsCmd.LicenseNumber)
+		if err != nil {
+			return fmt.Errorf("invalid license number: %w", err)
+		}
+	}
+
+	detailsChanged := vehicle.UpdateDetails(vehicleName, vehicleModel, licenseNumber)
+	attributesChanged := vehicle.ApplyAttributeChanges(detailsCmd.Attributes)
+	if !detailsChanged && !attributesChanged {
+		return nil
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
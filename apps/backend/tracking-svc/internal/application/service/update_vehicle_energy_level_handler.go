@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type UpdateVehicleEnergyLevelCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewUpdateVehicleEnergyLevelCommandHandler(vehicleRepo repository.VehicleRepository) *UpdateVehicleEnergyLevelCommandHandler {
+	return &UpdateVehicleEnergyLevelCommandHandler{vehicleRepo: vehicleRepo}
+}
+
+func (h *UpdateVehicleEnergyLevelCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	energyCmd, ok := cmd.(*command.UpdateVehicleEnergyLevelCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateVehicleEnergyLevelCommandHandler")
+	}
+
+	level, err := valueobject.NewFuelLevel(energyCmd.Level)
+	if err != nil {
+		return fmt.Errorf("invalid energy level: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByRefID(ctx, energyCmd.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if !vehicle.UpdateEnergyLevel(level, energyCmd.ChargingState, energyCmd.EstimatedRangeKm) {
+		return nil
+	}
+
+	return h.vehicleRepo.Save(ctx, vehicle)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+type UpsertFuelProfileCommandHandler struct {
+	profileRepo repository.FuelProfileRepository
+}
+
+func NewUpsertFuelProfileCommandHandler(profileRepo repository.FuelProfileRepository) *UpsertFuelProfileCommandHandler {
+	return &UpsertFuelProfileCommandHandler{profileRepo: profileRepo}
+}
+
+func (h *UpsertFuelProfileCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	upsertCmd, ok := cmd.(*command.UpsertFuelProfileCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpsertFuelProfileCommandHandler")
+	}
+
+	profile, err := h.profileRepo.FindByVehicleModel(ctx, upsertCmd.VehicleModel)
+	if err != nil {
+		return fmt.Errorf("failed to find fuel profile: %w", err)
+	}
+
+	if profile == nil {
+		profile, err = entity.NewFuelProfile(
+			tenant.ID(ctx),
+			upsertCmd.VehicleModel,
+			upsertCmd.TankCapacityLiters,
+			upsertCmd.RefuelThresholdPercent,
+			upsertCmd.DropThresholdPercent,
+			upsertCmd.ConsumptionLitersPer100Km,
+			upsertCmd.ConsumptionTolerance,
+			upsertCmd.LowLevelPercent,
+		)
+	} else {
+		err = profile.Update(
+			upsertCmd.TankCapacityLiters,
+			upsertCmd.RefuelThresholdPercent,
+			upsertCmd.DropThresholdPercent,
+			upsertCmd.ConsumptionLitersPer100Km,
+			upsertCmd.ConsumptionTolerance,
+			upsertCmd.LowLevelPercent,
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := h.profileRepo.Save(ctx, profile); err != nil {
+		return fmt.Errorf("failed to save fuel profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// Defaults used for vehicle models without a configured fuel profile.
+const (
+	DefaultTankCapacityLiters        = 60.0
+	DefaultRefuelThresholdPercent    = 5.0
+	DefaultDropThresholdPercent      = 3.0
+	DefaultConsumptionLitersPer100Km = 12.0
+	DefaultConsumptionTolerance      = 2.0
+	DefaultLowLevelPercent           = 15.0
+)
+
+// FuelProfile holds the per-vehicle-model thresholds used to classify fuel
+// level jumps and to warn of low energy. Percentages are of tank capacity, or
+// battery capacity for an electric model.
+type FuelProfile struct {
+	tenantID                  string
+	vehicleModel              string
+	tankCapacityLiters        float64
+	refuelThresholdPercent    float64
+	dropThresholdPercent      float64
+	consumptionLitersPer100Km float64
+	consumptionTolerance      float64
+	lowLevelPercent           float64 // 0 disables low energy alerts
+	version                   valueobject.Version
+	createdAt                 time.Time
+	updatedAt                 time.Time
+}
+
+func NewFuelProfile(
+	tenantID string,
+	vehicleModel string,
+	tankCapacityLiters float64,
+	refuelThresholdPercent float64,
+	dropThresholdPercent float64,
+	consumptionLitersPer100Km float64,
+	consumptionTolerance float64,
+	lowLevelPercent float64,
+) (*FuelProfile, error) {
+	if vehicleModel == "" {
+		return nil, fmt.Errorf("vehicle model cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	p := &FuelProfile{
+		tenantID:     tenantID,
+		vehicleModel: vehicleModel,
+		createdAt:    now,
+	}
+	if err := p.Update(tankCapacityLiters, refuelThresholdPercent, dropThresholdPercent, consumptionLitersPer100Km, consumptionTolerance, lowLevelPercent); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// DefaultFuelProfile is used when no profile is configured for a model. It is
+// never persisted.
+func DefaultFuelProfile(tenantID, vehicleModel string) *FuelProfile {
+	return &FuelProfile{
+		tenantID:                  tenantID,
+		vehicleModel:              vehicleModel,
+		tankCapacityLiters:        DefaultTankCapacityLiters,
+		refuelThresholdPercent:    DefaultRefuelThresholdPercent,
+		dropThresholdPercent:      DefaultDropThresholdPercent,
+		consumptionLitersPer100Km: DefaultConsumptionLitersPer100Km,
+		consumptionTolerance:      DefaultConsumptionTolerance,
+		lowLevelPercent:           DefaultLowLevelPercent,
+	}
+}
+
+func (p *FuelProfile) VehicleModel() string {
+	return p.vehicleModel
+}
+
+func (p *FuelProfile) TenantID() string {
+	return p.tenantID
+}
+
+func (p *FuelProfile) TankCapacityLiters() float64 {
+	return p.tankCapacityLiters
+}
+
+func (p *FuelProfile) RefuelThresholdPercent() float64 {
+	return p.refuelThresholdPercent
+}
+
+func (p *FuelProfile) DropThresholdPercent() float64 {
+	return p.dropThresholdPercent
+}
+
+func (p *FuelProfile) ConsumptionLitersPer100Km() float64 {
+	return p.consumptionLitersPer100Km
+}
+
+func (p *FuelProfile) ConsumptionTolerance() float64 {
+	return p.consumptionTolerance
+}
+
+func (p *FuelProfile) LowLevelPercent() float64 {
+	return p.lowLevelPercent
+}
+
+func (p *FuelProfile) Version() valueobject.Version {
+	return p.version
+}
+
+func (p *FuelProfile) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *FuelProfile) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *FuelProfile) Update(
+	tankCapacityLiters float64,
+	refuelThresholdPercent float64,
+	dropThresholdPercent float64,
+	consumptionLitersPer100Km float64,
+	consumptionTolerance float64,
+	lowLevelPercent float64,
+) error {
+	if tankCapacityLiters <= 0 {
+		return fmt.Errorf("tank capacity must be positive: %f", tankCapacityLiters)
+	}
+	if refuelThresholdPercent <= 0 || refuelThresholdPercent > 100 {
+		return fmt.Errorf("refuel threshold must be between 0 and 100: %f", refuelThresholdPercent)
+	}
+	if dropThresholdPercent <= 0 || dropThresholdPercent > 100 {
+		return fmt.Errorf("drop threshold must be between 0 and 100: %f", dropThresholdPercent)
+	}
+	if consumptionLitersPer100Km <= 0 {
+		return fmt.Errorf("consumption must be positive: %f", consumptionLitersPer100Km)
+	}
+	if consumptionTolerance < 1 {
+		return fmt.Errorf("consumption tolerance must be at least 1: %f", consumptionTolerance)
+	}
+	if lowLevelPercent < 0 || lowLevelPercent >= 100 {
+		return fmt.Errorf("low level threshold must be between 0 and 100: %f", lowLevelPercent)
+	}
+
+	p.tankCapacityLiters = tankCapacityLiters
+	p.refuelThresholdPercent = refuelThresholdPercent
+	p.dropThresholdPercent = dropThresholdPercent
+	p.consumptionLitersPer100Km = consumptionLitersPer100Km
+	p.consumptionTolerance = consumptionTolerance
+	p.lowLevelPercent = lowLevelPercent
+	p.updatedAt = time.Now().UTC()
+	p.version = p.version.Next()
+
+	return nil
+}
+
+func LoadFuelProfileFromHistory(
+	tenantID string,
+	vehicleModel string,
+	tankCapacityLiters float64,
+	refuelThresholdPercent float64,
+	dropThresholdPercent float64,
+	consumptionLitersPer100Km float64,
+	consumptionTolerance float64,
+	lowLevelPercent float64,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *FuelProfile {
+	return &FuelProfile{
+		tenantID:                  tenantID,
+		vehicleModel:              vehicleModel,
+		tankCapacityLiters:        tankCapacityLiters,
+		refuelThresholdPercent:    refuelThresholdPercent,
+		dropThresholdPercent:      dropThresholdPercent,
+		consumptionLitersPer100Km: consumptionLitersPer100Km,
+		consumptionTolerance:      consumptionTolerance,
+		lowLevelPercent:           lowLevelPercent,
+		version:                   version,
+		createdAt:                 createdAt,
+		updatedAt:                 updatedAt,
+	}
+}
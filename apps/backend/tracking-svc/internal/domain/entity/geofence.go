@@ -0,0 +1,262 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+const earthRadiusMeters = 6371000.0
+
+// Geofence is a named zone, either a circle around a center point or a simple
+// polygon. Polygons are tested on the lat/lng plane, which is accurate enough
+// for depot- and site-sized zones. A zone may carry a speed limit that applies
+// to every vehicle inside it.
+type Geofence struct {
+	id           valueobject.GeofenceID
+	tenantID     string
+	name         string
+	category     valueobject.GeofenceCategory
+	shape        valueobject.GeofenceShape
+	center       valueobject.Coordinate
+	radiusMeters float64
+	polygon      []valueobject.Coordinate
+	speedLimit   float64 // km/h, 0 for none
+	version      valueobject.Version
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewCircleGeofence(
+	id valueobject.GeofenceID,
+	tenantID string,
+	name string,
+	category valueobject.GeofenceCategory,
+	center valueobject.Coordinate,
+	radiusMeters float64,
+) (*Geofence, error) {
+	if err := validateCircle(name, radiusMeters); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &Geofence{
+		id:           id,
+		tenantID:     tenantID,
+		name:         name,
+		category:     category,
+		shape:        valueobject.ShapeCircle,
+		center:       center,
+		radiusMeters: radiusMeters,
+		version:      valueobject.Version{},
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+func NewPolygonGeofence(
+	id valueobject.GeofenceID,
+	tenantID string,
+	name string,
+	category valueobject.GeofenceCategory,
+	polygon []valueobject.Coordinate,
+) (*Geofence, error) {
+	if err := validatePolygon(name, polygon); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &Geofence{
+		id:        id,
+		tenantID:  tenantID,
+		name:      name,
+		category:  category,
+		shape:     valueobject.ShapePolygon,
+		polygon:   polygon,
+		version:   valueobject.Version{},
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func (g *Geofence) ID() valueobject.GeofenceID {
+	return g.id
+}
+
+func (g *Geofence) TenantID() string {
+	return g.tenantID
+}
+
+func (g *Geofence) Name() string {
+	return g.name
+}
+
+func (g *Geofence) Category() valueobject.GeofenceCategory {
+	return g.category
+}
+
+func (g *Geofence) Shape() valueobject.GeofenceShape {
+	return g.shape
+}
+
+func (g *Geofence) Center() valueobject.Coordinate {
+	return g.center
+}
+
+func (g *Geofence) RadiusMeters() float64 {
+	return g.radiusMeters
+}
+
+func (g *Geofence) Polygon() []valueobject.Coordinate {
+	return g.polygon
+}
+
+// SpeedLimitKmh is 0 when the zone has no speed limit.
+func (g *Geofence) SpeedLimitKmh() float64 {
+	return g.speedLimit
+}
+
+// SetSpeedLimit sets the zone's speed limit; 0 removes it. It is applied
+// together with creating or redefining the zone and is saved with it.
+func (g *Geofence) SetSpeedLimit(kmh float64) error {
+	if kmh < 0 {
+		return fmt.Errorf("speed limit cannot be negative: %f", kmh)
+	}
+	g.speedLimit = kmh
+	return nil
+}
+
+func (g *Geofence) Version() valueobject.Version {
+	return g.version
+}
+
+func (g *Geofence) CreatedAt() time.Time {
+	return g.createdAt
+}
+
+func (g *Geofence) UpdatedAt() time.Time {
+	return g.updatedAt
+}
+
+func (g *Geofence) Redefine(
+	name string,
+	category valueobject.GeofenceCategory,
+	shape valueobject.GeofenceShape,
+	center valueobject.Coordinate,
+	radiusMeters float64,
+	polygon []valueobject.Coordinate,
+) error {
+	switch shape {
+	case valueobject.ShapeCircle:
+		if err := validateCircle(name, radiusMeters); err != nil {
+			return err
+		}
+		polygon = nil
+	case valueobject.ShapePolygon:
+		if err := validatePolygon(name, polygon); err != nil {
+			return err
+		}
+		center = valueobject.Coordinate{}
+		radiusMeters = 0
+	default:
+		return fmt.Errorf("invalid geofence shape: %s", shape)
+	}
+
+	g.name = name
+	g.category = category
+	g.shape = shape
+	g.center = center
+	g.radiusMeters = radiusMeters
+	g.polygon = polygon
+	g.updatedAt = time.Now().UTC()
+	g.version = g.version.Next()
+
+	return nil
+}
+
+func (g *Geofence) Contains(point valueobject.Coordinate) bool {
+	switch g.shape {
+	case valueobject.ShapeCircle:
+		return haversineMeters(g.center, point) <= g.radiusMeters
+	case valueobject.ShapePolygon:
+		return polygonContains(g.polygon, point)
+	default:
+		return false
+	}
+}
+
+func validateCircle(name string, radiusMeters float64) error {
+	if name == "" {
+		return fmt.Errorf("geofence name cannot be empty")
+	}
+	if radiusMeters <= 0 {
+		return fmt.Errorf("circle radius must be positive: %f", radiusMeters)
+	}
+	return nil
+}
+
+func validatePolygon(name string, polygon []valueobject.Coordinate) error {
+	if name == "" {
+		return fmt.Errorf("geofence name cannot be empty")
+	}
+	if len(polygon) < 3 {
+		return fmt.Errorf("polygon needs at least 3 vertices, got %d", len(polygon))
+	}
+	return nil
+}
+
+func haversineMeters(a, b valueobject.Coordinate) float64 {
+	lat1 := a.Latitude() * math.Pi / 180
+	lat2 := b.Latitude() * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude() - a.Longitude()) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// polygonContains is the even-odd ray casting test.
+func polygonContains(polygon []valueobject.Coordinate, point valueobject.Coordinate) bool {
+	inside := false
+	x, y := point.Longitude(), point.Latitude()
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		xi, yi := polygon[i].Longitude(), polygon[i].Latitude()
+		xj, yj := polygon[j].Longitude(), polygon[j].Latitude()
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func LoadGeofenceFromHistory(
+	id valueobject.GeofenceID,
+	tenantID string,
+	name string,
+	category valueobject.GeofenceCategory,
+	shape valueobject.GeofenceShape,
+	center valueobject.Coordinate,
+	radiusMeters float64,
+	polygon []valueobject.Coordinate,
+	speedLimitKmh float64,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *Geofence {
+	return &Geofence{
+		id:           id,
+		tenantID:     tenantID,
+		name:         name,
+		category:     category,
+		shape:        shape,
+		center:       center,
+		radiusMeters: radiusMeters,
+		polygon:      polygon,
+		speedLimit:   speedLimitKmh,
+		version:      version,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func testCoordinate(t *testing.T, lat, lng float64) valueobject.Coordinate {
+	t.Helper()
+	c, err := valueobject.NewCoordinate(lat, lng)
+	require.NoError(t, err)
+	return c
+}
+
+func TestCircleGeofenceContains(t *testing.T) {
+	zone, err := NewCircleGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Depot", valueobject.CategoryDepot,
+		testCoordinate(t, 10.7769, 106.7009), 500)
+	require.NoError(t, err)
+
+	assert.True(t, zone.Contains(testCoordinate(t, 10.7780, 106.7015)))
+	assert.False(t, zone.Contains(testCoordinate(t, 10.7900, 106.7009)))
+}
+
+func TestPolygonGeofenceContains(t *testing.T) {
+	zone, err := NewPolygonGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Yard", valueobject.CategoryCustomerSite,
+		[]valueobject.Coordinate{
+			testCoordinate(t, 0, 0),
+			testCoordinate(t, 0, 1),
+			testCoordinate(t, 1, 1),
+			testCoordinate(t, 1, 0),
+		})
+	require.NoError(t, err)
+
+	assert.True(t, zone.Contains(testCoordinate(t, 0.5, 0.5)))
+	assert.False(t, zone.Contains(testCoordinate(t, 1.5, 0.5)))
+}
+
+func TestNewGeofenceRejectsInvalidShape(t *testing.T) {
+	_, err := NewCircleGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Depot", valueobject.CategoryDepot,
+		testCoordinate(t, 0, 0), 0)
+	assert.Error(t, err)
+
+	_, err = NewPolygonGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Yard", valueobject.CategoryOther,
+		[]valueobject.Coordinate{testCoordinate(t, 0, 0), testCoordinate(t, 0, 1)})
+	assert.Error(t, err)
+}
+
+func TestVehicleGeofenceStateMove(t *testing.T) {
+	zone, err := NewCircleGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Depot", valueobject.CategoryDepot,
+		testCoordinate(t, 10.7769, 106.7009), 500)
+	require.NoError(t, err)
+	zones := []*Geofence{zone}
+
+	state := NewVehicleGeofenceState("fleet-a", "vehicle-1")
+
+	// First fix only establishes membership.
+	assert.True(t, state.Move(testCoordinate(t, 10.7769, 106.7009), 100, zones))
+	assert.Equal(t, []string{zone.ID().String()}, state.ZoneIDs())
+	assert.Empty(t, state.UncommittedEvents())
+
+	assert.True(t, state.Move(testCoordinate(t, 10.8000, 106.7009), 200, zones))
+	events := state.UncommittedEvents()
+	require.Len(t, events, 1)
+	exited, ok := events[0].(*event.GeofenceExitedEvent)
+	require.True(t, ok)
+	assert.Equal(t, zone.ID().String(), exited.GeofenceID)
+	assert.Equal(t, "vehicle-1", exited.VehicleID)
+
+	// Out-of-order fixes are ignored.
+	assert.False(t, state.Move(testCoordinate(t, 10.7769, 106.7009), 150, zones))
+	assert.Empty(t, state.UncommittedEvents())
+
+	assert.True(t, state.Move(testCoordinate(t, 10.7769, 106.7009), 300, zones))
+	events = state.UncommittedEvents()
+	require.Len(t, events, 1)
+	_, ok = events[0].(*event.GeofenceEnteredEvent)
+	assert.True(t, ok)
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// LocationPoint is a recorded position of a vehicle, or a rollup standing for
+// every position recorded within a minute or an hour. A rollup carries the
+// last position of its bucket, so it lies on the track the vehicle drove.
+type LocationPoint struct {
+	TenantID  string
+	VehicleID string    // vehicle-svc vehicle id
+	Timestamp time.Time // device time
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	SpeedKmh  *float64
+	Heading   *float64
+	// Count is how many recorded points the rollup stands for; 1 for a
+	// recorded point.
+	Count int
+	// MaxSpeedKmh is the highest speed among them, nil when none reported one.
+	MaxSpeedKmh *float64
+	// Archived marks a point that arrived after a newer one had already
+	// moved the vehicle. It is kept but is not part of the track: rollups,
+	// tracks, playback and positions leave it out.
+	Archived bool
+}
+
+func NewLocationPoint(
+	tenantID string,
+	vehicleID string,
+	timestamp time.Time,
+	latitude float64,
+	longitude float64,
+	altitude float64,
+	speedKmh *float64,
+	heading *float64,
+) *LocationPoint {
+	return &LocationPoint{
+		TenantID:    tenantID,
+		VehicleID:   vehicleID,
+		Timestamp:   timestamp.UTC(),
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Altitude:    altitude,
+		SpeedKmh:    speedKmh,
+		Heading:     heading,
+		Count:       1,
+		MaxSpeedKmh: speedKmh,
+	}
+}
+
+// Merge folds other, a point or rollup of the same vehicle, into p. p takes
+// the later of the two positions, so merging in any order gives the same
+// rollup.
+func (p *LocationPoint) Merge(other *LocationPoint) {
+	if other.Timestamp.After(p.Timestamp) {
+		p.Timestamp = other.Timestamp
+		p.Latitude = other.Latitude
+		p.Longitude = other.Longitude
+		p.Altitude = other.Altitude
+		p.SpeedKmh = other.SpeedKmh
+		p.Heading = other.Heading
+	}
+	p.Count += other.Count
+	if other.MaxSpeedKmh != nil && (p.MaxSpeedKmh == nil || *other.MaxSpeedKmh > *p.MaxSpeedKmh) {
+		speed := *other.MaxSpeedKmh
+		p.MaxSpeedKmh = &speed
+	}
+}
+
+type locationBucketKey struct {
+	tenantID  string
+	vehicleID string
+	start     time.Time
+}
+
+// LocationRollup accumulates points into one rollup per vehicle and bucket
+// of its resolution. Memory follows the number of buckets, not of points.
+type LocationRollup struct {
+	resolution valueobject.LocationResolution
+	buckets    map[locationBucketKey]*LocationPoint
+	order      []locationBucketKey
+}
+
+func NewLocationRollup(resolution valueobject.LocationResolution) *LocationRollup {
+	return &LocationRollup{
+		resolution: resolution,
+		buckets:    make(map[locationBucketKey]*LocationPoint),
+	}
+}
+
+func (r *LocationRollup) Add(point *LocationPoint) {
+	key := locationBucketKey{
+		tenantID:  point.TenantID,
+		vehicleID: point.VehicleID,
+		start:     point.Timestamp.Truncate(r.resolution.Bucket()),
+	}
+	if bucket, ok := r.buckets[key]; ok {
+		bucket.Merge(point)
+		return
+	}
+	bucket := *point
+	r.buckets[key] = &bucket
+	r.order = append(r.order, key)
+}
+
+// Points returns the rollups in the order their buckets were first seen.
+func (r *LocationRollup) Points() []*LocationPoint {
+	points := make([]*LocationPoint, len(r.order))
+	for i, key := range r.order {
+		points[i] = r.buckets[key]
+	}
+	return points
+}
+
+func (r *LocationRollup) Resolution() valueobject.LocationResolution {
+	return r.resolution
+}
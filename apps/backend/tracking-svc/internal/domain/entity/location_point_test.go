@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func TestLocationPointMerge_IsOrderIndependent(t *testing.T) {
+	speed := func(kmh float64) *float64 { return &kmh }
+	base := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	points := func() []*LocationPoint {
+		return []*LocationPoint{
+			NewLocationPoint("fleet-a", "vehicle-1", base.Add(10*time.Second), 10.0, 106.0, 0, speed(40), nil),
+			NewLocationPoint("fleet-a", "vehicle-1", base.Add(50*time.Second), 10.2, 106.2, 0, nil, nil),
+			NewLocationPoint("fleet-a", "vehicle-1", base.Add(30*time.Second), 10.1, 106.1, 0, speed(72), nil),
+		}
+	}
+
+	forward := points()
+	for _, point := range forward[1:] {
+		forward[0].Merge(point)
+	}
+	backward := points()
+	for i := len(backward) - 2; i >= 0; i-- {
+		backward[len(backward)-1].Merge(backward[i])
+	}
+
+	for _, rollup := range []*LocationPoint{forward[0], backward[len(backward)-1]} {
+		assert.Equal(t, base.Add(50*time.Second), rollup.Timestamp)
+		assert.Equal(t, 10.2, rollup.Latitude)
+		assert.Nil(t, rollup.SpeedKmh)
+		assert.Equal(t, 3, rollup.Count)
+		require.NotNil(t, rollup.MaxSpeedKmh)
+		assert.Equal(t, 72.0, *rollup.MaxSpeedKmh)
+	}
+}
+
+func TestLocationRollup_GroupsByVehicleAndBucket(t *testing.T) {
+	base := time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)
+	rollup := NewLocationRollup(valueobject.ResolutionMinute)
+
+	first := NewLocationPoint("fleet-a", "vehicle-1", base.Add(5*time.Second), 10.0, 106.0, 0, nil, nil)
+	rollup.Add(first)
+	rollup.Add(NewLocationPoint("fleet-a", "vehicle-1", base.Add(55*time.Second), 10.1, 106.1, 0, nil, nil))
+	rollup.Add(NewLocationPoint("fleet-a", "vehicle-1", base.Add(65*time.Second), 10.2, 106.2, 0, nil, nil))
+	rollup.Add(NewLocationPoint("fleet-a", "vehicle-2", base.Add(20*time.Second), 20.0, 100.0, 0, nil, nil))
+
+	points := rollup.Points()
+	require.Len(t, points, 3)
+	assert.Equal(t, 2, points[0].Count)
+	assert.Equal(t, 10.1, points[0].Latitude)
+	assert.Equal(t, base.Add(65*time.Second), points[1].Timestamp)
+	assert.Equal(t, "vehicle-2", points[2].VehicleID)
+
+	// The points added are left as they were.
+	assert.Equal(t, 1, first.Count)
+	assert.Equal(t, 10.0, first.Latitude)
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+const (
+	// Interpolating across a gap up to highConfidenceGapSeconds is rarely off
+	// by more than a block; up to mediumConfidenceGapSeconds the vehicle may
+	// have turned off the straight line between the fixes.
+	highConfidenceGapSeconds   = 60
+	mediumConfidenceGapSeconds = 300
+)
+
+// EstimatedPosition is where a vehicle is judged to have been at Timestamp.
+// GapSeconds is the span of the fixes it is interpolated between, or the
+// distance in time to the single fix it is held at.
+type EstimatedPosition struct {
+	Coordinate valueobject.Coordinate
+	Timestamp  int64
+	Method     valueobject.PositionMethod
+	Confidence valueobject.PositionConfidence
+	GapSeconds int64
+}
+
+// EstimatePosition places a vehicle at the unix time at from the last fix at
+// or before it and the first fix after it, either of which may be nil.
+// Between two fixes the position is interpolated linearly; before the first
+// or after the last fix it is held at that fix. It returns false when there
+// are no fixes at all.
+func EstimatePosition(before, after *valueobject.TrackPoint, at int64) (EstimatedPosition, bool) {
+	switch {
+	case before != nil && before.Timestamp() == at:
+		return EstimatedPosition{
+			Coordinate: before.Coordinate(),
+			Timestamp:  at,
+			Method:     valueobject.PositionFix,
+			Confidence: valueobject.ConfidenceExact,
+		}, true
+	case before != nil && after != nil:
+		gap := after.Timestamp() - before.Timestamp()
+		fraction := float64(at-before.Timestamp()) / float64(gap)
+		// Take the short way round when the fixes straddle the antimeridian.
+		dLng := after.Longitude() - before.Longitude()
+		if dLng > 180 {
+			dLng -= 360
+		} else if dLng < -180 {
+			dLng += 360
+		}
+		longitude := before.Longitude() + dLng*fraction
+		if longitude > 180 {
+			longitude -= 360
+		} else if longitude < -180 {
+			longitude += 360
+		}
+		coordinate, _ := valueobject.NewCoordinate(
+			before.Latitude()+(after.Latitude()-before.Latitude())*fraction,
+			longitude,
+		)
+		return EstimatedPosition{
+			Coordinate: coordinate,
+			Timestamp:  at,
+			Method:     valueobject.PositionInterpolated,
+			Confidence: confidenceFor(gap),
+			GapSeconds: gap,
+		}, true
+	case before != nil:
+		return heldPosition(*before, at, valueobject.PositionLastKnown, at-before.Timestamp()), true
+	case after != nil:
+		return heldPosition(*after, at, valueobject.PositionFirstKnown, after.Timestamp()-at), true
+	default:
+		return EstimatedPosition{}, false
+	}
+}
+
+// heldPosition keeps the vehicle at a single fix. Nothing says where it went
+// since, so the estimate is never better than medium.
+func heldPosition(fix valueobject.TrackPoint, at int64, method valueobject.PositionMethod, gap int64) EstimatedPosition {
+	confidence := valueobject.ConfidenceLow
+	if gap <= highConfidenceGapSeconds {
+		confidence = valueobject.ConfidenceMedium
+	}
+	return EstimatedPosition{
+		Coordinate: fix.Coordinate(),
+		Timestamp:  at,
+		Method:     method,
+		Confidence: confidence,
+		GapSeconds: gap,
+	}
+}
+
+func confidenceFor(gap int64) valueobject.PositionConfidence {
+	switch {
+	case gap <= highConfidenceGapSeconds:
+		return valueobject.ConfidenceHigh
+	case gap <= mediumConfidenceGapSeconds:
+		return valueobject.ConfidenceMedium
+	default:
+		return valueobject.ConfidenceLow
+	}
+}
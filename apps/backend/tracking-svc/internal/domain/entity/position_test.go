@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func testFix(t *testing.T, lat, lng float64, timestamp int64) *valueobject.TrackPoint {
+	t.Helper()
+	fix, err := valueobject.NewTrackPoint(lat, lng, timestamp)
+	require.NoError(t, err)
+	return &fix
+}
+
+func TestEstimatePosition(t *testing.T) {
+	before := testFix(t, 10.0, 106.0, 1000)
+	after := testFix(t, 10.2, 106.4, 1040)
+
+	position, ok := EstimatePosition(before, after, 1010)
+	require.True(t, ok)
+	assert.Equal(t, valueobject.PositionInterpolated, position.Method)
+	assert.Equal(t, valueobject.ConfidenceHigh, position.Confidence)
+	assert.InDelta(t, 10.05, position.Coordinate.Latitude(), 1e-9)
+	assert.InDelta(t, 106.1, position.Coordinate.Longitude(), 1e-9)
+	assert.Equal(t, int64(40), position.GapSeconds)
+
+	position, _ = EstimatePosition(before, after, 1000)
+	assert.Equal(t, valueobject.PositionFix, position.Method)
+	assert.Equal(t, valueobject.ConfidenceExact, position.Confidence)
+
+	// Wide gaps are interpolated with less confidence.
+	position, _ = EstimatePosition(before, testFix(t, 10.2, 106.4, 1000+600), 1100)
+	assert.Equal(t, valueobject.ConfidenceLow, position.Confidence)
+
+	// After the last fix the vehicle is held there.
+	position, _ = EstimatePosition(after, nil, 1070)
+	assert.Equal(t, valueobject.PositionLastKnown, position.Method)
+	assert.Equal(t, valueobject.ConfidenceMedium, position.Confidence)
+	assert.Equal(t, after.Coordinate(), position.Coordinate)
+
+	_, ok = EstimatePosition(nil, nil, 1000)
+	assert.False(t, ok)
+}
+
+func TestEstimatePosition_AcrossAntimeridian(t *testing.T) {
+	position, ok := EstimatePosition(testFix(t, 0, 179.9, 1), testFix(t, 0, -179.9, 21), 11)
+	require.True(t, ok)
+	assert.InDelta(t, 180, math.Abs(position.Coordinate.Longitude()), 1e-9)
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// Refuel records a fuel level jump large enough to be a fill-up. VehicleID is
+// the vehicle-svc vehicle id.
+type Refuel struct {
+	id           valueobject.RefuelID
+	tenantID     string
+	vehicleID    string
+	fromLevel    float64
+	toLevel      float64
+	volumeLiters float64
+	mileage      float64
+	occurredAt   time.Time
+}
+
+func (r *Refuel) ID() valueobject.RefuelID {
+	return r.id
+}
+
+func (r *Refuel) TenantID() string {
+	return r.tenantID
+}
+
+func (r *Refuel) VehicleID() string {
+	return r.vehicleID
+}
+
+func (r *Refuel) FromLevel() float64 {
+	return r.fromLevel
+}
+
+func (r *Refuel) ToLevel() float64 {
+	return r.toLevel
+}
+
+func (r *Refuel) VolumeLiters() float64 {
+	return r.volumeLiters
+}
+
+func (r *Refuel) Mileage() float64 {
+	return r.mileage
+}
+
+func (r *Refuel) OccurredAt() time.Time {
+	return r.occurredAt
+}
+
+func LoadRefuelFromHistory(
+	id valueobject.RefuelID,
+	tenantID string,
+	vehicleID string,
+	fromLevel, toLevel float64,
+	volumeLiters float64,
+	mileage float64,
+	occurredAt time.Time,
+) *Refuel {
+	return &Refuel{
+		id:           id,
+		tenantID:     tenantID,
+		vehicleID:    vehicleID,
+		fromLevel:    fromLevel,
+		toLevel:      toLevel,
+		volumeLiters: volumeLiters,
+		mileage:      mileage,
+		occurredAt:   occurredAt,
+	}
+}
@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// Trip is one journey segmented out of a vehicle's location stream. Only fixes
+// that moved the vehicle are kept in the polyline, so the last point is also
+// the last time the vehicle was seen moving. VehicleID is the vehicle-svc id.
+type Trip struct {
+	id             valueobject.TripID
+	tenantID       string
+	vehicleID      string
+	status         valueobject.TripStatus
+	polyline       []valueobject.TrackPoint
+	distanceMeters float64
+	maxSpeedKmh    float64
+	version        valueobject.Version
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// StartTrip opens a trip from the point the vehicle departed to the first fix
+// that showed it moving.
+func StartTrip(
+	id valueobject.TripID,
+	tenantID string,
+	vehicleID string,
+	departure valueobject.TrackPoint,
+	next valueobject.TrackPoint,
+) (*Trip, error) {
+	if vehicleID == "" {
+		return nil, fmt.Errorf("vehicle id cannot be empty")
+	}
+	if next.Timestamp() <= departure.Timestamp() {
+		return nil, fmt.Errorf("trip must move forward in time")
+	}
+
+	now := time.Now().UTC()
+	t := &Trip{
+		id:        id,
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		status:    valueobject.TripInProgress,
+		polyline:  []valueobject.TrackPoint{departure},
+		version:   valueobject.Version{},
+		createdAt: now,
+		updatedAt: now,
+	}
+	t.appendPoint(next)
+
+	return t, nil
+}
+
+func (t *Trip) ID() valueobject.TripID {
+	return t.id
+}
+
+func (t *Trip) TenantID() string {
+	return t.tenantID
+}
+
+func (t *Trip) VehicleID() string {
+	return t.vehicleID
+}
+
+func (t *Trip) Status() valueobject.TripStatus {
+	return t.status
+}
+
+func (t *Trip) Polyline() []valueobject.TrackPoint {
+	return t.polyline
+}
+
+func (t *Trip) StartPoint() valueobject.TrackPoint {
+	return t.polyline[0]
+}
+
+func (t *Trip) EndPoint() valueobject.TrackPoint {
+	return t.polyline[len(t.polyline)-1]
+}
+
+func (t *Trip) StartedAt() int64 {
+	return t.StartPoint().Timestamp()
+}
+
+// EndedAt is nil while the trip is in progress.
+func (t *Trip) EndedAt() *int64 {
+	if t.status != valueobject.TripCompleted {
+		return nil
+	}
+	endedAt := t.EndPoint().Timestamp()
+	return &endedAt
+}
+
+func (t *Trip) DurationSeconds() int64 {
+	return t.EndPoint().Timestamp() - t.StartedAt()
+}
+
+func (t *Trip) DistanceMeters() float64 {
+	return t.distanceMeters
+}
+
+func (t *Trip) MaxSpeedKmh() float64 {
+	return t.maxSpeedKmh
+}
+
+func (t *Trip) Version() valueobject.Version {
+	return t.version
+}
+
+func (t *Trip) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *Trip) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+func (t *Trip) IsOpen() bool {
+	return t.status == valueobject.TripInProgress
+}
+
+// IdleSince reports how long the vehicle has been stationary as of the given
+// unix time.
+func (t *Trip) IdleSince(timestamp int64) time.Duration {
+	return time.Duration(timestamp-t.EndPoint().Timestamp()) * time.Second
+}
+
+// Extend adds a fix to the polyline when it is at least minMovementMeters from
+// the last recorded point. Smaller moves are treated as GPS jitter; because
+// they are not recorded, slow movement still accumulates until it crosses the
+// threshold. It reports whether the trip changed.
+func (t *Trip) Extend(point valueobject.TrackPoint, minMovementMeters float64) bool {
+	if !t.IsOpen() {
+		return false
+	}
+	if point.Timestamp() <= t.EndPoint().Timestamp() {
+		return false
+	}
+	if haversineMeters(t.EndPoint().Coordinate(), point.Coordinate()) < minMovementMeters {
+		return false
+	}
+
+	t.appendPoint(point)
+	t.updatedAt = time.Now().UTC()
+	t.version = t.version.Next()
+
+	return true
+}
+
+func (t *Trip) Complete() {
+	if !t.IsOpen() {
+		return
+	}
+
+	t.status = valueobject.TripCompleted
+	t.updatedAt = time.Now().UTC()
+	t.version = t.version.Next()
+}
+
+func (t *Trip) appendPoint(point valueobject.TrackPoint) {
+	last := t.EndPoint()
+	meters := haversineMeters(last.Coordinate(), point.Coordinate())
+	seconds := point.Timestamp() - last.Timestamp()
+	if seconds > 0 {
+		if speed := meters / float64(seconds) * 3.6; speed > t.maxSpeedKmh {
+			t.maxSpeedKmh = speed
+		}
+	}
+
+	t.distanceMeters += meters
+	t.polyline = append(t.polyline, point)
+}
+
+func LoadTripFromHistory(
+	id valueobject.TripID,
+	tenantID string,
+	vehicleID string,
+	status valueobject.TripStatus,
+	polyline []valueobject.TrackPoint,
+	distanceMeters float64,
+	maxSpeedKmh float64,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *Trip {
+	return &Trip{
+		id:             id,
+		tenantID:       tenantID,
+		vehicleID:      vehicleID,
+		status:         status,
+		polyline:       polyline,
+		distanceMeters: distanceMeters,
+		maxSpeedKmh:    maxSpeedKmh,
+		version:        version,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
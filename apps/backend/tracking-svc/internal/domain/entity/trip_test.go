@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func testTrackPoint(t *testing.T, lat, lng float64, timestamp int64) valueobject.TrackPoint {
+	t.Helper()
+	p, err := valueobject.NewTrackPoint(lat, lng, timestamp)
+	require.NoError(t, err)
+	return p
+}
+
+func TestStartTripAndExtend(t *testing.T) {
+	// 0.001 degrees of latitude is roughly 111 meters.
+	trip, err := StartTrip(valueobject.GenerateTripID(), "fleet-a", "vehicle-1",
+		testTrackPoint(t, 10.000, 106.0, 0),
+		testTrackPoint(t, 10.001, 106.0, 10))
+	require.NoError(t, err)
+
+	assert.True(t, trip.IsOpen())
+	assert.Nil(t, trip.EndedAt())
+	assert.InDelta(t, 111, trip.DistanceMeters(), 1)
+	assert.InDelta(t, 40, trip.MaxSpeedKmh(), 1)
+
+	// Jitter below the threshold is not recorded.
+	assert.False(t, trip.Extend(testTrackPoint(t, 10.0011, 106.0, 20), 50))
+	// Out-of-order fixes are ignored.
+	assert.False(t, trip.Extend(testTrackPoint(t, 10.005, 106.0, 5), 50))
+
+	assert.True(t, trip.Extend(testTrackPoint(t, 10.003, 106.0, 30), 50))
+	assert.Len(t, trip.Polyline(), 3)
+	assert.InDelta(t, 333, trip.DistanceMeters(), 1)
+	assert.Equal(t, int64(30), trip.DurationSeconds())
+	assert.Equal(t, 10*time.Second, trip.IdleSince(40))
+
+	trip.Complete()
+	require.NotNil(t, trip.EndedAt())
+	assert.Equal(t, int64(30), *trip.EndedAt())
+	assert.False(t, trip.Extend(testTrackPoint(t, 10.010, 106.0, 60), 50))
+}
+
+func TestVehicleMotionStateDepart(t *testing.T) {
+	state := NewVehicleMotionState("fleet-a", "vehicle-1")
+
+	first := testTrackPoint(t, 10.000, 106.0, 100)
+	require.True(t, state.Accept(first))
+	assert.Nil(t, state.Depart(first, 50))
+	assert.Equal(t, int64(1), state.Version().Value())
+
+	// Creeping 30m at a time still adds up to a departure.
+	creep := testTrackPoint(t, 10.0003, 106.0, 110)
+	require.True(t, state.Accept(creep))
+	assert.Nil(t, state.Depart(creep, 50))
+
+	assert.False(t, state.Accept(testTrackPoint(t, 10.0, 106.0, 105)))
+
+	moved := testTrackPoint(t, 10.0006, 106.0, 120)
+	require.True(t, state.Accept(moved))
+	departure := state.Depart(moved, 50)
+	require.NotNil(t, departure)
+	assert.Equal(t, 10.000, departure.Latitude())
+	assert.Equal(t, int64(110), departure.Timestamp())
+	assert.Nil(t, state.ParkedAt())
+}
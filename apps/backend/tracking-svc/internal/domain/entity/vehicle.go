@@ -2,6 +2,8 @@ package entity
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
@@ -10,6 +12,7 @@ import (
 
 type Vehicle struct {
 	id                valueobject.VehicleID
+	tenantID          string
 	refId             string
 	vin               string
 	vehicleName       string
@@ -18,15 +21,25 @@ type Vehicle struct {
 	status            valueobject.VehicleStatus
 	currentLocation   valueobject.Location
 	mileage           valueobject.Mileage
-	fuelLevel         valueobject.FuelLevel
+	fuelLevel         valueobject.FuelLevel // battery state of charge for a BEV
+	energyType        valueobject.EnergyType
+	energyCapacity    float64 // liters, or kWh for a BEV; 0 when unknown
+	chargingState     string
+	estimatedRangeKm  *float64
+	currentDriverID   string
 	version           valueobject.Version
 	createdAt         time.Time
 	updatedAt         time.Time
+	archivedAt        *time.Time
+	group             string
+	tags              []string
+	attributes        map[string]interface{}
 	uncommittedEvents []interface{}
 }
 
 func NewVehicle(
 	id valueobject.VehicleID,
+	tenantID string,
 	refId string,
 	vin string,
 	vehicleName string,
@@ -36,6 +49,9 @@ func NewVehicle(
 	location valueobject.Location,
 	mileage valueobject.Mileage,
 	fuelLevel valueobject.FuelLevel,
+	energyType valueobject.EnergyType,
+	energyCapacity float64,
+	attributes map[string]interface{},
 ) (*Vehicle, error) {
 	if vin == "" {
 		return nil, fmt.Errorf("vin cannot be empty")
@@ -50,6 +66,7 @@ func NewVehicle(
 	now := time.Now().UTC()
 	v := &Vehicle{
 		id:              id,
+		tenantID:        tenantID,
 		refId:           refId,
 		vin:             vin,
 		vehicleName:     vehicleName,
@@ -59,12 +76,16 @@ func NewVehicle(
 		currentLocation: location,
 		mileage:         mileage,
 		fuelLevel:       fuelLevel,
+		energyType:      energyType,
+		energyCapacity:  energyCapacity,
+		attributes:      maps.Clone(attributes),
 		version:         valueobject.Version{},
 		createdAt:       now,
 		updatedAt:       now,
 	}
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleCreatedEvent{
+		TenantID:      v.tenantID,
 		VehicleID:     id.String(),
 		VIN:           vin,
 		VehicleName:   vehicleName,
@@ -85,6 +106,10 @@ func (v *Vehicle) ID() valueobject.VehicleID {
 	return v.id
 }
 
+func (v *Vehicle) TenantID() string {
+	return v.tenantID
+}
+
 func (v *Vehicle) RefID() string {
 	return v.refId
 }
@@ -121,6 +146,27 @@ func (v *Vehicle) FuelLevel() valueobject.FuelLevel {
 	return v.fuelLevel
 }
 
+func (v *Vehicle) EnergyType() valueobject.EnergyType {
+	return v.energyType
+}
+
+func (v *Vehicle) EnergyCapacity() float64 {
+	return v.energyCapacity
+}
+
+func (v *Vehicle) ChargingState() string {
+	return v.chargingState
+}
+
+// EstimatedRangeKm is nil until the vehicle reports one.
+func (v *Vehicle) EstimatedRangeKm() *float64 {
+	return v.estimatedRangeKm
+}
+
+func (v *Vehicle) CurrentDriverID() string {
+	return v.currentDriverID
+}
+
 func (v *Vehicle) Version() valueobject.Version {
 	return v.version
 }
@@ -133,6 +179,27 @@ func (v *Vehicle) UpdatedAt() time.Time {
 	return v.updatedAt
 }
 
+// ArchivedAt is nil unless the vehicle was deleted in vehicle-svc.
+func (v *Vehicle) ArchivedAt() *time.Time {
+	return v.archivedAt
+}
+
+func (v *Vehicle) IsArchived() bool {
+	return v.archivedAt != nil
+}
+
+func (v *Vehicle) Group() string {
+	return v.group
+}
+
+func (v *Vehicle) Tags() []string {
+	return append([]string(nil), v.tags...)
+}
+
+func (v *Vehicle) Attributes() map[string]interface{} {
+	return maps.Clone(v.attributes)
+}
+
 func (v *Vehicle) UpdateLocation(location valueobject.Location) error {
 	if location.Equals(v.currentLocation) {
 		return nil
@@ -143,6 +210,7 @@ func (v *Vehicle) UpdateLocation(location valueobject.Location) error {
 	v.version = v.version.Next()
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleLocationUpdatedEvent{
+		TenantID:  v.tenantID,
 		VehicleID: v.id.String(),
 		Latitude:  location.Latitude(),
 		Longitude: location.Longitude(),
@@ -165,6 +233,7 @@ func (v *Vehicle) UpdateMileage(newMileage valueobject.Mileage) error {
 	v.version = v.version.Next()
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleMileageUpdatedEvent{
+		TenantID:  v.tenantID,
 		VehicleID: v.id.String(),
 		Mileage:   float64(newMileage.Kilometers()),
 		UpdatedAt: v.updatedAt.Unix(),
@@ -174,24 +243,23 @@ func (v *Vehicle) UpdateMileage(newMileage valueobject.Mileage) error {
 	return nil
 }
 
-func (v *Vehicle) UpdateFuelLevel(fuelLevel valueobject.FuelLevel) error {
-	if fuelLevel.Equals(v.fuelLevel) {
-		return nil // No change
+// UpdateEnergyLevel mirrors an energy reading made in vehicle-svc, which sends
+// the charging state and range it currently holds. It reports whether the
+// vehicle changed.
+func (v *Vehicle) UpdateEnergyLevel(level valueobject.FuelLevel, chargingState string, estimatedRangeKm *float64) bool {
+	sameRange := (estimatedRangeKm == nil && v.estimatedRangeKm == nil) ||
+		(estimatedRangeKm != nil && v.estimatedRangeKm != nil && *estimatedRangeKm == *v.estimatedRangeKm)
+	if level.Equals(v.fuelLevel) && chargingState == v.chargingState && sameRange {
+		return false
 	}
 
-	v.fuelLevel = fuelLevel
+	v.fuelLevel = level
+	v.chargingState = chargingState
+	v.estimatedRangeKm = estimatedRangeKm
 	v.updatedAt = time.Now().UTC()
 	v.version = v.version.Next()
 
-	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleFuelLevelUpdatedEvent{
-		VehicleID: v.id.String(),
-		FuelLevel: float64(fuelLevel.Percentage()),
-		IsLow:     fuelLevel.IsLow(),
-		UpdatedAt: v.updatedAt.Unix(),
-		Version:   v.version.Value(),
-	})
-
-	return nil
+	return true
 }
 
 func (v *Vehicle) ChangeStatus(newStatus valueobject.VehicleStatus) error {
@@ -205,6 +273,7 @@ func (v *Vehicle) ChangeStatus(newStatus valueobject.VehicleStatus) error {
 	v.version = v.version.Next()
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleStatusChangedEvent{
+		TenantID:  v.tenantID,
 		VehicleID: v.id.String(),
 		OldStatus: string(oldStatus),
 		NewStatus: string(newStatus),
@@ -215,6 +284,133 @@ func (v *Vehicle) ChangeStatus(newStatus valueobject.VehicleStatus) error {
 	return nil
 }
 
+func (v *Vehicle) AssignDriver(driverID string) error {
+	if driverID == "" {
+		return fmt.Errorf("driver id cannot be empty")
+	}
+	if driverID == v.currentDriverID {
+		return nil
+	}
+
+	v.currentDriverID = driverID
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return nil
+}
+
+// UnassignDriver clears the active driver only if it is still the given one,
+// so a late unassign event cannot wipe out a newer assignment.
+func (v *Vehicle) UnassignDriver(driverID string) error {
+	if v.currentDriverID == "" || v.currentDriverID != driverID {
+		return nil
+	}
+
+	v.currentDriverID = ""
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return nil
+}
+
+// UpdateDetails mirrors a rename, re-model or re-plate made in vehicle-svc. It
+// reports whether the vehicle changed.
+func (v *Vehicle) UpdateDetails(vehicleName, vehicleModel string, licenseNumber valueobject.LicenseNumber) bool {
+	if vehicleName == v.vehicleName && vehicleModel == v.vehicleModel && licenseNumber.Equals(v.licenseNumber) {
+		return false
+	}
+
+	v.vehicleName = vehicleName
+	v.vehicleModel = vehicleModel
+	v.licenseNumber = licenseNumber
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
+// ChangeGroup mirrors a group move made in vehicle-svc; an empty group means
+// ungrouped. It reports whether the vehicle changed.
+func (v *Vehicle) ChangeGroup(group string) bool {
+	if group == v.group {
+		return false
+	}
+
+	v.group = group
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
+// ReplaceTags mirrors the tag set kept by vehicle-svc, which sends it already
+// normalized and sorted. It reports whether the vehicle changed.
+func (v *Vehicle) ReplaceTags(tags []string) bool {
+	if slices.Equal(tags, v.tags) {
+		return false
+	}
+
+	v.tags = append([]string(nil), tags...)
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
+// ApplyAttributeChanges mirrors a custom attribute change made in vehicle-svc,
+// which has already validated it. A nil value removes the attribute. It
+// reports whether the vehicle changed.
+func (v *Vehicle) ApplyAttributeChanges(changes map[string]interface{}) bool {
+	next := maps.Clone(v.attributes)
+	if next == nil {
+		next = make(map[string]interface{}, len(changes))
+	}
+	for name, value := range changes {
+		if value == nil {
+			delete(next, name)
+		} else {
+			next[name] = value
+		}
+	}
+	if maps.Equal(next, v.attributes) {
+		return false
+	}
+
+	v.attributes = next
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
+// Archive hides the projection of a vehicle deleted in vehicle-svc. It reports
+// whether the vehicle changed.
+func (v *Vehicle) Archive(at time.Time) bool {
+	if v.IsArchived() {
+		return false
+	}
+
+	v.archivedAt = &at
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
+// Unarchive brings back the projection of a restored vehicle. It reports
+// whether the vehicle changed.
+func (v *Vehicle) Unarchive() bool {
+	if !v.IsArchived() {
+		return false
+	}
+
+	v.archivedAt = nil
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	return true
+}
+
 func (v *Vehicle) UncommittedEvents() []interface{} {
 	events := v.uncommittedEvents
 	v.uncommittedEvents = []interface{}{}
@@ -223,6 +419,7 @@ func (v *Vehicle) UncommittedEvents() []interface{} {
 
 func LoadFromHistory(
 	id valueobject.VehicleID,
+	tenantID string,
 	refId string,
 	vin string,
 	vehicleName string,
@@ -232,22 +429,41 @@ func LoadFromHistory(
 	location valueobject.Location,
 	mileage valueobject.Mileage,
 	fuelLevel valueobject.FuelLevel,
+	energyType valueobject.EnergyType,
+	energyCapacity float64,
+	chargingState string,
+	estimatedRangeKm *float64,
+	currentDriverID string,
 	version valueobject.Version,
 	createdAt, updatedAt time.Time,
+	archivedAt *time.Time,
+	group string,
+	tags []string,
+	attributes map[string]interface{},
 ) *Vehicle {
 	return &Vehicle{
-		id:              id,
-		refId:           refId,
-		vin:             vin,
-		vehicleName:     vehicleName,
-		vehicleModel:    vehicleModel,
-		licenseNumber:   licenseNumber,
-		status:          status,
-		currentLocation: location,
-		mileage:         mileage,
-		fuelLevel:       fuelLevel,
-		version:         version,
-		createdAt:       createdAt,
-		updatedAt:       updatedAt,
+		id:               id,
+		tenantID:         tenantID,
+		refId:            refId,
+		vin:              vin,
+		vehicleName:      vehicleName,
+		vehicleModel:     vehicleModel,
+		licenseNumber:    licenseNumber,
+		status:           status,
+		currentLocation:  location,
+		mileage:          mileage,
+		fuelLevel:        fuelLevel,
+		energyType:       energyType,
+		energyCapacity:   energyCapacity,
+		chargingState:    chargingState,
+		estimatedRangeKm: estimatedRangeKm,
+		currentDriverID:  currentDriverID,
+		version:          version,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		archivedAt:       archivedAt,
+		group:            group,
+		tags:             tags,
+		attributes:       attributes,
 	}
 }
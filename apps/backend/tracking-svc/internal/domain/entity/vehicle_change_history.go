@@ -6,26 +6,33 @@ import (
 
 type VehicleChangeHistory struct {
 	ID         string                 `bson:"_id,omitempty"`
+	TenantID   string                 `bson:"tenantId"`
 	VehicleID  string                 `bson:"vehicleId"`
 	VIN        string                 `bson:"vin"`
-	ChangeType string                 `bson:"changeType"` // created, location_updated, status_changed, mileage_updated, fuel_updated
-	OldValue   map[string]interface{} `bson:"oldValue"`   // Previous state
-	NewValue   map[string]interface{} `bson:"newValue"`   // New state
+	DriverID   string                 `bson:"driverId,omitempty"` // Driver assigned when the change happened
+	ChangeType string                 `bson:"changeType"`         // created, status_changed, mileage_updated, fuel_updated
+	OldValue   map[string]interface{} `bson:"oldValue"`           // Previous state
+	NewValue   map[string]interface{} `bson:"newValue"`           // New state
 	ChangedAt  time.Time              `bson:"changedAt"`
-	Version    int64                  `bson:"version"` // Vehicle version at time of change
+	Version    int64                  `bson:"version"`            // Vehicle version at time of change
+	Archived   bool                   `bson:"archived,omitempty"` // Set while the vehicle is deleted
 }
 
 func NewVehicleChangeHistory(
+	tenantID string,
 	vehicleID string,
 	vin string,
+	driverID string,
 	changeType string,
 	oldValue map[string]interface{},
 	newValue map[string]interface{},
 	version int64,
 ) *VehicleChangeHistory {
 	return &VehicleChangeHistory{
+		TenantID:   tenantID,
 		VehicleID:  vehicleID,
 		VIN:        vin,
+		DriverID:   driverID,
 		ChangeType: changeType,
 		OldValue:   oldValue,
 		NewValue:   newValue,
@@ -0,0 +1,267 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// stationaryKm is the distance below which a vehicle is treated as not having
+// moved between two fuel readings.
+const stationaryKm = 0.5
+
+// VehicleFuelState keeps the last fuel reading and the odometer at that time,
+// so each new reading can be judged against the distance driven since.
+// VehicleID is the vehicle-svc vehicle id carried on events.
+type VehicleFuelState struct {
+	tenantID          string
+	vehicleID         string
+	lastLevel         *float64
+	lastReadingAt     int64
+	mileageAtReading  float64
+	currentMileage    float64
+	version           valueobject.Version
+	updatedAt         time.Time
+	changed           bool
+	uncommittedEvents []interface{}
+}
+
+func NewVehicleFuelState(tenantID, vehicleID string) *VehicleFuelState {
+	return &VehicleFuelState{
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		version:   valueobject.Version{},
+		updatedAt: time.Now().UTC(),
+	}
+}
+
+func (s *VehicleFuelState) VehicleID() string {
+	return s.vehicleID
+}
+
+func (s *VehicleFuelState) TenantID() string {
+	return s.tenantID
+}
+
+// LastLevel is nil until the first fuel reading arrives.
+func (s *VehicleFuelState) LastLevel() *float64 {
+	return s.lastLevel
+}
+
+func (s *VehicleFuelState) LastReadingAt() int64 {
+	return s.lastReadingAt
+}
+
+func (s *VehicleFuelState) MileageAtReading() float64 {
+	return s.mileageAtReading
+}
+
+func (s *VehicleFuelState) CurrentMileage() float64 {
+	return s.currentMileage
+}
+
+func (s *VehicleFuelState) Version() valueobject.Version {
+	return s.version
+}
+
+func (s *VehicleFuelState) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// RecordMileage tracks the odometer between fuel readings. It reports whether
+// the state changed.
+func (s *VehicleFuelState) RecordMileage(kilometers float64) bool {
+	if kilometers == s.currentMileage {
+		return false
+	}
+
+	s.currentMileage = kilometers
+	if s.lastLevel == nil {
+		s.mileageAtReading = kilometers
+	}
+	s.touch()
+	return true
+}
+
+// RecordFuelLevel classifies a new reading against the previous one. A rise of
+// at least the refuel threshold is returned as a Refuel. A drop of at least
+// the drop threshold raises a fuel_drop alert when the vehicle has not moved,
+// or when it is more than the tolerance times what the distance driven should
+// have consumed. For a BEV the level is the state of charge, so neither
+// applies. Falling below the profile's low level raises a low_energy alert
+// once per crossing. Readings older than the last one are ignored; it reports
+// whether the state changed.
+func (s *VehicleFuelState) RecordFuelLevel(
+	level float64,
+	timestamp int64,
+	profile *FuelProfile,
+	energyType valueobject.EnergyType,
+	refuelID valueobject.RefuelID,
+) (*Refuel, bool) {
+	if s.lastLevel != nil && timestamp < s.lastReadingAt {
+		return nil, false
+	}
+
+	s.checkLow(level, timestamp, profile, energyType)
+
+	var refuel *Refuel
+	if s.lastLevel != nil && energyType != valueobject.EnergyBEV {
+		previous := *s.lastLevel
+		delta := level - previous
+		distanceKm := s.currentMileage - s.mileageAtReading
+		if distanceKm < 0 {
+			distanceKm = 0
+		}
+
+		switch {
+		case delta >= profile.RefuelThresholdPercent():
+			refuel = LoadRefuelFromHistory(
+				refuelID,
+				s.tenantID,
+				s.vehicleID,
+				previous,
+				level,
+				delta/100*profile.TankCapacityLiters(),
+				s.currentMileage,
+				time.Unix(timestamp, 0).UTC(),
+			)
+		case -delta >= profile.DropThresholdPercent():
+			s.checkDrop(previous, level, distanceKm, timestamp, profile)
+		}
+	}
+
+	s.lastLevel = &level
+	s.lastReadingAt = timestamp
+	s.mileageAtReading = s.currentMileage
+	s.touch()
+
+	return refuel, true
+}
+
+// CorrectMileage takes an operator's odometer correction as the new baseline.
+// The odometer at the last fuel reading is pulled down with it, so a lowered
+// odometer cannot make the distance driven since negative. It reports whether
+// the state changed.
+func (s *VehicleFuelState) CorrectMileage(kilometers float64) bool {
+	if kilometers == s.currentMileage {
+		return false
+	}
+
+	s.currentMileage = kilometers
+	if s.lastLevel == nil || s.mileageAtReading > kilometers {
+		s.mileageAtReading = kilometers
+	}
+	s.touch()
+	return true
+}
+
+// CorrectFuelLevel takes an operator's fuel correction as the last reading
+// without judging it, so the correction raises no refuel or alert. Readings
+// the device took before the correction are ignored afterwards. It reports
+// whether the state changed.
+func (s *VehicleFuelState) CorrectFuelLevel(level float64, timestamp int64) bool {
+	if s.lastLevel != nil && *s.lastLevel == level {
+		return false
+	}
+
+	s.lastLevel = &level
+	if timestamp > s.lastReadingAt {
+		s.lastReadingAt = timestamp
+	}
+	s.mileageAtReading = s.currentMileage
+	s.touch()
+	return true
+}
+
+func (s *VehicleFuelState) checkDrop(previous, level, distanceKm float64, timestamp int64, profile *FuelProfile) {
+	dropLiters := (previous - level) / 100 * profile.TankCapacityLiters()
+	expectedLiters := distanceKm * profile.ConsumptionLitersPer100Km() / 100
+
+	var message string
+	switch {
+	case distanceKm < stationaryKm:
+		message = fmt.Sprintf("fuel dropped %.1f L while stationary", dropLiters)
+	case dropLiters > expectedLiters*profile.ConsumptionTolerance():
+		message = fmt.Sprintf("fuel dropped %.1f L over %.1f km, expected about %.1f L", dropLiters, distanceKm, expectedLiters)
+	default:
+		return
+	}
+
+	s.uncommittedEvents = append(s.uncommittedEvents, &event.TrackingAlertEvent{
+		TenantID:  s.tenantID,
+		VehicleID: s.vehicleID,
+		AlertType: string(valueobject.AlertFuelDrop),
+		Message:   message,
+		Details: map[string]float64{
+			"fromLevel":      previous,
+			"toLevel":        level,
+			"dropLiters":     dropLiters,
+			"distanceKm":     distanceKm,
+			"expectedLiters": expectedLiters,
+		},
+		Timestamp: timestamp,
+	})
+}
+
+func (s *VehicleFuelState) checkLow(level float64, timestamp int64, profile *FuelProfile, energyType valueobject.EnergyType) {
+	threshold := profile.LowLevelPercent()
+	if level >= threshold || (s.lastLevel != nil && *s.lastLevel < threshold) {
+		return
+	}
+
+	what := "fuel"
+	if energyType == valueobject.EnergyBEV {
+		what = "battery"
+	}
+	s.uncommittedEvents = append(s.uncommittedEvents, &event.TrackingAlertEvent{
+		TenantID:  s.tenantID,
+		VehicleID: s.vehicleID,
+		AlertType: string(valueobject.AlertLowEnergy),
+		Message:   fmt.Sprintf("%s at %.0f%%, below the %.0f%% threshold", what, level, threshold),
+		Details: map[string]float64{
+			"level":     level,
+			"threshold": threshold,
+		},
+		Timestamp: timestamp,
+	})
+}
+
+// touch bumps the version once per load, since a fuel reading and its alert
+// are saved together.
+func (s *VehicleFuelState) touch() {
+	s.updatedAt = time.Now().UTC()
+	if !s.changed {
+		s.version = s.version.Next()
+		s.changed = true
+	}
+}
+
+func (s *VehicleFuelState) UncommittedEvents() []interface{} {
+	events := s.uncommittedEvents
+	s.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadVehicleFuelStateFromHistory(
+	tenantID string,
+	vehicleID string,
+	lastLevel *float64,
+	lastReadingAt int64,
+	mileageAtReading float64,
+	currentMileage float64,
+	version valueobject.Version,
+	updatedAt time.Time,
+) *VehicleFuelState {
+	return &VehicleFuelState{
+		tenantID:         tenantID,
+		vehicleID:        vehicleID,
+		lastLevel:        lastLevel,
+		lastReadingAt:    lastReadingAt,
+		mileageAtReading: mileageAtReading,
+		currentMileage:   currentMileage,
+		version:          version,
+		updatedAt:        updatedAt,
+	}
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func TestNewFuelProfileValidation(t *testing.T) {
+	_, err := NewFuelProfile("fleet-a", "", 60, 5, 3, 12, 2, 15)
+	assert.Error(t, err)
+
+	_, err = NewFuelProfile("fleet-a", "Hilux", 0, 5, 3, 12, 2, 15)
+	assert.Error(t, err)
+
+	_, err = NewFuelProfile("fleet-a", "Hilux", 60, 5, 3, 12, 0.5, 15)
+	assert.Error(t, err)
+
+	profile, err := NewFuelProfile("fleet-a", "Hilux", 80, 5, 3, 10, 1.5, 15)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), profile.Version().Value())
+}
+
+func TestVehicleFuelStateDetectsRefuel(t *testing.T) {
+	profile := DefaultFuelProfile("fleet-a", "Hilux")
+	state := NewVehicleFuelState("fleet-a", "vehicle-1")
+
+	refuel, changed := state.RecordFuelLevel(20, 100, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.True(t, changed)
+	assert.Nil(t, refuel)
+
+	state.RecordMileage(1200)
+	refuel, _ = state.RecordFuelLevel(90, 200, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	require.NotNil(t, refuel)
+	assert.Equal(t, 20.0, refuel.FromLevel())
+	assert.Equal(t, 90.0, refuel.ToLevel())
+	assert.InDelta(t, 42, refuel.VolumeLiters(), 0.001)
+	assert.Equal(t, 1200.0, refuel.Mileage())
+	assert.Equal(t, "fleet-a", refuel.TenantID())
+	assert.Empty(t, state.UncommittedEvents())
+
+	// Readings older than the last one are ignored.
+	_, changed = state.RecordFuelLevel(10, 150, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.False(t, changed)
+	assert.Equal(t, 90.0, *state.LastLevel())
+}
+
+func TestVehicleFuelStateFlagsStationaryDrop(t *testing.T) {
+	profile := DefaultFuelProfile("fleet-a", "Hilux")
+	state := NewVehicleFuelState("fleet-a", "vehicle-1")
+	state.RecordMileage(1000)
+	state.RecordFuelLevel(80, 100, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+
+	_, changed := state.RecordFuelLevel(60, 200, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.True(t, changed)
+
+	events := state.UncommittedEvents()
+	require.Len(t, events, 1)
+	alert, ok := events[0].(*event.TrackingAlertEvent)
+	require.True(t, ok)
+	assert.Equal(t, string(valueobject.AlertFuelDrop), alert.AlertType)
+	assert.InDelta(t, 12, alert.Details["dropLiters"], 0.001)
+}
+
+func TestVehicleFuelStateComparesDropWithDistance(t *testing.T) {
+	profile := DefaultFuelProfile("fleet-a", "Hilux")
+	state := NewVehicleFuelState("fleet-a", "vehicle-1")
+	state.RecordMileage(1000)
+	state.RecordFuelLevel(80, 100, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+
+	// 100 km at 12 L/100km burns 12 L, which is 20% of a 60 L tank.
+	state.RecordMileage(1100)
+	state.RecordFuelLevel(60, 200, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.Empty(t, state.UncommittedEvents())
+
+	// 10 km should burn about 1.2 L, not 12 L.
+	state.RecordMileage(1110)
+	state.RecordFuelLevel(40, 300, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.Len(t, state.UncommittedEvents(), 1)
+}
+
+func TestVehicleFuelStateWarnsOnceBelowLowLevel(t *testing.T) {
+	profile, err := NewFuelProfile("fleet-a", "e-Transit", 68, 5, 3, 20, 2, 20)
+	require.NoError(t, err)
+	state := NewVehicleFuelState("fleet-a", "vehicle-1")
+
+	state.RecordFuelLevel(30, 100, profile, valueobject.EnergyBEV, valueobject.GenerateRefuelID())
+	state.RecordFuelLevel(18, 200, profile, valueobject.EnergyBEV, valueobject.GenerateRefuelID())
+	events := state.UncommittedEvents()
+	require.Len(t, events, 1, "a BEV losing charge raises no fuel_drop alert")
+	alert := events[0].(*event.TrackingAlertEvent)
+	assert.Equal(t, string(valueobject.AlertLowEnergy), alert.AlertType)
+	assert.Equal(t, 20.0, alert.Details["threshold"])
+
+	state.RecordFuelLevel(12, 300, profile, valueobject.EnergyBEV, valueobject.GenerateRefuelID())
+	assert.Empty(t, state.UncommittedEvents())
+
+	// Charging is not a refuel.
+	refuel, _ := state.RecordFuelLevel(90, 400, profile, valueobject.EnergyBEV, valueobject.GenerateRefuelID())
+	assert.Nil(t, refuel)
+	state.RecordFuelLevel(10, 500, profile, valueobject.EnergyBEV, valueobject.GenerateRefuelID())
+	assert.Len(t, state.UncommittedEvents(), 1)
+}
+
+func TestVehicleFuelStateTakesCorrectionsAsBaseline(t *testing.T) {
+	profile := DefaultFuelProfile("fleet-a", "Hilux")
+	state := NewVehicleFuelState("fleet-a", "vehicle-1")
+	state.RecordMileage(1000)
+	state.RecordFuelLevel(20, 100, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+
+	// A corrected level is neither a refuel nor a drop.
+	assert.True(t, state.CorrectFuelLevel(85, 150))
+	assert.Equal(t, 85.0, *state.LastLevel())
+	assert.Empty(t, state.UncommittedEvents())
+	assert.False(t, state.CorrectFuelLevel(85, 160))
+
+	// Later readings are judged against the corrected level; those taken
+	// before it are ignored.
+	_, changed := state.RecordFuelLevel(20, 120, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.False(t, changed)
+	refuel, _ := state.RecordFuelLevel(88, 200, profile, valueobject.EnergyICE, valueobject.GenerateRefuelID())
+	assert.Nil(t, refuel)
+
+	// Lowering the odometer keeps the distance since the last reading at zero
+	// or more.
+	state.RecordMileage(1300)
+	assert.True(t, state.CorrectMileage(900))
+	assert.Equal(t, 900.0, state.CurrentMileage())
+	assert.Equal(t, 900.0, state.MileageAtReading())
+	assert.False(t, state.CorrectMileage(900))
+}
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// VehicleGeofenceState remembers which zones a vehicle was inside at its last
+// known position, so crossings can be detected across restarts. VehicleID is
+// the vehicle-svc vehicle id carried on location events.
+type VehicleGeofenceState struct {
+	tenantID          string
+	vehicleID         string
+	zoneIDs           []string
+	lastPosition      *valueobject.Coordinate
+	lastTimestamp     int64
+	version           valueobject.Version
+	updatedAt         time.Time
+	uncommittedEvents []interface{}
+}
+
+func NewVehicleGeofenceState(tenantID, vehicleID string) *VehicleGeofenceState {
+	return &VehicleGeofenceState{
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		version:   valueobject.Version{},
+		updatedAt: time.Now().UTC(),
+	}
+}
+
+func (s *VehicleGeofenceState) VehicleID() string {
+	return s.vehicleID
+}
+
+func (s *VehicleGeofenceState) TenantID() string {
+	return s.tenantID
+}
+
+func (s *VehicleGeofenceState) ZoneIDs() []string {
+	return s.zoneIDs
+}
+
+func (s *VehicleGeofenceState) LastPosition() *valueobject.Coordinate {
+	return s.lastPosition
+}
+
+func (s *VehicleGeofenceState) LastTimestamp() int64 {
+	return s.lastTimestamp
+}
+
+func (s *VehicleGeofenceState) Version() valueobject.Version {
+	return s.version
+}
+
+func (s *VehicleGeofenceState) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Move evaluates a new position against every zone and emits entered/exited
+// events for zones whose membership changed since the previous position.
+// Positions older than the last one seen are ignored. The very first position
+// only establishes membership, since without a previous position there is no
+// crossing to report. It reports whether the state changed.
+func (s *VehicleGeofenceState) Move(position valueobject.Coordinate, timestamp int64, zones []*Geofence) bool {
+	if s.lastPosition != nil && timestamp < s.lastTimestamp {
+		return false
+	}
+
+	previous := make(map[string]bool, len(s.zoneIDs))
+	for _, id := range s.zoneIDs {
+		previous[id] = true
+	}
+
+	var current []string
+	for _, zone := range zones {
+		inside := zone.Contains(position)
+		if inside {
+			current = append(current, zone.ID().String())
+		}
+		if s.lastPosition == nil {
+			continue
+		}
+
+		wasInside := previous[zone.ID().String()]
+		switch {
+		case inside && !wasInside:
+			s.uncommittedEvents = append(s.uncommittedEvents, &event.GeofenceEnteredEvent{
+				TenantID:     s.tenantID,
+				GeofenceID:   zone.ID().String(),
+				GeofenceName: zone.Name(),
+				Category:     string(zone.Category()),
+				VehicleID:    s.vehicleID,
+				Latitude:     position.Latitude(),
+				Longitude:    position.Longitude(),
+				OccurredAt:   timestamp,
+			})
+		case !inside && wasInside:
+			s.uncommittedEvents = append(s.uncommittedEvents, &event.GeofenceExitedEvent{
+				TenantID:     s.tenantID,
+				GeofenceID:   zone.ID().String(),
+				GeofenceName: zone.Name(),
+				Category:     string(zone.Category()),
+				VehicleID:    s.vehicleID,
+				Latitude:     position.Latitude(),
+				Longitude:    position.Longitude(),
+				OccurredAt:   timestamp,
+			})
+		}
+	}
+
+	s.zoneIDs = current
+	s.lastPosition = &position
+	s.lastTimestamp = timestamp
+	s.updatedAt = time.Now().UTC()
+	s.version = s.version.Next()
+
+	return true
+}
+
+func (s *VehicleGeofenceState) UncommittedEvents() []interface{} {
+	events := s.uncommittedEvents
+	s.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadVehicleGeofenceStateFromHistory(
+	tenantID string,
+	vehicleID string,
+	zoneIDs []string,
+	lastPosition *valueobject.Coordinate,
+	lastTimestamp int64,
+	version valueobject.Version,
+	updatedAt time.Time,
+) *VehicleGeofenceState {
+	return &VehicleGeofenceState{
+		tenantID:      tenantID,
+		vehicleID:     vehicleID,
+		zoneIDs:       zoneIDs,
+		lastPosition:  lastPosition,
+		lastTimestamp: lastTimestamp,
+		version:       version,
+		updatedAt:     updatedAt,
+	}
+}
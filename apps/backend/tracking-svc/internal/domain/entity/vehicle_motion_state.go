@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// VehicleMotionState tracks where a vehicle is parked between trips so that
+// trip detection can tell when it sets off again. VehicleID is the vehicle-svc
+// vehicle id carried on location events.
+type VehicleMotionState struct {
+	tenantID      string
+	vehicleID     string
+	parkedAt      *valueobject.TrackPoint
+	lastTimestamp int64
+	version       valueobject.Version
+	updatedAt     time.Time
+	changed       bool
+}
+
+func NewVehicleMotionState(tenantID, vehicleID string) *VehicleMotionState {
+	return &VehicleMotionState{
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		version:   valueobject.Version{},
+		updatedAt: time.Now().UTC(),
+	}
+}
+
+func (s *VehicleMotionState) VehicleID() string {
+	return s.vehicleID
+}
+
+func (s *VehicleMotionState) TenantID() string {
+	return s.tenantID
+}
+
+// ParkedAt is nil while the vehicle is on a trip or before its first fix.
+func (s *VehicleMotionState) ParkedAt() *valueobject.TrackPoint {
+	return s.parkedAt
+}
+
+func (s *VehicleMotionState) LastTimestamp() int64 {
+	return s.lastTimestamp
+}
+
+func (s *VehicleMotionState) Version() valueobject.Version {
+	return s.version
+}
+
+func (s *VehicleMotionState) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Accept reports whether a fix is newer than the last one seen and records its
+// time. Late fixes are dropped so replays cannot reopen finished trips.
+func (s *VehicleMotionState) Accept(point valueobject.TrackPoint) bool {
+	if s.lastTimestamp != 0 && point.Timestamp() <= s.lastTimestamp {
+		return false
+	}
+
+	s.lastTimestamp = point.Timestamp()
+	s.touch()
+	return true
+}
+
+// Depart checks an accepted fix against the parked position. Fixes within
+// minMovementMeters only refresh the parked time, so the trip starts from the
+// last moment the vehicle was seen at rest. It returns the departure point once
+// the vehicle has moved far enough.
+func (s *VehicleMotionState) Depart(point valueobject.TrackPoint, minMovementMeters float64) *valueobject.TrackPoint {
+	if s.parkedAt == nil {
+		s.parkedAt = &point
+		s.touch()
+		return nil
+	}
+
+	if haversineMeters(s.parkedAt.Coordinate(), point.Coordinate()) < minMovementMeters {
+		parked, _ := valueobject.NewTrackPoint(s.parkedAt.Latitude(), s.parkedAt.Longitude(), point.Timestamp())
+		s.parkedAt = &parked
+		s.touch()
+		return nil
+	}
+
+	departure := *s.parkedAt
+	s.parkedAt = nil
+	s.touch()
+	return &departure
+}
+
+// Park marks the vehicle as resting at the end of a finished trip.
+func (s *VehicleMotionState) Park(point valueobject.TrackPoint) {
+	s.parkedAt = &point
+	s.touch()
+}
+
+// touch bumps the version once per load, since a single fix may both accept
+// and park the vehicle before the state is saved.
+func (s *VehicleMotionState) touch() {
+	s.updatedAt = time.Now().UTC()
+	if !s.changed {
+		s.version = s.version.Next()
+		s.changed = true
+	}
+}
+
+func LoadVehicleMotionStateFromHistory(
+	tenantID string,
+	vehicleID string,
+	parkedAt *valueobject.TrackPoint,
+	lastTimestamp int64,
+	version valueobject.Version,
+	updatedAt time.Time,
+) *VehicleMotionState {
+	return &VehicleMotionState{
+		tenantID:      tenantID,
+		vehicleID:     vehicleID,
+		parkedAt:      parkedAt,
+		lastTimestamp: lastTimestamp,
+		version:       version,
+		updatedAt:     updatedAt,
+	}
+}
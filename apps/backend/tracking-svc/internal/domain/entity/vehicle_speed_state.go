@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// minDerivedIntervalSeconds is the shortest gap between two fixes that speed
+// is derived from; closer fixes make GPS jitter look like speed.
+const minDerivedIntervalSeconds = 1
+
+// VehicleSpeedState holds a vehicle's own speed limit and its last fix, so
+// speed can be derived for devices that do not report it and each speeding
+// episode is alerted once. VehicleID is the vehicle-svc vehicle id carried on
+// location events.
+type VehicleSpeedState struct {
+	tenantID          string
+	vehicleID         string
+	speedLimit        float64 // km/h, 0 for none
+	lastPosition      *valueobject.Coordinate
+	lastTimestamp     int64
+	speeding          bool
+	version           valueobject.Version
+	updatedAt         time.Time
+	changed           bool
+	uncommittedEvents []interface{}
+}
+
+func NewVehicleSpeedState(tenantID, vehicleID string) *VehicleSpeedState {
+	return &VehicleSpeedState{
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		version:   valueobject.Version{},
+		updatedAt: time.Now().UTC(),
+	}
+}
+
+func (s *VehicleSpeedState) VehicleID() string {
+	return s.vehicleID
+}
+
+func (s *VehicleSpeedState) TenantID() string {
+	return s.tenantID
+}
+
+// SpeedLimitKmh is 0 when the vehicle has no limit of its own.
+func (s *VehicleSpeedState) SpeedLimitKmh() float64 {
+	return s.speedLimit
+}
+
+// LastPosition is nil before the first fix.
+func (s *VehicleSpeedState) LastPosition() *valueobject.Coordinate {
+	return s.lastPosition
+}
+
+func (s *VehicleSpeedState) LastTimestamp() int64 {
+	return s.lastTimestamp
+}
+
+// Speeding reports whether the last fix was over its limit.
+func (s *VehicleSpeedState) Speeding() bool {
+	return s.speeding
+}
+
+func (s *VehicleSpeedState) Version() valueobject.Version {
+	return s.version
+}
+
+func (s *VehicleSpeedState) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// SetSpeedLimit sets the vehicle's own limit; 0 removes it.
+func (s *VehicleSpeedState) SetSpeedLimit(kmh float64) error {
+	if kmh < 0 {
+		return fmt.Errorf("speed limit cannot be negative: %f", kmh)
+	}
+	s.speedLimit = kmh
+	s.touch()
+	return nil
+}
+
+// Observe checks a fix against the strictest limit that applies: the
+// vehicle's own and that of every zone containing the fix. Speed is the
+// reported one, or derived from the distance to the previous fix when the
+// device does not report it. A speeding alert is raised when the vehicle goes
+// over the limit, not again until it has dropped back under it. Fixes older
+// than the last one are ignored; it reports whether the state changed.
+func (s *VehicleSpeedState) Observe(position valueobject.Coordinate, timestamp int64, reportedKmh *float64, zones []*Geofence) bool {
+	if s.lastPosition != nil && timestamp <= s.lastTimestamp {
+		return false
+	}
+
+	speedKmh, known := 0.0, false
+	switch {
+	case reportedKmh != nil:
+		speedKmh, known = *reportedKmh, true
+	case s.lastPosition != nil && timestamp-s.lastTimestamp >= minDerivedIntervalSeconds:
+		seconds := float64(timestamp - s.lastTimestamp)
+		speedKmh, known = haversineMeters(*s.lastPosition, position)/seconds*3.6, true
+	}
+
+	if known {
+		limit := s.limitAt(position, zones)
+		speeding := limit > 0 && speedKmh > limit
+		if speeding && !s.speeding {
+			s.uncommittedEvents = append(s.uncommittedEvents, &event.TrackingAlertEvent{
+				TenantID:  s.tenantID,
+				VehicleID: s.vehicleID,
+				AlertType: string(valueobject.AlertSpeeding),
+				Message:   fmt.Sprintf("speed %.0f km/h over the %.0f km/h limit", speedKmh, limit),
+				Details: map[string]float64{
+					"speedKmh":  speedKmh,
+					"limitKmh":  limit,
+					"latitude":  position.Latitude(),
+					"longitude": position.Longitude(),
+				},
+				Timestamp: timestamp,
+			})
+		}
+		s.speeding = speeding
+	}
+
+	s.lastPosition = &position
+	s.lastTimestamp = timestamp
+	s.touch()
+	return true
+}
+
+// limitAt returns the lowest limit in force at position, 0 when none is.
+func (s *VehicleSpeedState) limitAt(position valueobject.Coordinate, zones []*Geofence) float64 {
+	limit := s.speedLimit
+	for _, zone := range zones {
+		zoneLimit := zone.SpeedLimitKmh()
+		if zoneLimit <= 0 || !zone.Contains(position) {
+			continue
+		}
+		if limit == 0 || zoneLimit < limit {
+			limit = zoneLimit
+		}
+	}
+	return limit
+}
+
+// touch bumps the version once per load, like the other tracking states.
+func (s *VehicleSpeedState) touch() {
+	s.updatedAt = time.Now().UTC()
+	if !s.changed {
+		s.version = s.version.Next()
+		s.changed = true
+	}
+}
+
+func (s *VehicleSpeedState) UncommittedEvents() []interface{} {
+	events := s.uncommittedEvents
+	s.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadVehicleSpeedStateFromHistory(
+	tenantID string,
+	vehicleID string,
+	speedLimitKmh float64,
+	lastPosition *valueobject.Coordinate,
+	lastTimestamp int64,
+	speeding bool,
+	version valueobject.Version,
+	updatedAt time.Time,
+) *VehicleSpeedState {
+	return &VehicleSpeedState{
+		tenantID:      tenantID,
+		vehicleID:     vehicleID,
+		speedLimit:    speedLimitKmh,
+		lastPosition:  lastPosition,
+		lastTimestamp: lastTimestamp,
+		speeding:      speeding,
+		version:       version,
+		updatedAt:     updatedAt,
+	}
+}
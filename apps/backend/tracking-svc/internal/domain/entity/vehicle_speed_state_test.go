@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func TestVehicleSpeedStateObserve(t *testing.T) {
+	zone, err := NewCircleGeofence(valueobject.GenerateGeofenceID(), "fleet-a", "Depot", valueobject.CategoryDepot,
+		testCoordinate(t, 10.7769, 106.7009), 500)
+	require.NoError(t, err)
+	require.NoError(t, zone.SetSpeedLimit(30))
+	zones := []*Geofence{zone}
+
+	state := NewVehicleSpeedState("fleet-a", "vehicle-1")
+	require.NoError(t, state.SetSpeedLimit(90))
+	speed := func(kmh float64) *float64 { return &kmh }
+
+	// Without a reported speed the first fix has nothing to derive from.
+	assert.True(t, state.Observe(testCoordinate(t, 10.7769, 106.7009), 100, nil, zones))
+	assert.Empty(t, state.UncommittedEvents())
+
+	// About 111 m in 10 s is 40 km/h, over the zone's 30 km/h.
+	assert.True(t, state.Observe(testCoordinate(t, 10.7779, 106.7009), 110, nil, zones))
+	events := state.UncommittedEvents()
+	require.Len(t, events, 1)
+	alert, ok := events[0].(*event.TrackingAlertEvent)
+	require.True(t, ok)
+	assert.Equal(t, string(valueobject.AlertSpeeding), alert.AlertType)
+	assert.InDelta(t, 40, alert.Details["speedKmh"], 0.5)
+	assert.Equal(t, 30.0, alert.Details["limitKmh"])
+
+	// Still speeding: one alert per episode.
+	assert.True(t, state.Observe(testCoordinate(t, 10.7780, 106.7009), 120, speed(35), zones))
+	assert.Empty(t, state.UncommittedEvents())
+
+	// Outside the zone the vehicle's own 90 km/h applies.
+	assert.True(t, state.Observe(testCoordinate(t, 10.8000, 106.7009), 200, speed(80), zones))
+	assert.False(t, state.Speeding())
+	assert.True(t, state.Observe(testCoordinate(t, 10.8100, 106.7009), 220, speed(100), zones))
+	require.Len(t, state.UncommittedEvents(), 1)
+
+	// Out-of-order fixes are ignored.
+	assert.False(t, state.Observe(testCoordinate(t, 10.7769, 106.7009), 150, speed(120), zones))
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func TestChangeGroupAndReplaceTags_ReportChanges(t *testing.T) {
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 20, 0, 0)
+	require.NoError(t, err)
+	mileage, _ := valueobject.NewMileage(1000)
+	fuel, _ := valueobject.NewFuelLevel(50)
+
+	v, err := NewVehicle(valueobject.GenerateVehicleID(), "fleet-a", "vehicle-1", "1HGBH41JXMN109186",
+		"Truck", "Model", license, valueobject.StatusActive, location, mileage, fuel, valueobject.EnergyICE, 0, nil)
+	require.NoError(t, err)
+	version := v.Version().Value()
+
+	assert.True(t, v.ChangeGroup("North Depot"))
+	assert.False(t, v.ChangeGroup("North Depot"))
+	assert.Equal(t, "North Depot", v.Group())
+
+	assert.True(t, v.ReplaceTags([]string{"hazmat", "reefer"}))
+	assert.False(t, v.ReplaceTags([]string{"hazmat", "reefer"}))
+	assert.Equal(t, []string{"hazmat", "reefer"}, v.Tags())
+
+	assert.True(t, v.ReplaceTags(nil))
+	assert.Empty(t, v.Tags())
+	assert.Equal(t, version+3, v.Version().Value())
+}
+
+func TestApplyAttributeChanges_NilRemoves(t *testing.T) {
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 20, 0, 0)
+	require.NoError(t, err)
+	mileage, _ := valueobject.NewMileage(1000)
+	fuel, _ := valueobject.NewFuelLevel(50)
+
+	v, err := NewVehicle(valueobject.GenerateVehicleID(), "fleet-a", "vehicle-1", "1HGBH41JXMN109186",
+		"Truck", "Model", license, valueobject.StatusActive, location, mileage, fuel, valueobject.EnergyICE, 0,
+		map[string]interface{}{"costCenter": "CC-1"})
+	require.NoError(t, err)
+
+	assert.True(t, v.ApplyAttributeChanges(map[string]interface{}{"costCenter": nil, "axles": float64(3)}))
+	assert.Equal(t, map[string]interface{}{"axles": float64(3)}, v.Attributes())
+	assert.False(t, v.ApplyAttributeChanges(map[string]interface{}{"axles": float64(3)}))
+	assert.False(t, v.ApplyAttributeChanges(nil))
+}
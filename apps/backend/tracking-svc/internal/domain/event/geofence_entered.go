@@ -0,0 +1,27 @@
+package event
+
+type GeofenceEnteredEvent struct {
+	BaseDomainEvent
+	TenantID     string  `json:"tenantId"`
+	GeofenceID   string  `json:"geofenceId"`
+	GeofenceName string  `json:"geofenceName"`
+	Category     string  `json:"category"`
+	VehicleID    string  `json:"vehicleId"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	OccurredAt   int64   `json:"occurredAt"`
+}
+
+func NewGeofenceEnteredEvent(tenantID, geofenceID, geofenceName, category, vehicleID string, latitude, longitude float64, occurredAt int64) *GeofenceEnteredEvent {
+	return &GeofenceEnteredEvent{
+		BaseDomainEvent: InitBaseDomainEvent("geofence.entered", vehicleID),
+		TenantID:        tenantID,
+		GeofenceID:      geofenceID,
+		GeofenceName:    geofenceName,
+		Category:        category,
+		VehicleID:       vehicleID,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		OccurredAt:      occurredAt,
+	}
+}
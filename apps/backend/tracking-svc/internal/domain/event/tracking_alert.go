@@ -0,0 +1,25 @@
+package event
+
+// TrackingAlertEvent keeps the snake_case field names of the alert contract
+// declared in the integration events.
+type TrackingAlertEvent struct {
+	BaseDomainEvent
+	TenantID  string             `json:"tenantId"`
+	VehicleID string             `json:"vehicle_id"`
+	AlertType string             `json:"alert_type"` // e.g., "fuel_drop"
+	Message   string             `json:"message"`
+	Details   map[string]float64 `json:"details,omitempty"`
+	Timestamp int64              `json:"timestamp"`
+}
+
+func NewTrackingAlertEvent(tenantID, vehicleID, alertType, message string, details map[string]float64, timestamp int64) *TrackingAlertEvent {
+	return &TrackingAlertEvent{
+		BaseDomainEvent: InitBaseDomainEvent("tracking.alert", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		AlertType:       alertType,
+		Message:         message,
+		Details:         details,
+		Timestamp:       timestamp,
+	}
+}
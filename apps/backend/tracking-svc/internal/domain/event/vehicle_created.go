@@ -2,6 +2,7 @@ package event
 
 type VehicleCreatedEvent struct {
 	BaseDomainEvent
+	TenantID      string  `json:"tenantId"`
 	VehicleID     string  `json:"vehicleId"`
 	VIN           string  `json:"vin"`
 	VehicleName   string  `json:"vehicleName"`
@@ -15,9 +16,10 @@ type VehicleCreatedEvent struct {
 	Timestamp     int64   `json:"timestamp"`
 }
 
-func NewVehicleCreatedEvent(vehicleID, vin, vehicleName, vehicleModel, licenseNumber, status string, latitude, longitude float64, mileage, fuelLevel float64, timestamp int64) *VehicleCreatedEvent {
+func NewVehicleCreatedEvent(tenantID, vehicleID, vin, vehicleName, vehicleModel, licenseNumber, status string, latitude, longitude float64, mileage, fuelLevel float64, timestamp int64) *VehicleCreatedEvent {
 	return &VehicleCreatedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.created", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		VIN:             vin,
 		VehicleName:     vehicleName,
@@ -2,6 +2,7 @@ package event
 
 type VehicleLocationUpdatedEvent struct {
 	BaseDomainEvent
+	TenantID  string  `json:"tenantId"`
 	VehicleID string  `json:"vehicleId"`
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
@@ -11,9 +12,10 @@ type VehicleLocationUpdatedEvent struct {
 	Version   int64   `json:"version"`
 }
 
-func NewVehicleLocationUpdatedEvent(vehicleID string, latitude, longitude, altitude float64, timestamp, updatedAt, version int64) *VehicleLocationUpdatedEvent {
+func NewVehicleLocationUpdatedEvent(tenantID, vehicleID string, latitude, longitude, altitude float64, timestamp, updatedAt, version int64) *VehicleLocationUpdatedEvent {
 	return &VehicleLocationUpdatedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.location.updated", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		Latitude:        latitude,
 		Longitude:       longitude,
@@ -2,6 +2,7 @@ package event
 
 type VehicleStatusChangedEvent struct {
 	BaseDomainEvent
+	TenantID  string `json:"tenantId"`
 	VehicleID string `json:"vehicleId"`
 	OldStatus string `json:"oldStatus"`
 	NewStatus string `json:"newStatus"`
@@ -9,9 +10,10 @@ type VehicleStatusChangedEvent struct {
 	Version   int64  `json:"version"`
 }
 
-func NewVehicleStatusChangedEvent(vehicleID, oldStatus, newStatus string, changedAt, version int64) *VehicleStatusChangedEvent {
+func NewVehicleStatusChangedEvent(tenantID, vehicleID, oldStatus, newStatus string, changedAt, version int64) *VehicleStatusChangedEvent {
 	return &VehicleStatusChangedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.status.changed", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		OldStatus:       oldStatus,
 		NewStatus:       newStatus,
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+)
+
+type FuelProfileRepository interface {
+	Save(ctx context.Context, profile *entity.FuelProfile) error
+
+	// FindByVehicleModel returns nil without error when the model has no profile.
+	FindByVehicleModel(ctx context.Context, vehicleModel string) (*entity.FuelProfile, error)
+
+	FindAll(ctx context.Context) ([]*entity.FuelProfile, error)
+
+	Delete(ctx context.Context, vehicleModel string) error
+}
+
+type VehicleFuelStateRepository interface {
+	Save(ctx context.Context, state *entity.VehicleFuelState) error
+
+	// FindByVehicleID returns nil without error when the vehicle has no state yet.
+	FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleFuelState, error)
+}
+
+type RefuelRepository interface {
+	Save(ctx context.Context, refuel *entity.Refuel) error
+
+	// FindByVehicleID returns the vehicle's refuels, newest first.
+	FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.Refuel, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type GeofenceRepository interface {
+	Save(ctx context.Context, geofence *entity.Geofence) error
+
+	FindByID(ctx context.Context, id valueobject.GeofenceID) (*entity.Geofence, error)
+
+	// FindAll returns every geofence when limit is zero.
+	FindAll(ctx context.Context, limit int, offset int) ([]*entity.Geofence, error)
+
+	Delete(ctx context.Context, id valueobject.GeofenceID) error
+}
+
+type VehicleGeofenceStateRepository interface {
+	Save(ctx context.Context, state *entity.VehicleGeofenceState) error
+
+	// FindByVehicleID returns nil without error when the vehicle has no state yet.
+	FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleGeofenceState, error)
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+// RollupProgress is when the points rolled up so far were saved up to, and
+// the end of a pass that has not finished; either is zero when there is none.
+type RollupProgress struct {
+	Done    time.Time
+	Pending time.Time
+}
+
+type LocationPointRepository interface {
+	// Save records a raw point, archived or not.
+	Save(ctx context.Context, point *entity.LocationPoint) error
+
+	// FindAround returns the vehicle's last point at resolution timed at or
+	// before at and its first point after it; either is nil when there is
+	// none. Archived points are left out here and in EachBetween.
+	FindAround(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, at time.Time) (before, after *entity.LocationPoint, err error)
+
+	// EachBetween calls fn with the vehicle's points at resolution timed
+	// within [from, to], oldest first, decoding one at a time so long ranges
+	// need not fit in memory. A zero from leaves the range open. It stops at
+	// the first error fn returns.
+	EachBetween(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, from, to time.Time, fn func(*entity.LocationPoint) error) error
+
+	// EachArchivedBetween calls fn with the vehicle's archived raw points
+	// timed within [from, to], oldest first. They are kept for the raw
+	// retention.
+	EachArchivedBetween(ctx context.Context, vehicleID string, from, to time.Time, fn func(*entity.LocationPoint) error) error
+
+	// EachRecordedBetween calls fn with the raw points of every tenant that
+	// were saved after from and up to to, in no particular order. Archived
+	// points are left out, so they never reach a rollup.
+	EachRecordedBetween(ctx context.Context, from, to time.Time, fn func(*entity.LocationPoint) error) error
+
+	// MergeRollups folds the rollups of one pass into those stored at their
+	// resolution. A stored rollup that the pass ending at run already merged
+	// into is left alone, so a pass that failed part way can be repeated.
+	MergeRollups(ctx context.Context, resolution valueobject.LocationResolution, rollups []*entity.LocationPoint, run time.Time) error
+
+	FindRollupProgress(ctx context.Context) (RollupProgress, error)
+
+	// SwapRollupProgress replaces the progress with next if it is still old,
+	// and reports whether it did. Passes are started and finished only
+	// through it, so replicas rolling up at once never merge overlapping
+	// windows.
+	SwapRollupProgress(ctx context.Context, old, next RollupProgress) (bool, error)
+
+	// SetVehicleArchived hides a vehicle's points and rollups from every read
+	// while the vehicle is deleted, or shows them again once it is restored.
+	SetVehicleArchived(ctx context.Context, vehicleID string, archived bool) error
+}
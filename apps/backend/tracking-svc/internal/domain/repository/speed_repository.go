@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+)
+
+type VehicleSpeedStateRepository interface {
+	Save(ctx context.Context, state *entity.VehicleSpeedState) error
+
+	// FindByVehicleID returns nil without error when the vehicle has no state yet.
+	FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleSpeedState, error)
+}
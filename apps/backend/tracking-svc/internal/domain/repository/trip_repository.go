@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+)
+
+type TripRepository interface {
+	Save(ctx context.Context, trip *entity.Trip) error
+
+	// FindOpenByVehicleID returns nil without error when the vehicle is not on a trip.
+	FindOpenByVehicleID(ctx context.Context, vehicleID string) (*entity.Trip, error)
+
+	// FindOpenIdleSince returns open trips whose last movement is before cutoff.
+	FindOpenIdleSince(ctx context.Context, cutoff time.Time) ([]*entity.Trip, error)
+
+	// FindByVehicleID returns trips overlapping [from, to], newest first. A zero
+	// time leaves that end of the range open.
+	FindByVehicleID(ctx context.Context, vehicleID string, from, to time.Time, limit int, offset int) ([]*entity.Trip, error)
+}
+
+type VehicleMotionStateRepository interface {
+	Save(ctx context.Context, state *entity.VehicleMotionState) error
+
+	// FindByVehicleID returns nil without error when the vehicle has no state yet.
+	FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleMotionState, error)
+}
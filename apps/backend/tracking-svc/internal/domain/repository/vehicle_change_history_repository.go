@@ -11,5 +11,11 @@ type VehicleChangeHistoryRepository interface {
 
 	FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error)
 
+	FindByDriverID(ctx context.Context, driverID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error)
+
 	FindByChangeType(ctx context.Context, changeType string, limit int, offset int) ([]*entity.VehicleChangeHistory, error)
+
+	// SetArchived hides or shows a vehicle's history in FindByDriverID and
+	// FindByChangeType. FindByVehicleID always returns it.
+	SetArchived(ctx context.Context, vehicleID string, archived bool) error
 }
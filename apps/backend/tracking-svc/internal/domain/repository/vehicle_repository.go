@@ -2,18 +2,41 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
 
+// ErrVehicleNotFound is returned, wrapped, by FindByID and FindByRefID when
+// the caller's tenant has no such vehicle.
+var ErrVehicleNotFound = errors.New("vehicle not found")
+
+// VehicleDistance is a vehicle found by FindNear with its distance from the
+// search position.
+type VehicleDistance struct {
+	Vehicle        *entity.Vehicle
+	DistanceMeters float64
+}
+
 type VehicleRepository interface {
 	Save(ctx context.Context, vehicle *entity.Vehicle) error
 
 	FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error)
 
+	// FindByRefID looks a projection up by the vehicle-svc vehicle id.
+	FindByRefID(ctx context.Context, refID string) (*entity.Vehicle, error)
+
 	FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error)
 
+	// FindNear returns vehicles within radiusMeters of center, nearest first.
+	// An empty status matches any.
+	FindNear(ctx context.Context, center valueobject.Coordinate, radiusMeters float64, status valueobject.VehicleStatus, limit int) ([]VehicleDistance, error)
+
+	// FindInBox returns vehicles whose position lies between southWest and
+	// northEast. An empty status matches any.
+	FindInBox(ctx context.Context, southWest, northEast valueobject.Coordinate, status valueobject.VehicleStatus, limit int, offset int) ([]*entity.Vehicle, error)
+
 	Delete(ctx context.Context, id valueobject.VehicleID) error
 
 	ExistsByVIN(ctx context.Context, vin string) (bool, error)
@@ -0,0 +1,30 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+)
+
+// DefaultID is the tenant that owns data written without an explicit
+// organization, so single-fleet deployments keep working unchanged.
+const DefaultID = "default"
+
+type ctxKey struct{}
+
+// WithID returns a copy of ctx scoped to the given tenant. An empty id scopes
+// the context to DefaultID.
+func WithID(ctx context.Context, id string) context.Context {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		id = DefaultID
+	}
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// ID returns the tenant the context is scoped to, or DefaultID.
+func ID(ctx context.Context) string {
+	if id, ok := ctx.Value(ctxKey{}).(string); ok {
+		return id
+	}
+	return DefaultID
+}
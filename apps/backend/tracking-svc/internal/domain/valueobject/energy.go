@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidEnergyType = errors.New("invalid energy type")
+
+// EnergyType is what propels a vehicle, as set in vehicle-svc. For a BEV the
+// fuel level is the battery state of charge.
+type EnergyType string
+
+const (
+	EnergyICE    EnergyType = "ice" // internal combustion
+	EnergyHybrid EnergyType = "hybrid"
+	EnergyBEV    EnergyType = "bev" // battery electric
+)
+
+// NewEnergyType parses an energy type case-insensitively. An empty type means
+// ICE, which is what vehicles created before energy types are.
+func NewEnergyType(energyType string) (EnergyType, error) {
+	switch t := EnergyType(strings.ToLower(strings.TrimSpace(energyType))); t {
+	case "":
+		return EnergyICE, nil
+	case EnergyICE, EnergyHybrid, EnergyBEV:
+		return t, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidEnergyType, energyType)
+	}
+}
+
+// CapacityUnit is liters of fuel, or kWh of battery for a BEV.
+func (t EnergyType) CapacityUnit() string {
+	if t == EnergyBEV {
+		return "kWh"
+	}
+	return "L"
+}
@@ -0,0 +1,77 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidResolution = errors.New("invalid location resolution")
+
+// LocationResolution is the granularity location history is read at: every
+// recorded point, or one rolled-up point per minute or hour.
+type LocationResolution string
+
+const (
+	ResolutionRaw    LocationResolution = "raw"
+	ResolutionMinute LocationResolution = "1m"
+	ResolutionHour   LocationResolution = "1h"
+)
+
+const (
+	// Longest ranges read at each resolution, which keep a response to some
+	// thousands of points for a vehicle reporting every few seconds.
+	maxRawSpan    = 6 * time.Hour
+	maxMinuteSpan = 7 * 24 * time.Hour
+)
+
+func NewLocationResolution(resolution string) (LocationResolution, error) {
+	switch LocationResolution(strings.ToLower(strings.TrimSpace(resolution))) {
+	case ResolutionRaw:
+		return ResolutionRaw, nil
+	case ResolutionMinute:
+		return ResolutionMinute, nil
+	case ResolutionHour:
+		return ResolutionHour, nil
+	default:
+		return "", fmt.Errorf("%w: %q (use raw, 1m or 1h)", ErrInvalidResolution, resolution)
+	}
+}
+
+// Bucket is the span one rolled-up point covers; zero for raw points.
+func (r LocationResolution) Bucket() time.Duration {
+	switch r {
+	case ResolutionMinute:
+		return time.Minute
+	case ResolutionHour:
+		return time.Hour
+	default:
+		return 0
+	}
+}
+
+// LocationRetention is how long raw points and minute rollups are kept.
+// Hourly rollups are kept for good.
+type LocationRetention struct {
+	Raw    time.Duration
+	Minute time.Duration
+}
+
+// Resolution picks the finest resolution that keeps [from, to] to a readable
+// number of points and still holds data at from. A zero from is an open
+// range and is read hourly.
+func (r LocationRetention) Resolution(from, to, now time.Time) LocationResolution {
+	if from.IsZero() {
+		return ResolutionHour
+	}
+	span := to.Sub(from)
+	switch {
+	case span <= maxRawSpan && !from.Before(now.Add(-r.Raw)):
+		return ResolutionRaw
+	case span <= maxMinuteSpan && !from.Before(now.Add(-r.Minute)):
+		return ResolutionMinute
+	default:
+		return ResolutionHour
+	}
+}
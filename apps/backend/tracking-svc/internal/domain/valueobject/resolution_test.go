@@ -0,0 +1,42 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationRetentionResolution(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	retention := LocationRetention{Raw: 7 * 24 * time.Hour, Minute: 90 * 24 * time.Hour}
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want LocationResolution
+	}{
+		{"short recent range", now.Add(-2 * time.Hour), now, ResolutionRaw},
+		{"longer than raw span", now.Add(-2 * day), now, ResolutionMinute},
+		{"short range past raw retention", now.Add(-10 * day), now.Add(-10*day + time.Hour), ResolutionMinute},
+		{"longer than minute span", now.Add(-30 * day), now, ResolutionHour},
+		{"short range past minute retention", now.Add(-120 * day), now.Add(-120*day + time.Hour), ResolutionHour},
+		{"open range", time.Time{}, now, ResolutionHour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, retention.Resolution(tt.from, tt.to, now), tt.name)
+	}
+}
+
+func TestNewLocationResolution(t *testing.T) {
+	resolution, err := NewLocationResolution(" 1M ")
+	assert.NoError(t, err)
+	assert.Equal(t, ResolutionMinute, resolution)
+	assert.Equal(t, time.Minute, resolution.Bucket())
+
+	_, err = NewLocationResolution("5m")
+	assert.ErrorIs(t, err, ErrInvalidResolution)
+}
@@ -0,0 +1,86 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var ErrInvalidUnitSystem = errors.New("invalid unit system")
+
+// UnitSystem is the system of units a client reads and writes distances and
+// volumes in. Values are always stored in metric; conversion only happens at
+// the API boundary.
+type UnitSystem string
+
+const (
+	UnitsMetric   UnitSystem = "metric"
+	UnitsImperial UnitSystem = "imperial" // miles and US gallons
+)
+
+const (
+	kilometersPerMile = 1.609344
+	litersPerGallon   = 3.785411784
+)
+
+// NewUnitSystem parses a unit system name case-insensitively. An empty name
+// means metric.
+func NewUnitSystem(system string) (UnitSystem, error) {
+	switch UnitSystem(strings.ToLower(strings.TrimSpace(system))) {
+	case "", UnitsMetric:
+		return UnitsMetric, nil
+	case UnitsImperial:
+		return UnitsImperial, nil
+	default:
+		return "", fmt.Errorf("%w: %q (use metric or imperial)", ErrInvalidUnitSystem, system)
+	}
+}
+
+func (u UnitSystem) DistanceUnit() string {
+	if u == UnitsImperial {
+		return "mi"
+	}
+	return "km"
+}
+
+func (u UnitSystem) VolumeUnit() string {
+	if u == UnitsImperial {
+		return "gal"
+	}
+	return "L"
+}
+
+// FromKilometers converts a stored distance for output. Imperial values are
+// rounded to the metre-level precision mileage is compared at.
+func (u UnitSystem) FromKilometers(km float64) float64 {
+	if u != UnitsImperial {
+		return km
+	}
+	return roundTo3(km / kilometersPerMile)
+}
+
+func (u UnitSystem) ToKilometers(distance float64) float64 {
+	if u != UnitsImperial {
+		return distance
+	}
+	return distance * kilometersPerMile
+}
+
+func (u UnitSystem) FromLiters(liters float64) float64 {
+	if u != UnitsImperial {
+		return liters
+	}
+	return roundTo3(liters / litersPerGallon)
+}
+
+func (u UnitSystem) ToLiters(volume float64) float64 {
+	if u != UnitsImperial {
+		return volume
+	}
+	return volume * litersPerGallon
+}
+
+func roundTo3(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
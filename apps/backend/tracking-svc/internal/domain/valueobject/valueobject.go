@@ -99,6 +99,227 @@ func (l Location) Equals(other Location) bool {
 		l.timestamp == other.timestamp
 }
 
+type GeofenceID struct {
+	value string
+}
+
+func NewGeofenceID(id string) (GeofenceID, error) {
+	if id == "" {
+		return GeofenceID{}, fmt.Errorf("geofence id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return GeofenceID{}, fmt.Errorf("invalid geofence id format: %w", err)
+	}
+	return GeofenceID{value: id}, nil
+}
+
+func GenerateGeofenceID() GeofenceID {
+	return GeofenceID{value: uuid.New().String()}
+}
+
+func (g GeofenceID) String() string {
+	return g.value
+}
+
+func (g GeofenceID) Equals(other GeofenceID) bool {
+	return g.value == other.value
+}
+
+// Coordinate is a bare WGS84 point, used for zone geometry where altitude and
+// timestamp do not apply.
+type Coordinate struct {
+	latitude  float64
+	longitude float64
+}
+
+func NewCoordinate(latitude, longitude float64) (Coordinate, error) {
+	if latitude < -90 || latitude > 90 {
+		return Coordinate{}, fmt.Errorf("invalid latitude: %f", latitude)
+	}
+	if longitude < -180 || longitude > 180 {
+		return Coordinate{}, fmt.Errorf("invalid longitude: %f", longitude)
+	}
+	return Coordinate{latitude: latitude, longitude: longitude}, nil
+}
+
+func (c Coordinate) Latitude() float64 {
+	return c.latitude
+}
+
+func (c Coordinate) Longitude() float64 {
+	return c.longitude
+}
+
+type GeofenceShape string
+
+const (
+	ShapeCircle  GeofenceShape = "circle"
+	ShapePolygon GeofenceShape = "polygon"
+)
+
+func NewGeofenceShape(shape string) (GeofenceShape, error) {
+	s := GeofenceShape(shape)
+	switch s {
+	case ShapeCircle, ShapePolygon:
+		return s, nil
+	default:
+		return "", fmt.Errorf("invalid geofence shape: %s", shape)
+	}
+}
+
+type GeofenceCategory string
+
+const (
+	CategoryDepot          GeofenceCategory = "depot"
+	CategoryCustomerSite   GeofenceCategory = "customer_site"
+	CategoryRestrictedArea GeofenceCategory = "restricted_area"
+	CategoryOther          GeofenceCategory = "other"
+)
+
+func NewGeofenceCategory(category string) (GeofenceCategory, error) {
+	c := GeofenceCategory(category)
+	switch c {
+	case CategoryDepot, CategoryCustomerSite, CategoryRestrictedArea, CategoryOther:
+		return c, nil
+	case "":
+		return CategoryOther, nil
+	default:
+		return "", fmt.Errorf("invalid geofence category: %s", category)
+	}
+}
+
+type TripID struct {
+	value string
+}
+
+func NewTripID(id string) (TripID, error) {
+	if id == "" {
+		return TripID{}, fmt.Errorf("trip id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return TripID{}, fmt.Errorf("invalid trip id format: %w", err)
+	}
+	return TripID{value: id}, nil
+}
+
+func GenerateTripID() TripID {
+	return TripID{value: uuid.New().String()}
+}
+
+func (t TripID) String() string {
+	return t.value
+}
+
+func (t TripID) Equals(other TripID) bool {
+	return t.value == other.value
+}
+
+type TripStatus string
+
+const (
+	TripInProgress TripStatus = "in_progress"
+	TripCompleted  TripStatus = "completed"
+)
+
+func NewTripStatus(status string) (TripStatus, error) {
+	s := TripStatus(status)
+	switch s {
+	case TripInProgress, TripCompleted:
+		return s, nil
+	default:
+		return "", fmt.Errorf("invalid trip status: %s", status)
+	}
+}
+
+// TrackPoint is a position fix with the unix time it was taken at.
+type TrackPoint struct {
+	coordinate Coordinate
+	timestamp  int64
+}
+
+func NewTrackPoint(latitude, longitude float64, timestamp int64) (TrackPoint, error) {
+	coordinate, err := NewCoordinate(latitude, longitude)
+	if err != nil {
+		return TrackPoint{}, err
+	}
+	if timestamp < 0 {
+		return TrackPoint{}, fmt.Errorf("invalid timestamp: %d", timestamp)
+	}
+	return TrackPoint{coordinate: coordinate, timestamp: timestamp}, nil
+}
+
+func (p TrackPoint) Coordinate() Coordinate {
+	return p.coordinate
+}
+
+func (p TrackPoint) Latitude() float64 {
+	return p.coordinate.latitude
+}
+
+func (p TrackPoint) Longitude() float64 {
+	return p.coordinate.longitude
+}
+
+func (p TrackPoint) Timestamp() int64 {
+	return p.timestamp
+}
+
+type RefuelID struct {
+	value string
+}
+
+func NewRefuelID(id string) (RefuelID, error) {
+	if id == "" {
+		return RefuelID{}, fmt.Errorf("refuel id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return RefuelID{}, fmt.Errorf("invalid refuel id format: %w", err)
+	}
+	return RefuelID{value: id}, nil
+}
+
+func GenerateRefuelID() RefuelID {
+	return RefuelID{value: uuid.New().String()}
+}
+
+func (r RefuelID) String() string {
+	return r.value
+}
+
+func (r RefuelID) Equals(other RefuelID) bool {
+	return r.value == other.value
+}
+
+// AlertType classifies a TrackingAlertEvent.
+type AlertType string
+
+const (
+	AlertFuelDrop  AlertType = "fuel_drop"
+	AlertLowEnergy AlertType = "low_energy"
+	AlertSpeeding  AlertType = "speeding"
+)
+
+// PositionMethod is how an estimated position was obtained.
+type PositionMethod string
+
+const (
+	PositionFix          PositionMethod = "fix"          // a fix was taken at that second
+	PositionInterpolated PositionMethod = "interpolated" // between the fixes either side
+	PositionLastKnown    PositionMethod = "last_known"   // after the latest fix
+	PositionFirstKnown   PositionMethod = "first_known"  // before the earliest fix
+)
+
+// PositionConfidence grades an estimated position by how far it is in time
+// from the fixes it is based on.
+type PositionConfidence string
+
+const (
+	ConfidenceExact  PositionConfidence = "exact"
+	ConfidenceHigh   PositionConfidence = "high"
+	ConfidenceMedium PositionConfidence = "medium"
+	ConfidenceLow    PositionConfidence = "low"
+)
+
 type Version struct {
 	value int64
 }
@@ -137,6 +358,16 @@ func (m Mileage) Kilometers() float64 {
 	return m.kilometers
 }
 
+// NewMileageIn reads a mileage given in the client's unit system.
+func NewMileageIn(distance float64, units UnitSystem) (Mileage, error) {
+	return NewMileage(units.ToKilometers(distance))
+}
+
+// In returns the mileage in the client's unit system.
+func (m Mileage) In(units UnitSystem) float64 {
+	return units.FromKilometers(m.kilometers)
+}
+
 func (m Mileage) AddKilometers(km float64) (Mileage, error) {
 	if km < 0 {
 		return Mileage{}, fmt.Errorf("cannot add negative kilometers")
@@ -164,10 +395,6 @@ func (f FuelLevel) Percentage() float64 {
 	return f.percentage
 }
 
-func (f FuelLevel) IsLow() bool {
-	return f.percentage < 15
-}
-
 func (f FuelLevel) Equals(other FuelLevel) bool {
 	const epsilon = 0.001
 	return (f.percentage-other.percentage) < epsilon && (other.percentage-f.percentage) < epsilon
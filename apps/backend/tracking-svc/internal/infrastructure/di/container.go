@@ -16,6 +16,7 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/query"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/service"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/messaging"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/infrastructure/persistence"
 )
@@ -27,17 +28,41 @@ type Container struct {
 	VehicleRepository              repository.VehicleRepository
 	OutboxRepository               repository.OutboxRepository
 	VehicleChangeHistoryRepository repository.VehicleChangeHistoryRepository
+	GeofenceRepository             repository.GeofenceRepository
+	VehicleGeofenceStateRepository repository.VehicleGeofenceStateRepository
+	TripRepository                 repository.TripRepository
+	VehicleMotionStateRepository   repository.VehicleMotionStateRepository
+	FuelProfileRepository          repository.FuelProfileRepository
+	VehicleFuelStateRepository     repository.VehicleFuelStateRepository
+	RefuelRepository               repository.RefuelRepository
+	VehicleSpeedStateRepository    repository.VehicleSpeedStateRepository
+	LocationPointRepository        repository.LocationPointRepository
 
 	CommandBus     command.CommandBus
 	QueryBus       query.QueryBus
 	EventPublisher messaging.EventPublisher
 
 	// Event handlers for consuming external events
-	VehicleCreatedEventHandler          *handler.VehicleCreatedEventHandler
-	VehicleLocationUpdatedEventHandler  *handler.VehicleLocationUpdatedEventHandler
-	VehicleStatusChangedEventHandler    *handler.VehicleStatusChangedEventHandler
-	VehicleMileageUpdatedEventHandler   *handler.VehicleMileageUpdatedEventHandler
-	VehicleFuelLevelUpdatedEventHandler *handler.VehicleFuelLevelUpdatedEventHandler
+	VehicleCreatedEventHandler            *handler.VehicleCreatedEventHandler
+	VehicleLocationUpdatedEventHandler    *handler.VehicleLocationUpdatedEventHandler
+	VehicleLocationArchivedEventHandler   *handler.VehicleLocationArchivedEventHandler
+	VehicleStatusChangedEventHandler      *handler.VehicleStatusChangedEventHandler
+	VehicleMileageUpdatedEventHandler     *handler.VehicleMileageUpdatedEventHandler
+	VehicleFuelLevelUpdatedEventHandler   *handler.VehicleFuelLevelUpdatedEventHandler
+	VehicleEnergyLevelUpdatedEventHandler *handler.VehicleEnergyLevelUpdatedEventHandler
+	DriverAssignedEventHandler            *handler.DriverAssignedEventHandler
+	DriverUnassignedEventHandler          *handler.DriverUnassignedEventHandler
+	DevicePairedEventHandler              *handler.DevicePairedEventHandler
+	DeviceUnpairedEventHandler            *handler.DeviceUnpairedEventHandler
+	TrackingCorrectionAppliedHandler      *handler.TrackingCorrectionAppliedEventHandler
+	VehicleDetailsUpdatedEventHandler     *handler.VehicleDetailsUpdatedEventHandler
+	VehicleGroupChangedEventHandler       *handler.VehicleGroupChangedEventHandler
+	VehicleTagsChangedEventHandler        *handler.VehicleTagsChangedEventHandler
+	VehicleDeletedEventHandler            *handler.VehicleDeletedEventHandler
+	VehicleRestoredEventHandler           *handler.VehicleRestoredEventHandler
+
+	locationRepo            *persistence.MongoLocationPointRepository
+	changeHistoryCollection *mongo.Collection
 }
 
 func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger) (*Container, error) {
@@ -54,6 +79,9 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 
 	vehicleCollection := db.Collection("vehicles")
 	vehicleRepo := persistence.NewMongoVehicleRepository(vehicleCollection)
+	if err := vehicleRepo.EnsureIndexes(ctx); err != nil {
+		return nil, err
+	}
 
 	outboxCollection := db.Collection("outbox")
 	outboxRepo := persistence.NewMongoOutboxRepository(outboxCollection)
@@ -61,6 +89,39 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 	vehicleChangeHistoryCollection := db.Collection("vehicle_change_history")
 	changeHistoryRepo := persistence.NewMongoVehicleChangeHistoryRepository(vehicleChangeHistoryCollection)
 
+	geofenceCollection := db.Collection("geofences")
+	geofenceRepo := persistence.NewMongoGeofenceRepository(geofenceCollection)
+
+	vehicleGeofenceStateCollection := db.Collection("vehicle_geofence_states")
+	geofenceStateRepo := persistence.NewMongoVehicleGeofenceStateRepository(vehicleGeofenceStateCollection)
+
+	tripCollection := db.Collection("trips")
+	tripRepo := persistence.NewMongoTripRepository(tripCollection)
+
+	vehicleMotionStateCollection := db.Collection("vehicle_motion_states")
+	motionStateRepo := persistence.NewMongoVehicleMotionStateRepository(vehicleMotionStateCollection)
+
+	fuelProfileCollection := db.Collection("fuel_profiles")
+	fuelProfileRepo := persistence.NewMongoFuelProfileRepository(fuelProfileCollection)
+
+	vehicleFuelStateCollection := db.Collection("vehicle_fuel_states")
+	fuelStateRepo := persistence.NewMongoVehicleFuelStateRepository(vehicleFuelStateCollection)
+
+	refuelCollection := db.Collection("refuels")
+	refuelRepo := persistence.NewMongoRefuelRepository(refuelCollection)
+
+	vehicleSpeedStateCollection := db.Collection("vehicle_speed_states")
+	speedStateRepo := persistence.NewMongoVehicleSpeedStateRepository(vehicleSpeedStateCollection)
+
+	locationRetention := valueobject.LocationRetention{
+		Raw:    config.Location.RawRetention,
+		Minute: config.Location.MinuteRetention,
+	}
+	locationRepo := persistence.NewMongoLocationPointRepository(db, locationRetention)
+	if err := locationRepo.EnsureCollections(ctx); err != nil {
+		return nil, err
+	}
+
 	commandBus := messaging.NewInMemoryCommandBus()
 
 	commandBus.Register(
@@ -69,7 +130,99 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 	)
 	commandBus.Register(
 		"RecordVehicleChange",
-		service.NewRecordVehicleChangeCommandHandler(changeHistoryRepo),
+		service.NewRecordVehicleChangeCommandHandler(changeHistoryRepo, vehicleRepo),
+	)
+	commandBus.Register(
+		"RecordLocationPoint",
+		service.NewRecordLocationPointCommandHandler(locationRepo),
+	)
+	commandBus.Register(
+		"RollUpLocations",
+		service.NewRollUpLocationsCommandHandler(locationRepo),
+	)
+	commandBus.Register(
+		"AssignVehicleDriver",
+		service.NewAssignVehicleDriverCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"UnassignVehicleDriver",
+		service.NewUnassignVehicleDriverCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"UpdateVehicleDetails",
+		service.NewUpdateVehicleDetailsCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"UpdateVehicleEnergyLevel",
+		service.NewUpdateVehicleEnergyLevelCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"ChangeVehicleGroup",
+		service.NewChangeVehicleGroupCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"ReplaceVehicleTags",
+		service.NewReplaceVehicleTagsCommandHandler(vehicleRepo),
+	)
+	commandBus.Register(
+		"ArchiveVehicle",
+		service.NewArchiveVehicleCommandHandler(vehicleRepo, changeHistoryRepo, locationRepo),
+	)
+	commandBus.Register(
+		"UnarchiveVehicle",
+		service.NewUnarchiveVehicleCommandHandler(vehicleRepo, changeHistoryRepo, locationRepo),
+	)
+	commandBus.Register(
+		"CreateGeofence",
+		service.NewCreateGeofenceCommandHandler(geofenceRepo),
+	)
+	commandBus.Register(
+		"UpdateGeofence",
+		service.NewUpdateGeofenceCommandHandler(geofenceRepo),
+	)
+	commandBus.Register(
+		"DeleteGeofence",
+		service.NewDeleteGeofenceCommandHandler(geofenceRepo),
+	)
+	commandBus.Register(
+		"EvaluateGeofences",
+		service.NewEvaluateGeofencesCommandHandler(geofenceRepo, geofenceStateRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"EvaluateSpeed",
+		service.NewEvaluateSpeedCommandHandler(geofenceRepo, speedStateRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"SetVehicleSpeedLimit",
+		service.NewSetVehicleSpeedLimitCommandHandler(vehicleRepo, speedStateRepo),
+	)
+	commandBus.Register(
+		"RecordTripPoint",
+		service.NewRecordTripPointCommandHandler(tripRepo, motionStateRepo, config.Trip.StationaryTimeout, config.Trip.MinMovementMeters),
+	)
+	commandBus.Register(
+		"CloseIdleTrips",
+		service.NewCloseIdleTripsCommandHandler(tripRepo, motionStateRepo, config.Trip.StationaryTimeout),
+	)
+	commandBus.Register(
+		"EvaluateFuelLevel",
+		service.NewEvaluateFuelLevelCommandHandler(vehicleRepo, fuelProfileRepo, fuelStateRepo, refuelRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"RecordFuelMileage",
+		service.NewRecordFuelMileageCommandHandler(fuelStateRepo),
+	)
+	commandBus.Register(
+		"CorrectFuelReading",
+		service.NewCorrectFuelReadingCommandHandler(fuelStateRepo),
+	)
+	commandBus.Register(
+		"UpsertFuelProfile",
+		service.NewUpsertFuelProfileCommandHandler(fuelProfileRepo),
+	)
+	commandBus.Register(
+		"DeleteFuelProfile",
+		service.NewDeleteFuelProfileCommandHandler(fuelProfileRepo),
 	)
 
 	queryBus := messaging.NewInMemoryQueryBus()
@@ -82,9 +235,69 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 		"GetAllVehicles",
 		service.NewGetAllVehiclesQueryHandler(vehicleRepo),
 	)
+	queryBus.Register(
+		"GetVehiclesNear",
+		service.NewGetVehiclesNearQueryHandler(vehicleRepo),
+	)
+	queryBus.Register(
+		"GetVehiclesInBox",
+		service.NewGetVehiclesInBoxQueryHandler(vehicleRepo),
+	)
 	queryBus.Register(
 		"GetVehicleChangeHistory",
-		service.NewGetVehicleChangeHistoryQueryHandler(changeHistoryRepo, vehicleRepo),
+		service.NewGetVehicleChangeHistoryQueryHandler(changeHistoryRepo, vehicleRepo, locationRepo, locationRetention),
+	)
+	queryBus.Register(
+		"GetVehicleLocations",
+		service.NewGetVehicleLocationsQueryHandler(locationRepo, vehicleRepo, locationRetention),
+	)
+	queryBus.Register(
+		"ExportVehicleTrack",
+		service.NewExportVehicleTrackQueryHandler(locationRepo, vehicleRepo, locationRetention),
+	)
+	queryBus.Register(
+		"GetVehiclePosition",
+		service.NewGetVehiclePositionQueryHandler(locationRepo, vehicleRepo, locationRetention),
+	)
+	queryBus.Register(
+		"GetVehiclePlayback",
+		service.NewGetVehiclePlaybackQueryHandler(locationRepo, vehicleRepo, locationRetention),
+	)
+	queryBus.Register(
+		"GetDriverChangeHistory",
+		service.NewGetDriverChangeHistoryQueryHandler(changeHistoryRepo),
+	)
+	queryBus.Register(
+		"GetGeofence",
+		service.NewGetGeofenceQueryHandler(geofenceRepo),
+	)
+	queryBus.Register(
+		"GetAllGeofences",
+		service.NewGetAllGeofencesQueryHandler(geofenceRepo),
+	)
+	queryBus.Register(
+		"GetVehicleGeofences",
+		service.NewGetVehicleGeofencesQueryHandler(vehicleRepo, geofenceRepo, geofenceStateRepo),
+	)
+	queryBus.Register(
+		"GetVehicleSpeed",
+		service.NewGetVehicleSpeedQueryHandler(vehicleRepo, speedStateRepo),
+	)
+	queryBus.Register(
+		"GetVehicleTrips",
+		service.NewGetVehicleTripsQueryHandler(tripRepo, vehicleRepo),
+	)
+	queryBus.Register(
+		"GetFuelProfile",
+		service.NewGetFuelProfileQueryHandler(fuelProfileRepo),
+	)
+	queryBus.Register(
+		"GetAllFuelProfiles",
+		service.NewGetAllFuelProfilesQueryHandler(fuelProfileRepo),
+	)
+	queryBus.Register(
+		"GetVehicleRefuels",
+		service.NewGetVehicleRefuelsQueryHandler(refuelRepo, vehicleRepo),
 	)
 
 	// Wire Kafka publisher
@@ -100,27 +313,69 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 
 	vehicleCreatedHandler := handler.NewVehicleCreatedEventHandler(commandBus, logger)
 	vehicleLocationUpdatedHandler := handler.NewVehicleLocationUpdatedEventHandler(commandBus, logger)
+	vehicleLocationArchivedHandler := handler.NewVehicleLocationArchivedEventHandler(commandBus, logger)
 	vehicleStatusChangedHandler := handler.NewVehicleStatusChangedEventHandler(commandBus, logger)
 	vehicleMileageUpdatedHandler := handler.NewVehicleMileageUpdatedEventHandler(commandBus, logger)
 	vehicleFuelLevelUpdatedHandler := handler.NewVehicleFuelLevelUpdatedEventHandler(commandBus, logger)
+	vehicleEnergyLevelUpdatedHandler := handler.NewVehicleEnergyLevelUpdatedEventHandler(commandBus, logger)
+	driverAssignedHandler := handler.NewDriverAssignedEventHandler(commandBus, logger)
+	driverUnassignedHandler := handler.NewDriverUnassignedEventHandler(commandBus, logger)
+	devicePairedHandler := handler.NewDevicePairedEventHandler(commandBus, logger)
+	deviceUnpairedHandler := handler.NewDeviceUnpairedEventHandler(commandBus, logger)
+	trackingCorrectionAppliedHandler := handler.NewTrackingCorrectionAppliedEventHandler(commandBus, logger)
+	vehicleDetailsUpdatedHandler := handler.NewVehicleDetailsUpdatedEventHandler(commandBus, logger)
+	vehicleGroupChangedHandler := handler.NewVehicleGroupChangedEventHandler(commandBus, logger)
+	vehicleTagsChangedHandler := handler.NewVehicleTagsChangedEventHandler(commandBus, logger)
+	vehicleDeletedHandler := handler.NewVehicleDeletedEventHandler(commandBus, logger)
+	vehicleRestoredHandler := handler.NewVehicleRestoredEventHandler(commandBus, logger)
 
 	return &Container{
-		MongoClient:                         mongoClient,
-		Logger:                              logger,
-		VehicleRepository:                   vehicleRepo,
-		OutboxRepository:                    outboxRepo,
-		VehicleChangeHistoryRepository:      changeHistoryRepo,
-		CommandBus:                          commandBus,
-		QueryBus:                            queryBus,
-		EventPublisher:                      eventPublisher,
-		VehicleCreatedEventHandler:          vehicleCreatedHandler,
-		VehicleLocationUpdatedEventHandler:  vehicleLocationUpdatedHandler,
-		VehicleStatusChangedEventHandler:    vehicleStatusChangedHandler,
-		VehicleMileageUpdatedEventHandler:   vehicleMileageUpdatedHandler,
-		VehicleFuelLevelUpdatedEventHandler: vehicleFuelLevelUpdatedHandler,
+		MongoClient:                           mongoClient,
+		Logger:                                logger,
+		VehicleRepository:                     vehicleRepo,
+		OutboxRepository:                      outboxRepo,
+		VehicleChangeHistoryRepository:        changeHistoryRepo,
+		GeofenceRepository:                    geofenceRepo,
+		VehicleGeofenceStateRepository:        geofenceStateRepo,
+		TripRepository:                        tripRepo,
+		VehicleMotionStateRepository:          motionStateRepo,
+		FuelProfileRepository:                 fuelProfileRepo,
+		VehicleFuelStateRepository:            fuelStateRepo,
+		RefuelRepository:                      refuelRepo,
+		VehicleSpeedStateRepository:           speedStateRepo,
+		LocationPointRepository:               locationRepo,
+		CommandBus:                            commandBus,
+		QueryBus:                              queryBus,
+		EventPublisher:                        eventPublisher,
+		VehicleCreatedEventHandler:            vehicleCreatedHandler,
+		VehicleLocationUpdatedEventHandler:    vehicleLocationUpdatedHandler,
+		VehicleLocationArchivedEventHandler:   vehicleLocationArchivedHandler,
+		VehicleStatusChangedEventHandler:      vehicleStatusChangedHandler,
+		VehicleMileageUpdatedEventHandler:     vehicleMileageUpdatedHandler,
+		VehicleFuelLevelUpdatedEventHandler:   vehicleFuelLevelUpdatedHandler,
+		VehicleEnergyLevelUpdatedEventHandler: vehicleEnergyLevelUpdatedHandler,
+		DriverAssignedEventHandler:            driverAssignedHandler,
+		DriverUnassignedEventHandler:          driverUnassignedHandler,
+		DevicePairedEventHandler:              devicePairedHandler,
+		DeviceUnpairedEventHandler:            deviceUnpairedHandler,
+		TrackingCorrectionAppliedHandler:      trackingCorrectionAppliedHandler,
+		VehicleDetailsUpdatedEventHandler:     vehicleDetailsUpdatedHandler,
+		VehicleGroupChangedEventHandler:       vehicleGroupChangedHandler,
+		VehicleTagsChangedEventHandler:        vehicleTagsChangedHandler,
+		VehicleDeletedEventHandler:            vehicleDeletedHandler,
+		VehicleRestoredEventHandler:           vehicleRestoredHandler,
+		locationRepo:                          locationRepo,
+		changeHistoryCollection:               vehicleChangeHistoryCollection,
 	}, nil
 }
 
+// ImportLocationHistory copies positions recorded as change history before
+// the location store existed into it. It is called on every start but the
+// import runs once; see ImportChangeHistory.
+func (c *Container) ImportLocationHistory(ctx context.Context) (int, error) {
+	return c.locationRepo.ImportChangeHistory(ctx, c.changeHistoryCollection)
+}
+
 func (c *Container) Close(ctx context.Context) error {
 	if err := c.EventPublisher.Close(); err != nil {
 		log.Printf("error closing event publisher: %v", err)
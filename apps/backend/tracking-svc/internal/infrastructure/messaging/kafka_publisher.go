@@ -19,6 +19,9 @@ type TopicConfig struct {
 func InitializeTopics(brokers []string, logger *zap.Logger) error {
 	topics := []TopicConfig{
 		{Name: "tracking_config.created", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "geofence.entered", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "geofence.exited", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "tracking.alert", NumPartitions: 3, ReplicationFactor: 1},
 	}
 
 	conn, err := kafka.Dial("tcp", brokers[0])
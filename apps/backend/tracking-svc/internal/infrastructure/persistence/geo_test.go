@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+func TestNearStage_LongitudeFirstAndScoped(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), "fleet-a")
+	center, err := valueobject.NewCoordinate(10.7769, 106.7009)
+	require.NoError(t, err)
+
+	stage := nearStage(ctx, center, 5000, valueobject.StatusActive)
+
+	assert.Equal(t, []float64{106.7009, 10.7769}, stage["near"].(geoPointDocument).Coordinates)
+	assert.Equal(t, 5000.0, stage["maxDistance"])
+	assert.Equal(t, bson.M{"archivedAt": nil, "status": "active", "tenantId": "fleet-a"}, stage["query"])
+}
+
+func TestBoxFilter_ClosedRing(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), "fleet-a")
+	southWest, _ := valueobject.NewCoordinate(10, 106)
+	northEast, _ := valueobject.NewCoordinate(11, 107)
+
+	filter := boxFilter(ctx, southWest, northEast, "")
+
+	assert.NotContains(t, filter, "status")
+	polygon := filter["position"].(bson.M)["$geoWithin"].(bson.M)["$geometry"].(bson.M)
+	ring := polygon["coordinates"].(bson.A)[0].(bson.A)
+	require.Len(t, ring, 5)
+	assert.Equal(t, bson.A{106.0, 10.0}, ring[0])
+	assert.Equal(t, bson.A{107.0, 11.0}, ring[2])
+	assert.Equal(t, ring[0], ring[4])
+}
@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoFuelProfileRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoFuelProfileRepository(collection *mongo.Collection) *MongoFuelProfileRepository {
+	return &MongoFuelProfileRepository{collection: collection}
+}
+
+type fuelProfileDocument struct {
+	ID                        string   `bson:"_id"`
+	TenantID                  string   `bson:"tenantId"`
+	VehicleModel              string   `bson:"vehicleModel"`
+	TankCapacityLiters        float64  `bson:"tankCapacityLiters"`
+	RefuelThresholdPercent    float64  `bson:"refuelThresholdPercent"`
+	DropThresholdPercent      float64  `bson:"dropThresholdPercent"`
+	ConsumptionLitersPer100Km float64  `bson:"consumptionLitersPer100Km"`
+	ConsumptionTolerance      float64  `bson:"consumptionTolerance"`
+	LowLevelPercent           *float64 `bson:"lowLevelPercent"` // nil for profiles stored before low energy alerts
+	Version                   int64    `bson:"version"`
+	CreatedAt                 int64    `bson:"createdAt"`
+	UpdatedAt                 int64    `bson:"updatedAt"`
+}
+
+func (r *MongoFuelProfileRepository) Save(ctx context.Context, profile *entity.FuelProfile) error {
+	lowLevelPercent := profile.LowLevelPercent()
+	doc := fuelProfileDocument{
+		ID:                        fuelProfileKey(profile.TenantID(), profile.VehicleModel()),
+		TenantID:                  profile.TenantID(),
+		VehicleModel:              profile.VehicleModel(),
+		TankCapacityLiters:        profile.TankCapacityLiters(),
+		RefuelThresholdPercent:    profile.RefuelThresholdPercent(),
+		DropThresholdPercent:      profile.DropThresholdPercent(),
+		ConsumptionLitersPer100Km: profile.ConsumptionLitersPer100Km(),
+		ConsumptionTolerance:      profile.ConsumptionTolerance(),
+		LowLevelPercent:           &lowLevelPercent,
+		Version:                   profile.Version().Value(),
+		CreatedAt:                 profile.CreatedAt().Unix(),
+		UpdatedAt:                 profile.UpdatedAt().Unix(),
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      fuelProfileKey(profile.TenantID(), profile.VehicleModel()),
+		"version":  profile.Version().Value() - 1,
+		"tenantId": tenantMatch(profile.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save fuel profile: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: fuel profile version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoFuelProfileRepository) FindByVehicleModel(ctx context.Context, vehicleModel string) (*entity.FuelProfile, error) {
+	var doc fuelProfileDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": fuelProfileKey(tenant.ID(ctx), vehicleModel)})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find fuel profile: %w", err)
+	}
+
+	return toFuelProfileEntity(doc)
+}
+
+func (r *MongoFuelProfileRepository) FindAll(ctx context.Context) ([]*entity.FuelProfile, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fuel profiles: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []fuelProfileDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode fuel profiles: %w", err)
+	}
+
+	var results []*entity.FuelProfile
+	for _, doc := range docs {
+		profile, err := toFuelProfileEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, profile)
+	}
+
+	return results, nil
+}
+
+func (r *MongoFuelProfileRepository) Delete(ctx context.Context, vehicleModel string) error {
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": fuelProfileKey(tenant.ID(ctx), vehicleModel)}))
+	if err != nil {
+		return fmt.Errorf("failed to delete fuel profile: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("fuel profile not found: %s", vehicleModel)
+	}
+
+	return nil
+}
+
+// fuelProfileKey keys a profile by tenant and model. Default tenant profiles
+// keep the bare model as their key, as they had before tenancy.
+func fuelProfileKey(tenantID, vehicleModel string) string {
+	if tenantID == tenant.DefaultID {
+		return vehicleModel
+	}
+	return tenantID + "/" + vehicleModel
+}
+
+func toFuelProfileEntity(doc fuelProfileDocument) (*entity.FuelProfile, error) {
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	lowLevelPercent := entity.DefaultLowLevelPercent
+	if doc.LowLevelPercent != nil {
+		lowLevelPercent = *doc.LowLevelPercent
+	}
+
+	// Profiles written before tenancy only carry the model as their key.
+	vehicleModel := doc.VehicleModel
+	if vehicleModel == "" {
+		vehicleModel = doc.ID
+	}
+
+	return entity.LoadFuelProfileFromHistory(
+		tenantOrDefault(doc.TenantID),
+		vehicleModel,
+		doc.TankCapacityLiters,
+		doc.RefuelThresholdPercent,
+		doc.DropThresholdPercent,
+		doc.ConsumptionLitersPer100Km,
+		doc.ConsumptionTolerance,
+		lowLevelPercent,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
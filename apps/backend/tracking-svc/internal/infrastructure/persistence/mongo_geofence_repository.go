@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoGeofenceRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoGeofenceRepository(collection *mongo.Collection) *MongoGeofenceRepository {
+	return &MongoGeofenceRepository{collection: collection}
+}
+
+type coordinateDocument struct {
+	Latitude  float64 `bson:"latitude"`
+	Longitude float64 `bson:"longitude"`
+}
+
+type geofenceDocument struct {
+	ID           string               `bson:"_id"`
+	TenantID     string               `bson:"tenantId"`
+	Name         string               `bson:"name"`
+	Category     string               `bson:"category"`
+	Shape        string               `bson:"shape"`
+	Center       *coordinateDocument  `bson:"center,omitempty"`
+	RadiusMeters float64              `bson:"radiusMeters,omitempty"`
+	Polygon      []coordinateDocument `bson:"polygon,omitempty"`
+	SpeedLimit   float64              `bson:"speedLimitKmh"`
+	Version      int64                `bson:"version"`
+	CreatedAt    int64                `bson:"createdAt"`
+	UpdatedAt    int64                `bson:"updatedAt"`
+}
+
+func (r *MongoGeofenceRepository) Save(ctx context.Context, geofence *entity.Geofence) error {
+	doc := geofenceDocument{
+		ID:         geofence.ID().String(),
+		TenantID:   geofence.TenantID(),
+		Name:       geofence.Name(),
+		Category:   string(geofence.Category()),
+		Shape:      string(geofence.Shape()),
+		SpeedLimit: geofence.SpeedLimitKmh(),
+		Version:    geofence.Version().Value(),
+		CreatedAt:  geofence.CreatedAt().Unix(),
+		UpdatedAt:  geofence.UpdatedAt().Unix(),
+	}
+	switch geofence.Shape() {
+	case valueobject.ShapeCircle:
+		doc.Center = &coordinateDocument{
+			Latitude:  geofence.Center().Latitude(),
+			Longitude: geofence.Center().Longitude(),
+		}
+		doc.RadiusMeters = geofence.RadiusMeters()
+	case valueobject.ShapePolygon:
+		for _, vertex := range geofence.Polygon() {
+			doc.Polygon = append(doc.Polygon, coordinateDocument{
+				Latitude:  vertex.Latitude(),
+				Longitude: vertex.Longitude(),
+			})
+		}
+	}
+
+	opts := options.Replace().SetUpsert(true)
+	filter := bson.M{
+		"_id":      geofence.ID().String(),
+		"version":  geofence.Version().Value() - 1,
+		"tenantId": tenantMatch(geofence.TenantID()),
+	}
+
+	// Replace rather than $set so switching shape drops the old geometry.
+	result, err := r.collection.ReplaceOne(ctx, filter, doc, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save geofence: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: geofence version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoGeofenceRepository) FindByID(ctx context.Context, id valueobject.GeofenceID) (*entity.Geofence, error) {
+	var doc geofenceDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("geofence not found: %s", id.String())
+		}
+		return nil, fmt.Errorf("failed to find geofence: %w", err)
+	}
+
+	return toGeofenceEntity(doc)
+}
+
+func (r *MongoGeofenceRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Geofence, error) {
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofences: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []geofenceDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode geofences: %w", err)
+	}
+
+	var results []*entity.Geofence
+	for _, doc := range docs {
+		geofence, err := toGeofenceEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, geofence)
+	}
+
+	return results, nil
+}
+
+func (r *MongoGeofenceRepository) Delete(ctx context.Context, id valueobject.GeofenceID) error {
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": id.String()}))
+	if err != nil {
+		return fmt.Errorf("failed to delete geofence: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("geofence not found: %s", id.String())
+	}
+
+	return nil
+}
+
+func toGeofenceEntity(doc geofenceDocument) (*entity.Geofence, error) {
+	geofenceID, err := valueobject.NewGeofenceID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geofence id from database: %w", err)
+	}
+	category, err := valueobject.NewGeofenceCategory(doc.Category)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geofence category from database: %w", err)
+	}
+	shape, err := valueobject.NewGeofenceShape(doc.Shape)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geofence shape from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var center valueobject.Coordinate
+	if doc.Center != nil {
+		center, err = valueobject.NewCoordinate(doc.Center.Latitude, doc.Center.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid geofence center from database: %w", err)
+		}
+	}
+
+	polygon := make([]valueobject.Coordinate, 0, len(doc.Polygon))
+	for _, vertex := range doc.Polygon {
+		coordinate, err := valueobject.NewCoordinate(vertex.Latitude, vertex.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid geofence vertex from database: %w", err)
+		}
+		polygon = append(polygon, coordinate)
+	}
+
+	return entity.LoadGeofenceFromHistory(
+		geofenceID,
+		tenantOrDefault(doc.TenantID),
+		doc.Name,
+		category,
+		shape,
+		center,
+		doc.RadiusMeters,
+		polygon,
+		doc.SpeedLimit,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,697 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+const (
+	rawLocationCollection    = "location_points"
+	rawLocationTimeField     = "recordedAt"
+	minuteLocationCollection = "location_points_1m"
+	hourLocationCollection   = "location_points_1h"
+	rollupProgressCollection = "location_rollups"
+	rollupProgressID         = "progress"
+	migrationCollection      = "migrations"
+	locationImportJobID      = "location_history_v1"
+	locationImportBatchSize  = 1000
+	// locationImportLease is how long a replica holds the import without
+	// recording a batch before another may take it over.
+	locationImportLease = 5 * time.Minute
+)
+
+// legacyLocationChangeTypes are the change history entries that held
+// positions before the location store existed.
+var legacyLocationChangeTypes = bson.A{"location_updated", "location_archived"}
+
+// MongoDB server error codes.
+const (
+	errCodeNamespaceExists     = 48
+	errCodeIndexOptionConflict = 85
+	errCodeDuplicateKey        = 11000
+)
+
+// MongoLocationPointRepository keeps raw points in a time-series collection
+// that expires them the raw retention after they were saved, and rollups in a
+// collection per resolution keyed by vehicle and bucket. Expiry follows the
+// save time rather than device time so a point a device delivers late is
+// rolled up before it expires.
+type MongoLocationPointRepository struct {
+	db         *mongo.Database
+	retention  valueobject.LocationRetention
+	raw        *mongo.Collection
+	minute     *mongo.Collection
+	hour       *mongo.Collection
+	progress   *mongo.Collection
+	migrations *mongo.Collection
+}
+
+func NewMongoLocationPointRepository(db *mongo.Database, retention valueobject.LocationRetention) *MongoLocationPointRepository {
+	return &MongoLocationPointRepository{
+		db:         db,
+		retention:  retention,
+		raw:        db.Collection(rawLocationCollection),
+		minute:     db.Collection(minuteLocationCollection),
+		hour:       db.Collection(hourLocationCollection),
+		progress:   db.Collection(rollupProgressCollection),
+		migrations: db.Collection(migrationCollection),
+	}
+}
+
+type locationMetaDocument struct {
+	TenantID  string `bson:"tenantId"`
+	VehicleID string `bson:"vehicleId"`
+	Archived  bool   `bson:"archived,omitempty"` // raw points only; out of order
+	// ImportBatch names the import batch a raw point was copied from change
+	// history in. Its rollups were merged by the import, not by a pass.
+	ImportBatch string `bson:"importBatch,omitempty"`
+}
+
+type locationPointDocument struct {
+	Meta        locationMetaDocument `bson:"meta"`
+	Timestamp   time.Time            `bson:"timestamp"`
+	Latitude    float64              `bson:"latitude"`
+	Longitude   float64              `bson:"longitude"`
+	Altitude    float64              `bson:"altitude"`
+	SpeedKmh    *float64             `bson:"speedKmh,omitempty"`
+	Heading     *float64             `bson:"heading,omitempty"`
+	RecordedAt  time.Time            `bson:"recordedAt,omitempty"` // raw points only; when saved, and their time-series time
+	Count       int                  `bson:"count,omitempty"`      // rollups only
+	MaxSpeedKmh *float64             `bson:"maxSpeedKmh,omitempty"`
+}
+
+type rollupProgressDocument struct {
+	Done    time.Time `bson:"done,omitempty"`
+	Pending time.Time `bson:"pending,omitempty"`
+}
+
+// EnsureCollections creates the time-series collection and the indexes the
+// queries rely on, and brings the retention of existing collections in line
+// with the configuration.
+func (r *MongoLocationPointRepository) EnsureCollections(ctx context.Context) error {
+	if err := r.checkRawTimeField(ctx); err != nil {
+		return err
+	}
+
+	rawExpiry := int64(r.retention.Raw.Seconds())
+	opts := options.CreateCollection().
+		SetTimeSeriesOptions(options.TimeSeries().
+			SetTimeField(rawLocationTimeField).
+			SetMetaField("meta").
+			SetGranularity("seconds")).
+		SetExpireAfterSeconds(rawExpiry)
+	err := r.db.CreateCollection(ctx, rawLocationCollection, opts)
+	if hasErrorCode(err, errCodeNamespaceExists) {
+		err = r.db.RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: rawLocationCollection},
+			{Key: "expireAfterSeconds", Value: rawExpiry},
+		}).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", rawLocationCollection, err)
+	}
+
+	vehicleTime := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "meta.vehicleId", Value: 1},
+			{Key: "meta.tenantId", Value: 1},
+			{Key: "timestamp", Value: 1},
+		},
+	}
+	for _, collection := range []*mongo.Collection{r.raw, r.minute, r.hour} {
+		if _, err := collection.Indexes().CreateOne(ctx, vehicleTime); err != nil {
+			return fmt.Errorf("failed to create %s index: %w", collection.Name(), err)
+		}
+	}
+	if _, err := r.raw.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "recordedAt", Value: 1}}}); err != nil {
+		return fmt.Errorf("failed to create %s index: %w", rawLocationCollection, err)
+	}
+
+	return ensureTTLIndex(ctx, r.minute, "bucket", r.retention.Minute)
+}
+
+// checkRawTimeField refuses a raw collection whose time-series time, and so
+// its expiry, is not the save time. The time field of an existing collection
+// cannot be changed.
+func (r *MongoLocationPointRepository) checkRawTimeField(ctx context.Context) error {
+	specs, err := r.db.ListCollectionSpecifications(ctx, bson.M{"name": rawLocationCollection})
+	if err != nil {
+		return fmt.Errorf("failed to inspect %s: %w", rawLocationCollection, err)
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+	timeField, _ := specs[0].Options.Lookup("timeseries", "timeField").StringValueOK()
+	if timeField != rawLocationTimeField {
+		return fmt.Errorf("%s expires points by %q rather than %q; drop it to have it recreated",
+			rawLocationCollection, timeField, rawLocationTimeField)
+	}
+	return nil
+}
+
+func (r *MongoLocationPointRepository) Save(ctx context.Context, point *entity.LocationPoint) error {
+	_, err := r.raw.InsertOne(ctx, toRawDocument(point, time.Now().UTC()))
+	return err
+}
+
+func (r *MongoLocationPointRepository) FindAround(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, at time.Time) (*entity.LocationPoint, *entity.LocationPoint, error) {
+	collection := r.collectionFor(resolution)
+	before, err := firstPoint(ctx, collection, pointFilter(ctx, vehicleID, bson.M{"$lte": at}), -1)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err := firstPoint(ctx, collection, pointFilter(ctx, vehicleID, bson.M{"$gt": at}), 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+func firstPoint(ctx context.Context, collection *mongo.Collection, filter bson.M, order int) (*entity.LocationPoint, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: order}})
+
+	var doc locationPointDocument
+	err := collection.FindOne(ctx, filter, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *MongoLocationPointRepository) EachBetween(ctx context.Context, vehicleID string, resolution valueobject.LocationResolution, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	timestamp := bson.M{"$lte": to}
+	if !from.IsZero() {
+		timestamp["$gte"] = from
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cursor, err := r.collectionFor(resolution).Find(ctx, pointFilter(ctx, vehicleID, timestamp), opts)
+	if err != nil {
+		return err
+	}
+	return eachPoint(ctx, cursor, fn)
+}
+
+func (r *MongoLocationPointRepository) EachArchivedBetween(ctx context.Context, vehicleID string, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	filter := pointFilter(ctx, vehicleID, bson.M{"$gte": from, "$lte": to})
+	filter["meta.archived"] = true
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cursor, err := r.raw.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	return eachPoint(ctx, cursor, fn)
+}
+
+func (r *MongoLocationPointRepository) EachRecordedBetween(ctx context.Context, from, to time.Time, fn func(*entity.LocationPoint) error) error {
+	cursor, err := r.raw.Find(ctx, recordedFilter(from, to))
+	if err != nil {
+		return err
+	}
+	return eachPoint(ctx, cursor, fn)
+}
+
+func eachPoint(ctx context.Context, cursor *mongo.Cursor, fn func(*entity.LocationPoint) error) error {
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc locationPointDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := fn(doc.toEntity()); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// SetVehicleArchived flags the vehicle's raw points and rollups in their
+// metadata, the only part of a time-series document an update may change.
+func (r *MongoLocationPointRepository) SetVehicleArchived(ctx context.Context, vehicleID string, archived bool) error {
+	filter := bson.M{
+		"meta.vehicleId": vehicleID,
+		"meta.tenantId":  tenantMatch(tenant.ID(ctx)),
+	}
+	update := bson.M{"$set": bson.M{"meta.vehicleArchived": archived}}
+	for _, collection := range []*mongo.Collection{r.raw, r.minute, r.hour} {
+		if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("failed to archive %s: %w", collection.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (r *MongoLocationPointRepository) MergeRollups(ctx context.Context, resolution valueobject.LocationResolution, rollups []*entity.LocationPoint, run time.Time) error {
+	return r.mergeRollups(ctx, resolution, rollups, runMark(run))
+}
+
+// rollupMark records on a stored rollup that a merge was applied to it, so
+// that repeating the merge leaves the rollup alone.
+type rollupMark struct {
+	pending bson.M // matches rollups the merge was not applied to yet
+	applied bson.M // fields recording that it was
+}
+
+// runMark marks the rollups merged by the pass ending at run. Passes run in
+// order, so one mark per rollup is enough.
+func runMark(run time.Time) rollupMark {
+	return rollupMark{
+		// Also matches rollups no pass has merged into yet.
+		pending: bson.M{"rolledThrough": bson.M{"$not": bson.M{"$gte": run}}},
+		applied: bson.M{"rolledThrough": run},
+	}
+}
+
+// importMark marks the rollups merged by one batch of the change history
+// import.
+func importMark(batch string) rollupMark {
+	return rollupMark{
+		pending: bson.M{"importedBatches": bson.M{"$ne": batch}},
+		applied: bson.M{"importedBatches": bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$importedBatches", bson.A{}}},
+			bson.A{batch},
+		}}},
+	}
+}
+
+func (r *MongoLocationPointRepository) mergeRollups(ctx context.Context, resolution valueobject.LocationResolution, rollups []*entity.LocationPoint, mark rollupMark) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(rollups))
+	for i, rollup := range rollups {
+		start := rollup.Timestamp.Truncate(resolution.Bucket())
+		filter := bson.M{"_id": fmt.Sprintf("%s:%s:%d", tenantOrDefault(rollup.TenantID), rollup.VehicleID, start.Unix())}
+		for field, match := range mark.pending {
+			filter[field] = match
+		}
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(mergeRollupPipeline(rollup, start, mark)).
+			SetUpsert(true)
+	}
+
+	_, err := r.collectionFor(resolution).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		// A rollup this merge was already applied to no longer matches the
+		// filter, so its upsert collides with the stored one.
+		for _, writeErr := range bulkErr.WriteErrors {
+			if writeErr.Code != errCodeDuplicateKey {
+				return err
+			}
+		}
+		return nil
+	}
+	return err
+}
+
+// mergeRollupPipeline folds rollup into the stored one the way
+// LocationPoint.Merge does: the later position wins, counts add up and the
+// higher speed is kept.
+func mergeRollupPipeline(rollup *entity.LocationPoint, start time.Time, mark rollupMark) mongo.Pipeline {
+	later := "$_later"
+	set := bson.M{
+		// Set field by field to keep meta.vehicleArchived.
+		"meta.tenantId":  tenantOrDefault(rollup.TenantID),
+		"meta.vehicleId": rollup.VehicleID,
+		"bucket":         start,
+		"count":          bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$count", 0}}, rollup.Count}},
+		"maxSpeedKmh":    bson.M{"$max": bson.A{"$maxSpeedKmh", rollup.MaxSpeedKmh}},
+		"_later":         bson.M{"$gt": bson.A{rollup.Timestamp, bson.M{"$ifNull": bson.A{"$timestamp", time.Time{}}}}},
+	}
+	for field, value := range mark.applied {
+		set[field] = value
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$set", Value: set}},
+		{{Key: "$set", Value: bson.M{
+			"timestamp": bson.M{"$cond": bson.A{later, rollup.Timestamp, "$timestamp"}},
+			"latitude":  bson.M{"$cond": bson.A{later, rollup.Latitude, "$latitude"}},
+			"longitude": bson.M{"$cond": bson.A{later, rollup.Longitude, "$longitude"}},
+			"altitude":  bson.M{"$cond": bson.A{later, rollup.Altitude, "$altitude"}},
+			"speedKmh":  bson.M{"$cond": bson.A{later, rollup.SpeedKmh, "$speedKmh"}},
+			"heading":   bson.M{"$cond": bson.A{later, rollup.Heading, "$heading"}},
+		}}},
+		{{Key: "$unset", Value: "_later"}},
+	}
+}
+
+func (r *MongoLocationPointRepository) FindRollupProgress(ctx context.Context) (repository.RollupProgress, error) {
+	var doc rollupProgressDocument
+	err := r.progress.FindOne(ctx, bson.M{"_id": rollupProgressID}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return repository.RollupProgress{}, nil
+	}
+	if err != nil {
+		return repository.RollupProgress{}, err
+	}
+	return repository.RollupProgress{Done: doc.Done, Pending: doc.Pending}, nil
+}
+
+// SwapRollupProgress replaces the progress document only where it still
+// holds old. Like claimLocationImport, a first pass upserts it, and a
+// duplicate key means another replica wrote it first.
+func (r *MongoLocationPointRepository) SwapRollupProgress(ctx context.Context, old, next repository.RollupProgress) (bool, error) {
+	result, err := r.progress.ReplaceOne(ctx,
+		bson.M{
+			"_id":     rollupProgressID,
+			"done":    storedTime(old.Done),
+			"pending": storedTime(old.Pending),
+		},
+		rollupProgressDocument{Done: next.Done, Pending: next.Pending},
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+// storedTime matches t as the progress document stores it: to the
+// millisecond, and absent when zero.
+func storedTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Truncate(time.Millisecond)
+}
+
+// legacyLocationDocument is a position recorded as change history before the
+// location store existed, with its numbers read by legacyLocationPipeline.
+type legacyLocationDocument struct {
+	ID         interface{} `bson:"_id"`
+	TenantID   string      `bson:"tenantId"`
+	VehicleID  string      `bson:"vehicleId"`
+	ChangeType string      `bson:"changeType"`
+	ChangedAt  time.Time   `bson:"changedAt"`
+	Latitude   *float64    `bson:"latitude"`
+	Longitude  *float64    `bson:"longitude"`
+	Altitude   *float64    `bson:"altitude"`
+	Timestamp  *float64    `bson:"timestamp"` // device time, unix seconds
+	SpeedKmh   *float64    `bson:"speedKmh"`
+	Heading    *float64    `bson:"heading"`
+}
+
+// legacyLocationPipeline reads the next batch of positions from change
+// history, after the entry with id after when it is not nil. The numbers in
+// newValue may have been stored as any BSON number type; each is converted to
+// a double, or null when it is missing or not a number.
+func legacyLocationPipeline(after interface{}) mongo.Pipeline {
+	match := bson.M{"changeType": bson.M{"$in": legacyLocationChangeTypes}}
+	if after != nil {
+		match["_id"] = bson.M{"$gt": after}
+	}
+	project := bson.M{"tenantId": 1, "vehicleId": 1, "changeType": 1, "changedAt": 1}
+	for _, field := range []string{"latitude", "longitude", "altitude", "timestamp", "speedKmh", "heading"} {
+		project[field] = bson.M{"$convert": bson.M{
+			"input":   "$newValue." + field,
+			"to":      "double",
+			"onError": nil,
+			"onNull":  nil,
+		}}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: locationImportBatchSize}},
+		{{Key: "$project", Value: project}},
+	}
+}
+
+// locationImportJobDocument tracks the one-time import of change history
+// into the location store. The replica named by Owner imports while its lease
+// lasts; After is the last entry imported, where an interrupted import
+// resumes.
+type locationImportJobDocument struct {
+	ID         string      `bson:"_id"`
+	Owner      string      `bson:"owner"`
+	LeaseUntil time.Time   `bson:"leaseUntil"`
+	After      interface{} `bson:"after,omitempty"`
+	Batch      int         `bson:"batch"`
+	Imported   int         `bson:"imported"`
+	DoneAt     *time.Time  `bson:"doneAt,omitempty"`
+}
+
+// ImportChangeHistory copies the positions recorded as change history before
+// the location store existed into it, a batch at a time, and returns how many
+// entries it copied. The import runs once, by whichever replica claims it in
+// the migrations collection, and resumes after the last batch it recorded. A
+// batch repeated after a crash replaces its raw points and skips the rollups
+// it already merged into. History itself is left as it is.
+func (r *MongoLocationPointRepository) ImportChangeHistory(ctx context.Context, history *mongo.Collection) (int, error) {
+	job, err := r.claimLocationImport(ctx)
+	if err != nil || job == nil {
+		return 0, err
+	}
+
+	imported := 0
+	for {
+		cursor, err := history.Aggregate(ctx, legacyLocationPipeline(job.After))
+		if err != nil {
+			return imported, err
+		}
+		var entries []legacyLocationDocument
+		if err := cursor.All(ctx, &entries); err != nil {
+			return imported, err
+		}
+		if len(entries) == 0 {
+			return imported, r.updateLocationImport(ctx, job, bson.M{"doneAt": time.Now().UTC()}, 0)
+		}
+
+		batch := fmt.Sprintf("%s:%d", locationImportJobID, job.Batch)
+		if err := r.importLocationBatch(ctx, batch, entries); err != nil {
+			return imported, err
+		}
+
+		job.After = entries[len(entries)-1].ID
+		job.Batch++
+		progress := bson.M{
+			"after":      job.After,
+			"batch":      job.Batch,
+			"leaseUntil": time.Now().UTC().Add(locationImportLease),
+		}
+		if err := r.updateLocationImport(ctx, job, progress, len(entries)); err != nil {
+			return imported, err
+		}
+		imported += len(entries)
+	}
+}
+
+// claimLocationImport takes the import job unless it is done or another
+// replica holds its lease, in which case it returns nil.
+func (r *MongoLocationPointRepository) claimLocationImport(ctx context.Context) (*locationImportJobDocument, error) {
+	now := time.Now().UTC()
+	var job locationImportJobDocument
+	err := r.migrations.FindOneAndUpdate(ctx,
+		bson.M{
+			"_id":        locationImportJobID,
+			"doneAt":     nil,
+			"leaseUntil": bson.M{"$not": bson.M{"$gt": now}},
+		},
+		bson.M{"$set": bson.M{"owner": uuid.New().String(), "leaseUntil": now.Add(locationImportLease)}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&job)
+	if mongo.IsDuplicateKeyError(err) {
+		// The job exists but did not match: it is done or taken.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim %s: %w", locationImportJobID, err)
+	}
+	return &job, nil
+}
+
+// updateLocationImport records progress on the job while this replica still
+// holds it.
+func (r *MongoLocationPointRepository) updateLocationImport(ctx context.Context, job *locationImportJobDocument, set bson.M, imported int) error {
+	result, err := r.migrations.UpdateOne(ctx,
+		bson.M{"_id": job.ID, "owner": job.Owner},
+		bson.M{"$set": set, "$inc": bson.M{"imported": imported}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s was taken over by another replica", locationImportJobID)
+	}
+	return nil
+}
+
+// importLocationBatch copies one batch of history entries. Their rollups are
+// merged here, marked with the batch; the raw points kept for reads within
+// the raw retention carry the batch too, so passes leave them out.
+func (r *MongoLocationPointRepository) importLocationBatch(ctx context.Context, batch string, entries []legacyLocationDocument) error {
+	now := time.Now().UTC()
+	// Ranges reaching back past the raw retention are read from rollups.
+	rawSince := now.Add(-r.retention.Raw)
+	minute := entity.NewLocationRollup(valueobject.ResolutionMinute)
+	hour := entity.NewLocationRollup(valueobject.ResolutionHour)
+	var docs []interface{}
+	for _, entry := range entries {
+		point, ok := entry.toEntity()
+		if !ok {
+			continue
+		}
+		if !point.Archived {
+			minute.Add(point)
+			hour.Add(point)
+		}
+		if point.Timestamp.Before(rawSince) && !point.Archived {
+			continue
+		}
+		doc := toRawDocument(point, now)
+		doc.Meta.ImportBatch = batch
+		docs = append(docs, doc)
+	}
+
+	// Raw points saved by an earlier attempt at the batch are replaced.
+	if _, err := r.raw.DeleteMany(ctx, bson.M{"meta.importBatch": batch}); err != nil {
+		return err
+	}
+	if len(docs) > 0 {
+		if _, err := r.raw.InsertMany(ctx, docs); err != nil {
+			return err
+		}
+	}
+	for _, rollup := range []*entity.LocationRollup{minute, hour} {
+		if err := r.mergeRollups(ctx, rollup.Resolution(), rollup.Points(), importMark(batch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toEntity reads the position of a legacy entry. Entries recorded before the
+// device time was kept are placed at the time they were recorded.
+func (d legacyLocationDocument) toEntity() (*entity.LocationPoint, bool) {
+	if d.Latitude == nil || d.Longitude == nil {
+		return nil, false
+	}
+	var altitude float64
+	if d.Altitude != nil {
+		altitude = *d.Altitude
+	}
+
+	at := d.ChangedAt
+	if d.Timestamp != nil && *d.Timestamp > 0 {
+		at = time.Unix(int64(*d.Timestamp), 0)
+	}
+
+	point := entity.NewLocationPoint(tenantOrDefault(d.TenantID), d.VehicleID, at,
+		*d.Latitude, *d.Longitude, altitude, d.SpeedKmh, d.Heading)
+	point.Archived = d.ChangeType == "location_archived"
+	return point, true
+}
+
+func (r *MongoLocationPointRepository) collectionFor(resolution valueobject.LocationResolution) *mongo.Collection {
+	switch resolution {
+	case valueobject.ResolutionMinute:
+		return r.minute
+	case valueobject.ResolutionHour:
+		return r.hour
+	default:
+		return r.raw
+	}
+}
+
+// pointFilter selects the points on a vehicle's track in the caller's
+// tenant, leaving out archived ones and those of a deleted vehicle.
+func pointFilter(ctx context.Context, vehicleID string, timestamp bson.M) bson.M {
+	return bson.M{
+		"meta.vehicleId":       vehicleID,
+		"meta.tenantId":        tenantMatch(tenant.ID(ctx)),
+		"meta.archived":        bson.M{"$ne": true},
+		"meta.vehicleArchived": bson.M{"$ne": true},
+		"timestamp":            timestamp,
+	}
+}
+
+// recordedFilter selects the raw points to roll up that were saved within
+// (from, to]. Rollups span every tenant; each point carries its own. Points
+// copied from change history were rolled up by the import.
+func recordedFilter(from, to time.Time) bson.M {
+	return bson.M{
+		"recordedAt":       bson.M{"$gt": from, "$lte": to},
+		"meta.archived":    bson.M{"$ne": true},
+		"meta.importBatch": bson.M{"$exists": false},
+	}
+}
+
+func toRawDocument(point *entity.LocationPoint, recordedAt time.Time) locationPointDocument {
+	return locationPointDocument{
+		Meta: locationMetaDocument{
+			TenantID:  tenantOrDefault(point.TenantID),
+			VehicleID: point.VehicleID,
+			Archived:  point.Archived,
+		},
+		Timestamp:  point.Timestamp,
+		Latitude:   point.Latitude,
+		Longitude:  point.Longitude,
+		Altitude:   point.Altitude,
+		SpeedKmh:   point.SpeedKmh,
+		Heading:    point.Heading,
+		RecordedAt: recordedAt,
+	}
+}
+
+func (d locationPointDocument) toEntity() *entity.LocationPoint {
+	point := entity.NewLocationPoint(d.Meta.TenantID, d.Meta.VehicleID, d.Timestamp,
+		d.Latitude, d.Longitude, d.Altitude, d.SpeedKmh, d.Heading)
+	if d.Count > 0 {
+		point.Count = d.Count
+		point.MaxSpeedKmh = d.MaxSpeedKmh
+	}
+	point.Archived = d.Meta.Archived
+	return point
+}
+
+// ensureTTLIndex expires documents ttl after the time in field, changing the
+// expiry of an existing index to match.
+func ensureTTLIndex(ctx context.Context, collection *mongo.Collection, field string, ttl time.Duration) error {
+	name := field + "_ttl"
+	expiry := int32(ttl.Seconds())
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: field, Value: 1}},
+		Options: options.Index().SetName(name).SetExpireAfterSeconds(expiry),
+	})
+	if hasErrorCode(err, errCodeIndexOptionConflict) {
+		err = collection.Database().RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: collection.Name()},
+			{Key: "index", Value: bson.M{"name": name, "expireAfterSeconds": expiry}},
+		}).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s index on %s: %w", name, collection.Name(), err)
+	}
+	return nil
+}
+
+func hasErrorCode(err error, code int32) bool {
+	var commandErr mongo.CommandError
+	return errors.As(err, &commandErr) && commandErr.Code == code
+}
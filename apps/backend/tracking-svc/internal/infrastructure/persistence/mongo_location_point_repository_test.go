@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+func TestPointFilters_LeaveOutArchivedPoints(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), "fleet-a")
+	now := time.Now().UTC()
+
+	track := pointFilter(ctx, "vehicle-1", bson.M{"$lte": now})
+	assert.Equal(t, "fleet-a", track["meta.tenantId"])
+	assert.Equal(t, bson.M{"$ne": true}, track["meta.archived"])
+	assert.Equal(t, bson.M{"$ne": true}, track["meta.vehicleArchived"])
+
+	recorded := recordedFilter(now.Add(-time.Minute), now)
+	assert.Equal(t, bson.M{"$ne": true}, recorded["meta.archived"])
+	assert.Equal(t, bson.M{"$exists": false}, recorded["meta.importBatch"])
+}
+
+func TestRollupMarks_SkipMergesAlreadyApplied(t *testing.T) {
+	run := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	pass := runMark(run)
+	assert.Equal(t, bson.M{"rolledThrough": bson.M{"$not": bson.M{"$gte": run}}}, pass.pending)
+	assert.Equal(t, bson.M{"rolledThrough": run}, pass.applied)
+
+	batch := importMark("location_history_v1:3")
+	assert.Equal(t, bson.M{"importedBatches": bson.M{"$ne": "location_history_v1:3"}}, batch.pending)
+
+	point := entity.NewLocationPoint("fleet-a", "vehicle-1", run, 10, 106, 0, nil, nil)
+	pipeline := mergeRollupPipeline(point, run.Truncate(time.Hour), batch)
+	set := pipeline[0][0].Value.(bson.M)
+	assert.Contains(t, set, "importedBatches")
+	assert.NotContains(t, set, "rolledThrough")
+}
+
+func TestRawDocument_KeepsArchivedFlag(t *testing.T) {
+	point := entity.NewLocationPoint("fleet-a", "vehicle-1", time.Now(), 10, 106, 0, nil, nil)
+	point.Archived = true
+
+	doc := toRawDocument(point, time.Now())
+	assert.True(t, doc.Meta.Archived)
+	assert.True(t, doc.toEntity().Archived)
+}
+
+func TestLegacyLocation_ArchivedEntryBecomesArchivedPoint(t *testing.T) {
+	latitude, longitude, timestamp := 10.0, 106.0, 1700000000.0
+	entry := legacyLocationDocument{
+		VehicleID:  "vehicle-1",
+		ChangeType: "location_archived",
+		Latitude:   &latitude,
+		Longitude:  &longitude,
+		Timestamp:  &timestamp,
+	}
+
+	point, ok := entry.toEntity()
+	assert.True(t, ok)
+	assert.True(t, point.Archived)
+	assert.Equal(t, 106.0, point.Longitude)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), point.Timestamp)
+
+	entry.ChangeType = "location_updated"
+	point, _ = entry.toEntity()
+	assert.False(t, point.Archived)
+
+	entry.Longitude = nil
+	_, ok = entry.toEntity()
+	assert.False(t, ok)
+}
+
+func TestLegacyLocationPipeline_ConvertsNumbersAndResumes(t *testing.T) {
+	first := legacyLocationPipeline(nil)
+	match := first[0][0].Value.(bson.M)
+	assert.NotContains(t, match, "_id")
+
+	project := first[3][0].Value.(bson.M)
+	assert.Equal(t, bson.M{"$convert": bson.M{
+		"input":   "$newValue.latitude",
+		"to":      "double",
+		"onError": nil,
+		"onNull":  nil,
+	}}, project["latitude"])
+
+	next := legacyLocationPipeline("last-id")
+	assert.Equal(t, bson.M{"$gt": "last-id"}, next[0][0].Value.(bson.M)["_id"])
+}
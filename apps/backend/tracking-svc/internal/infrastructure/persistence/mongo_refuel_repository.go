@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoRefuelRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRefuelRepository(collection *mongo.Collection) *MongoRefuelRepository {
+	return &MongoRefuelRepository{collection: collection}
+}
+
+type refuelDocument struct {
+	ID           string  `bson:"_id"`
+	TenantID     string  `bson:"tenantId"`
+	VehicleID    string  `bson:"vehicleId"`
+	FromLevel    float64 `bson:"fromLevel"`
+	ToLevel      float64 `bson:"toLevel"`
+	VolumeLiters float64 `bson:"volumeLiters"`
+	Mileage      float64 `bson:"mileage"`
+	OccurredAt   int64   `bson:"occurredAt"`
+}
+
+func (r *MongoRefuelRepository) Save(ctx context.Context, refuel *entity.Refuel) error {
+	doc := refuelDocument{
+		ID:           refuel.ID().String(),
+		TenantID:     refuel.TenantID(),
+		VehicleID:    refuel.VehicleID(),
+		FromLevel:    refuel.FromLevel(),
+		ToLevel:      refuel.ToLevel(),
+		VolumeLiters: refuel.VolumeLiters(),
+		Mileage:      refuel.Mileage(),
+		OccurredAt:   refuel.OccurredAt().Unix(),
+	}
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("failed to save refuel: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MongoRefuelRepository) FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.Refuel, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "occurredAt", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{"vehicleId": vehicleID}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refuels: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []refuelDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode refuels: %w", err)
+	}
+
+	var results []*entity.Refuel
+	for _, doc := range docs {
+		refuelID, err := valueobject.NewRefuelID(doc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid refuel id from database: %w", err)
+		}
+		results = append(results, entity.LoadRefuelFromHistory(
+			refuelID,
+			tenantOrDefault(doc.TenantID),
+			doc.VehicleID,
+			doc.FromLevel,
+			doc.ToLevel,
+			doc.VolumeLiters,
+			doc.Mileage,
+			time.Unix(doc.OccurredAt, 0),
+		))
+	}
+
+	return results, nil
+}
@@ -0,0 +1,206 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoTripRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoTripRepository(collection *mongo.Collection) *MongoTripRepository {
+	return &MongoTripRepository{collection: collection}
+}
+
+type trackPointDocument struct {
+	Latitude  float64 `bson:"latitude"`
+	Longitude float64 `bson:"longitude"`
+	Timestamp int64   `bson:"timestamp"`
+}
+
+type tripDocument struct {
+	ID             string               `bson:"_id"`
+	TenantID       string               `bson:"tenantId"`
+	VehicleID      string               `bson:"vehicleId"`
+	Status         string               `bson:"status"`
+	Polyline       []trackPointDocument `bson:"polyline"`
+	StartedAt      int64                `bson:"startedAt"`
+	LastPointAt    int64                `bson:"lastPointAt"`
+	EndedAt        *int64               `bson:"endedAt,omitempty"`
+	DistanceMeters float64              `bson:"distanceMeters"`
+	MaxSpeedKmh    float64              `bson:"maxSpeedKmh"`
+	Version        int64                `bson:"version"`
+	CreatedAt      int64                `bson:"createdAt"`
+	UpdatedAt      int64                `bson:"updatedAt"`
+}
+
+func (r *MongoTripRepository) Save(ctx context.Context, trip *entity.Trip) error {
+	doc := tripDocument{
+		ID:             trip.ID().String(),
+		TenantID:       trip.TenantID(),
+		VehicleID:      trip.VehicleID(),
+		Status:         string(trip.Status()),
+		StartedAt:      trip.StartedAt(),
+		LastPointAt:    trip.EndPoint().Timestamp(),
+		EndedAt:        trip.EndedAt(),
+		DistanceMeters: trip.DistanceMeters(),
+		MaxSpeedKmh:    trip.MaxSpeedKmh(),
+		Version:        trip.Version().Value(),
+		CreatedAt:      trip.CreatedAt().Unix(),
+		UpdatedAt:      trip.UpdatedAt().Unix(),
+	}
+	for _, point := range trip.Polyline() {
+		doc.Polyline = append(doc.Polyline, trackPointDocument{
+			Latitude:  point.Latitude(),
+			Longitude: point.Longitude(),
+			Timestamp: point.Timestamp(),
+		})
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      trip.ID().String(),
+		"version":  trip.Version().Value() - 1,
+		"tenantId": tenantMatch(trip.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save trip: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: trip version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoTripRepository) FindOpenByVehicleID(ctx context.Context, vehicleID string) (*entity.Trip, error) {
+	filter := bson.M{
+		"vehicleId": vehicleID,
+		"status":    string(valueobject.TripInProgress),
+	}
+
+	var doc tripDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, filter)).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find open trip: %w", err)
+	}
+
+	return toTripEntity(doc)
+}
+
+func (r *MongoTripRepository) FindOpenIdleSince(ctx context.Context, cutoff time.Time) ([]*entity.Trip, error) {
+	filter := bson.M{
+		"status":      string(valueobject.TripInProgress),
+		"lastPointAt": bson.M{"$lt": cutoff.Unix()},
+	}
+
+	// The sweep runs for every tenant at once; callers close each trip
+	// within the trip's own tenant.
+	return r.find(ctx, filter, options.Find())
+}
+
+func (r *MongoTripRepository) FindByVehicleID(ctx context.Context, vehicleID string, from, to time.Time, limit int, offset int) ([]*entity.Trip, error) {
+	filter := bson.M{"vehicleId": vehicleID}
+	if !to.IsZero() {
+		filter["startedAt"] = bson.M{"$lte": to.Unix()}
+	}
+	if !from.IsZero() {
+		filter["lastPointAt"] = bson.M{"$gte": from.Unix()}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "startedAt", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	return r.find(ctx, scoped(ctx, filter), opts)
+}
+
+func (r *MongoTripRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Trip, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trips: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []tripDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode trips: %w", err)
+	}
+
+	var results []*entity.Trip
+	for _, doc := range docs {
+		trip, err := toTripEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, trip)
+	}
+
+	return results, nil
+}
+
+func toTripEntity(doc tripDocument) (*entity.Trip, error) {
+	tripID, err := valueobject.NewTripID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trip id from database: %w", err)
+	}
+
+	status, err := valueobject.NewTripStatus(doc.Status)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trip status from database: %w", err)
+	}
+
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	if len(doc.Polyline) == 0 {
+		return nil, fmt.Errorf("trip %s has no points", doc.ID)
+	}
+
+	polyline := make([]valueobject.TrackPoint, 0, len(doc.Polyline))
+	for _, p := range doc.Polyline {
+		point, err := valueobject.NewTrackPoint(p.Latitude, p.Longitude, p.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trip point from database: %w", err)
+		}
+		polyline = append(polyline, point)
+	}
+
+	return entity.LoadTripFromHistory(
+		tripID,
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleID,
+		status,
+		polyline,
+		doc.DistanceMeters,
+		doc.MaxSpeedKmh,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
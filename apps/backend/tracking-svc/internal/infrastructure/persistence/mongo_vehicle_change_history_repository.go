@@ -24,7 +24,28 @@ func (r *MongoVehicleChangeHistoryRepository) Save(ctx context.Context, history
 }
 
 func (r *MongoVehicleChangeHistoryRepository) FindByVehicleID(ctx context.Context, vehicleID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error) {
-	filter := bson.M{"vehicleId": vehicleID}
+	filter := historyFilter(ctx, bson.M{"vehicleId": vehicleID}, true)
+	opts := options.Find().
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"changedAt": -1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var histories []*entity.VehicleChangeHistory
+	if err := cursor.All(ctx, &histories); err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+func (r *MongoVehicleChangeHistoryRepository) FindByDriverID(ctx context.Context, driverID string, limit int, offset int) ([]*entity.VehicleChangeHistory, error) {
+	filter := historyFilter(ctx, bson.M{"driverId": driverID}, false)
 	opts := options.Find().
 		SetSkip(int64(offset)).
 		SetLimit(int64(limit)).
@@ -45,7 +66,7 @@ func (r *MongoVehicleChangeHistoryRepository) FindByVehicleID(ctx context.Contex
 }
 
 func (r *MongoVehicleChangeHistoryRepository) FindByChangeType(ctx context.Context, changeType string, limit int, offset int) ([]*entity.VehicleChangeHistory, error) {
-	filter := bson.M{"changeType": changeType}
+	filter := historyFilter(ctx, bson.M{"changeType": changeType}, false)
 	opts := options.Find().
 		SetSkip(int64(offset)).
 		SetLimit(int64(limit)).
@@ -64,3 +85,27 @@ func (r *MongoVehicleChangeHistoryRepository) FindByChangeType(ctx context.Conte
 
 	return histories, nil
 }
+
+// SetArchived flags every history entry of a vehicle so that fleet-wide
+// queries skip it while the vehicle is deleted.
+func (r *MongoVehicleChangeHistoryRepository) SetArchived(ctx context.Context, vehicleID string, archived bool) error {
+	_, err := r.collection.UpdateMany(ctx,
+		scoped(ctx, bson.M{"vehicleId": vehicleID}),
+		bson.M{"$set": bson.M{"archived": archived}},
+	)
+	return err
+}
+
+// historyFilter scopes a history query to the caller's tenant and, unless
+// includeArchived is set, hides the entries of deleted vehicles. Positions
+// recorded as history before the location store existed are served from the
+// store, so they are left out unless asked for by change type.
+func historyFilter(ctx context.Context, filter bson.M, includeArchived bool) bson.M {
+	if !includeArchived {
+		filter["archived"] = bson.M{"$ne": true}
+	}
+	if _, ok := filter["changeType"]; !ok {
+		filter["changeType"] = bson.M{"$nin": legacyLocationChangeTypes}
+	}
+	return scoped(ctx, filter)
+}
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoVehicleFuelStateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoVehicleFuelStateRepository(collection *mongo.Collection) *MongoVehicleFuelStateRepository {
+	return &MongoVehicleFuelStateRepository{collection: collection}
+}
+
+type vehicleFuelStateDocument struct {
+	VehicleID        string   `bson:"_id"`
+	TenantID         string   `bson:"tenantId"`
+	LastLevel        *float64 `bson:"lastLevel"`
+	LastReadingAt    int64    `bson:"lastReadingAt"`
+	MileageAtReading float64  `bson:"mileageAtReading"`
+	CurrentMileage   float64  `bson:"currentMileage"`
+	Version          int64    `bson:"version"`
+	UpdatedAt        int64    `bson:"updatedAt"`
+}
+
+func (r *MongoVehicleFuelStateRepository) Save(ctx context.Context, state *entity.VehicleFuelState) error {
+	doc := vehicleFuelStateDocument{
+		VehicleID:        state.VehicleID(),
+		TenantID:         state.TenantID(),
+		LastLevel:        state.LastLevel(),
+		LastReadingAt:    state.LastReadingAt(),
+		MileageAtReading: state.MileageAtReading(),
+		CurrentMileage:   state.CurrentMileage(),
+		Version:          state.Version().Value(),
+		UpdatedAt:        state.UpdatedAt().Unix(),
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      state.VehicleID(),
+		"version":  state.Version().Value() - 1,
+		"tenantId": tenantMatch(state.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save vehicle fuel state: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: vehicle fuel state version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoVehicleFuelStateRepository) FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleFuelState, error) {
+	var doc vehicleFuelStateDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": vehicleID})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find vehicle fuel state: %w", err)
+	}
+
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	return entity.LoadVehicleFuelStateFromHistory(
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleID,
+		doc.LastLevel,
+		doc.LastReadingAt,
+		doc.MileageAtReading,
+		doc.CurrentMileage,
+		version,
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
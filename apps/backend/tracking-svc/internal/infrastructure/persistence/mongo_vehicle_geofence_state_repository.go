@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoVehicleGeofenceStateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoVehicleGeofenceStateRepository(collection *mongo.Collection) *MongoVehicleGeofenceStateRepository {
+	return &MongoVehicleGeofenceStateRepository{collection: collection}
+}
+
+type vehicleGeofenceStateDocument struct {
+	VehicleID     string              `bson:"_id"`
+	TenantID      string              `bson:"tenantId"`
+	ZoneIDs       []string            `bson:"zoneIds"`
+	LastPosition  *coordinateDocument `bson:"lastPosition,omitempty"`
+	LastTimestamp int64               `bson:"lastTimestamp"`
+	Version       int64               `bson:"version"`
+	UpdatedAt     int64               `bson:"updatedAt"`
+}
+
+func (r *MongoVehicleGeofenceStateRepository) Save(ctx context.Context, state *entity.VehicleGeofenceState) error {
+	doc := vehicleGeofenceStateDocument{
+		VehicleID:     state.VehicleID(),
+		TenantID:      state.TenantID(),
+		ZoneIDs:       state.ZoneIDs(),
+		LastTimestamp: state.LastTimestamp(),
+		Version:       state.Version().Value(),
+		UpdatedAt:     state.UpdatedAt().Unix(),
+	}
+	if doc.ZoneIDs == nil {
+		doc.ZoneIDs = []string{}
+	}
+	if position := state.LastPosition(); position != nil {
+		doc.LastPosition = &coordinateDocument{
+			Latitude:  position.Latitude(),
+			Longitude: position.Longitude(),
+		}
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      state.VehicleID(),
+		"version":  state.Version().Value() - 1,
+		"tenantId": tenantMatch(state.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save vehicle geofence state: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: vehicle geofence state version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoVehicleGeofenceStateRepository) FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleGeofenceState, error) {
+	var doc vehicleGeofenceStateDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": vehicleID})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find vehicle geofence state: %w", err)
+	}
+
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var lastPosition *valueobject.Coordinate
+	if doc.LastPosition != nil {
+		position, err := valueobject.NewCoordinate(doc.LastPosition.Latitude, doc.LastPosition.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last position from database: %w", err)
+		}
+		lastPosition = &position
+	}
+
+	return entity.LoadVehicleGeofenceStateFromHistory(
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleID,
+		doc.ZoneIDs,
+		lastPosition,
+		doc.LastTimestamp,
+		version,
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoVehicleMotionStateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoVehicleMotionStateRepository(collection *mongo.Collection) *MongoVehicleMotionStateRepository {
+	return &MongoVehicleMotionStateRepository{collection: collection}
+}
+
+type vehicleMotionStateDocument struct {
+	VehicleID     string              `bson:"_id"`
+	TenantID      string              `bson:"tenantId"`
+	ParkedAt      *trackPointDocument `bson:"parkedAt"`
+	LastTimestamp int64               `bson:"lastTimestamp"`
+	Version       int64               `bson:"version"`
+	UpdatedAt     int64               `bson:"updatedAt"`
+}
+
+func (r *MongoVehicleMotionStateRepository) Save(ctx context.Context, state *entity.VehicleMotionState) error {
+	doc := vehicleMotionStateDocument{
+		VehicleID:     state.VehicleID(),
+		TenantID:      state.TenantID(),
+		LastTimestamp: state.LastTimestamp(),
+		Version:       state.Version().Value(),
+		UpdatedAt:     state.UpdatedAt().Unix(),
+	}
+	if parkedAt := state.ParkedAt(); parkedAt != nil {
+		doc.ParkedAt = &trackPointDocument{
+			Latitude:  parkedAt.Latitude(),
+			Longitude: parkedAt.Longitude(),
+			Timestamp: parkedAt.Timestamp(),
+		}
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      state.VehicleID(),
+		"version":  state.Version().Value() - 1,
+		"tenantId": tenantMatch(state.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save vehicle motion state: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: vehicle motion state version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoVehicleMotionStateRepository) FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleMotionState, error) {
+	var doc vehicleMotionStateDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": vehicleID})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find vehicle motion state: %w", err)
+	}
+
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var parkedAt *valueobject.TrackPoint
+	if doc.ParkedAt != nil {
+		point, err := valueobject.NewTrackPoint(doc.ParkedAt.Latitude, doc.ParkedAt.Longitude, doc.ParkedAt.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parked position from database: %w", err)
+		}
+		parkedAt = &point
+	}
+
+	return entity.LoadVehicleMotionStateFromHistory(
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleID,
+		parkedAt,
+		doc.LastTimestamp,
+		version,
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
 )
 
@@ -23,6 +24,7 @@ func NewMongoVehicleRepository(collection *mongo.Collection) *MongoVehicleReposi
 
 type vehicleDocument struct {
 	ID            string  `bson:"_id"`
+	TenantID      string  `bson:"tenantId"`
 	RefID         string  `bson:"refId"`
 	VIN           string  `bson:"vin"`
 	VehicleName   string  `bson:"vehicleName"`
@@ -32,36 +34,64 @@ type vehicleDocument struct {
 	Latitude      float64 `bson:"latitude"`
 	Longitude     float64 `bson:"longitude"`
 	Altitude      float64 `bson:"altitude"`
-	Mileage       float64 `bson:"mileage"`
-	FuelLevel     float64 `bson:"fuelLevel"`
-	Version       int64   `bson:"version"`
-	CreatedAt     int64   `bson:"createdAt"`
-	UpdatedAt     int64   `bson:"updatedAt"`
+	// Position repeats latitude and longitude as GeoJSON for the 2dsphere
+	// index behind FindNear and FindInBox.
+	Position       geoPointDocument `bson:"position"`
+	Mileage        float64          `bson:"mileage"`
+	FuelLevel      float64          `bson:"fuelLevel"`
+	EnergyType     string           `bson:"energyType,omitempty"` // empty for vehicles stored before energy types
+	EnergyCapacity float64          `bson:"energyCapacity"`
+	ChargingState  string           `bson:"chargingState"`
+	EstimatedRange *float64         `bson:"estimatedRangeKm"`
+	DriverID       string           `bson:"currentDriverId"`
+	Version        int64            `bson:"version"`
+	CreatedAt      int64            `bson:"createdAt"`
+	UpdatedAt      int64            `bson:"updatedAt"`
+	ArchivedAt     *int64           `bson:"archivedAt"`
+	// Not omitempty: Save uses $set, so clearing these must overwrite them.
+	Group      string                 `bson:"group"`
+	Tags       []string               `bson:"tags"`
+	Attributes map[string]interface{} `bson:"attributes"`
 }
 
 func (r *MongoVehicleRepository) Save(ctx context.Context, vehicle *entity.Vehicle) error {
 	doc := vehicleDocument{
-		ID:            vehicle.ID().String(),
-		RefID:         vehicle.RefID(),
-		VIN:           vehicle.VIN(),
-		VehicleName:   vehicle.VehicleName(),
-		VehicleModel:  vehicle.VehicleModel(),
-		LicenseNumber: vehicle.LicenseNumber().String(),
-		Status:        string(vehicle.Status()),
-		Latitude:      vehicle.CurrentLocation().Latitude(),
-		Longitude:     vehicle.CurrentLocation().Longitude(),
-		Altitude:      vehicle.CurrentLocation().Altitude(),
-		Mileage:       vehicle.Mileage().Kilometers(),
-		FuelLevel:     vehicle.FuelLevel().Percentage(),
-		Version:       vehicle.Version().Value(),
-		CreatedAt:     vehicle.CreatedAt().Unix(),
-		UpdatedAt:     vehicle.UpdatedAt().Unix(),
+		ID:             vehicle.ID().String(),
+		TenantID:       vehicle.TenantID(),
+		RefID:          vehicle.RefID(),
+		VIN:            vehicle.VIN(),
+		VehicleName:    vehicle.VehicleName(),
+		VehicleModel:   vehicle.VehicleModel(),
+		LicenseNumber:  vehicle.LicenseNumber().String(),
+		Status:         string(vehicle.Status()),
+		Latitude:       vehicle.CurrentLocation().Latitude(),
+		Longitude:      vehicle.CurrentLocation().Longitude(),
+		Altitude:       vehicle.CurrentLocation().Altitude(),
+		Position:       newGeoPoint(vehicle.CurrentLocation().Latitude(), vehicle.CurrentLocation().Longitude()),
+		Mileage:        vehicle.Mileage().Kilometers(),
+		FuelLevel:      vehicle.FuelLevel().Percentage(),
+		EnergyType:     string(vehicle.EnergyType()),
+		EnergyCapacity: vehicle.EnergyCapacity(),
+		ChargingState:  vehicle.ChargingState(),
+		EstimatedRange: vehicle.EstimatedRangeKm(),
+		DriverID:       vehicle.CurrentDriverID(),
+		Version:        vehicle.Version().Value(),
+		CreatedAt:      vehicle.CreatedAt().Unix(),
+		UpdatedAt:      vehicle.UpdatedAt().Unix(),
+		Group:          vehicle.Group(),
+		Tags:           vehicle.Tags(),
+		Attributes:     vehicle.Attributes(),
+	}
+	if archivedAt := vehicle.ArchivedAt(); archivedAt != nil {
+		unix := archivedAt.Unix()
+		doc.ArchivedAt = &unix
 	}
 
 	opts := options.Update().SetUpsert(true)
 	filter := bson.M{
-		"_id":     vehicle.ID().String(),
-		"version": vehicle.Version().Value() - 1,
+		"_id":      vehicle.ID().String(),
+		"version":  vehicle.Version().Value() - 1,
+		"tenantId": tenantMatch(vehicle.TenantID()),
 	}
 	update := bson.M{
 		"$set": doc,
@@ -85,61 +115,33 @@ func (r *MongoVehicleRepository) Save(ctx context.Context, vehicle *entity.Vehic
 
 func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
 	var doc vehicleDocument
-	err := r.collection.FindOne(ctx, bson.M{"_id": id.String()}).Decode(&doc)
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("vehicle not found: %s", id.String())
+			return nil, fmt.Errorf("%w: %s", repository.ErrVehicleNotFound, id.String())
 		}
 		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
-	status, err := valueobject.NewVehicleStatus(doc.Status)
-	if err != nil {
-		return nil, fmt.Errorf("invalid vehicle status from database: %w", err)
-	}
-	location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, doc.CreatedAt)
-	if err != nil {
-		return nil, fmt.Errorf("invalid location from database: %w", err)
-	}
-	licenseNumber, err := valueobject.NewLicenseNumber(doc.LicenseNumber)
-	if err != nil {
-		return nil, fmt.Errorf("invalid license number from database: %w", err)
-	}
-	mileage, err := valueobject.NewMileage(doc.Mileage)
-	if err != nil {
-		return nil, fmt.Errorf("invalid mileage from database: %w", err)
-	}
-	fuelLevel, err := valueobject.NewFuelLevel(doc.FuelLevel)
-	if err != nil {
-		return nil, fmt.Errorf("invalid fuel level from database: %w", err)
-	}
-	version, err := valueobject.NewVersion(doc.Version)
+	return toVehicleEntity(doc)
+}
+
+func (r *MongoVehicleRepository) FindByRefID(ctx context.Context, refID string) (*entity.Vehicle, error) {
+	var doc vehicleDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"refId": refID})).Decode(&doc)
 	if err != nil {
-		return nil, fmt.Errorf("invalid version from database: %w", err)
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w for ref id: %s", repository.ErrVehicleNotFound, refID)
+		}
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
-	vehicle := entity.LoadFromHistory(
-		id,
-		doc.RefID,
-		doc.VIN,
-		doc.VehicleName,
-		doc.VehicleModel,
-		licenseNumber,
-		status,
-		location,
-		mileage,
-		fuelLevel,
-		version,
-		time.Unix(doc.CreatedAt, 0),
-		time.Unix(doc.UpdatedAt, 0),
-	)
-
-	return vehicle, nil
+	return toVehicleEntity(doc)
 }
 
 func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error) {
 	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
-	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{"archivedAt": nil}), opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find vehicles: %w", err)
 	}
@@ -152,59 +154,111 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 
 	var results []*entity.Vehicle
 	for _, doc := range vehicles {
-		var vehDoc vehicleDocument
-		bsonBytes, _ := bson.Marshal(doc)
-		bson.Unmarshal(bsonBytes, &vehDoc)
-
-		vehicleID, _ := valueobject.NewVehicleID(vehDoc.ID)
-		status, err := valueobject.NewVehicleStatus(doc.Status)
+		vehicle, err := toVehicleEntity(doc)
 		if err != nil {
-			return nil, fmt.Errorf("invalid vehicle status from database: %w", err)
+			return nil, err
 		}
-		location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, doc.CreatedAt)
-		if err != nil {
-			return nil, fmt.Errorf("invalid location from database: %w", err)
-		}
-		licenseNumber, err := valueobject.NewLicenseNumber(doc.LicenseNumber)
-		if err != nil {
-			return nil, fmt.Errorf("invalid license number from database: %w", err)
-		}
-		mileage, err := valueobject.NewMileage(doc.Mileage)
-		if err != nil {
-			return nil, fmt.Errorf("invalid mileage from database: %w", err)
-		}
-		fuelLevel, err := valueobject.NewFuelLevel(doc.FuelLevel)
+		results = append(results, vehicle)
+	}
+
+	return results, nil
+}
+
+// EnsureIndexes creates the position index the spatial queries rely on.
+func (r *MongoVehicleRepository) EnsureIndexes(ctx context.Context) error {
+	return ensureGeoIndex(ctx, r.collection, "position")
+}
+
+// vehicleDistanceDocument is a vehicle with the distance $geoNear adds.
+type vehicleDistanceDocument struct {
+	vehicleDocument `bson:",inline"`
+	DistanceMeters  float64 `bson:"distanceMeters"`
+}
+
+func (r *MongoVehicleRepository) FindNear(ctx context.Context, center valueobject.Coordinate, radiusMeters float64, status valueobject.VehicleStatus, limit int) ([]repository.VehicleDistance, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: nearStage(ctx, center, radiusMeters, status)}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicles near position: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []vehicleDistanceDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode vehicles: %w", err)
+	}
+
+	results := make([]repository.VehicleDistance, 0, len(docs))
+	for _, doc := range docs {
+		vehicle, err := toVehicleEntity(doc.vehicleDocument)
 		if err != nil {
-			return nil, fmt.Errorf("invalid fuel level from database: %w", err)
+			return nil, err
 		}
-		version, err := valueobject.NewVersion(doc.Version)
+		results = append(results, repository.VehicleDistance{Vehicle: vehicle, DistanceMeters: doc.DistanceMeters})
+	}
+
+	return results, nil
+}
+
+func (r *MongoVehicleRepository) FindInBox(ctx context.Context, southWest, northEast valueobject.Coordinate, status valueobject.VehicleStatus, limit int, offset int) ([]*entity.Vehicle, error) {
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset)).SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, boxFilter(ctx, southWest, northEast, status), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicles in box: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []vehicleDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode vehicles: %w", err)
+	}
+
+	var results []*entity.Vehicle
+	for _, doc := range docs {
+		vehicle, err := toVehicleEntity(doc)
 		if err != nil {
-			return nil, fmt.Errorf("invalid version from database: %w", err)
+			return nil, err
 		}
-
-		vehicle := entity.LoadFromHistory(
-			vehicleID,
-			vehDoc.RefID,
-			vehDoc.VIN,
-			vehDoc.VehicleName,
-			vehDoc.VehicleModel,
-			licenseNumber,
-			status,
-			location,
-			mileage,
-			fuelLevel,
-			version,
-			time.Unix(vehDoc.CreatedAt, 0),
-			time.Unix(vehDoc.UpdatedAt, 0),
-		)
 		results = append(results, vehicle)
 	}
 
 	return results, nil
 }
 
+// nearStage is the $geoNear stage for FindNear. Results come back nearest
+// first; archived vehicles are left out as in FindAll.
+func nearStage(ctx context.Context, center valueobject.Coordinate, radiusMeters float64, status valueobject.VehicleStatus) bson.M {
+	return bson.M{
+		"near":          newGeoPoint(center.Latitude(), center.Longitude()),
+		"key":           "position",
+		"distanceField": "distanceMeters",
+		"maxDistance":   radiusMeters,
+		"spherical":     true,
+		"query":         liveVehicles(ctx, status),
+	}
+}
+
+func boxFilter(ctx context.Context, southWest, northEast valueobject.Coordinate, status valueobject.VehicleStatus) bson.M {
+	filter := liveVehicles(ctx, status)
+	filter["position"] = bson.M{"$geoWithin": bson.M{"$geometry": boxPolygon(southWest, northEast)}}
+	return filter
+}
+
+// liveVehicles matches the tenant's vehicles that are not archived, in the
+// given status unless it is empty.
+func liveVehicles(ctx context.Context, status valueobject.VehicleStatus) bson.M {
+	filter := bson.M{"archivedAt": nil}
+	if status != "" {
+		filter["status"] = string(status)
+	}
+	return scoped(ctx, filter)
+}
+
 func (r *MongoVehicleRepository) Delete(ctx context.Context, id valueobject.VehicleID) error {
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id.String()})
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": id.String()}))
 	if err != nil {
 		return fmt.Errorf("failed to delete vehicle: %w", err)
 	}
@@ -217,10 +271,77 @@ func (r *MongoVehicleRepository) Delete(ctx context.Context, id valueobject.Vehi
 }
 
 func (r *MongoVehicleRepository) ExistsByVIN(ctx context.Context, vin string) (bool, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"vin": vin})
+	count, err := r.collection.CountDocuments(ctx, scoped(ctx, bson.M{"vin": vin}))
 	if err != nil {
 		return false, fmt.Errorf("failed to check vin existence: %w", err)
 	}
 
 	return count > 0, nil
 }
+
+func toVehicleEntity(doc vehicleDocument) (*entity.Vehicle, error) {
+	vehicleID, err := valueobject.NewVehicleID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id from database: %w", err)
+	}
+	status, err := valueobject.NewVehicleStatus(doc.Status)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle status from database: %w", err)
+	}
+	location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, doc.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location from database: %w", err)
+	}
+	licenseNumber, err := valueobject.NewLicenseNumber(doc.LicenseNumber)
+	if err != nil {
+		return nil, fmt.Errorf("invalid license number from database: %w", err)
+	}
+	mileage, err := valueobject.NewMileage(doc.Mileage)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mileage from database: %w", err)
+	}
+	fuelLevel, err := valueobject.NewFuelLevel(doc.FuelLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fuel level from database: %w", err)
+	}
+	energyType, err := valueobject.NewEnergyType(doc.EnergyType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid energy type from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var archivedAt *time.Time
+	if doc.ArchivedAt != nil {
+		t := time.Unix(*doc.ArchivedAt, 0)
+		archivedAt = &t
+	}
+
+	return entity.LoadFromHistory(
+		vehicleID,
+		tenantOrDefault(doc.TenantID),
+		doc.RefID,
+		doc.VIN,
+		doc.VehicleName,
+		doc.VehicleModel,
+		licenseNumber,
+		status,
+		location,
+		mileage,
+		fuelLevel,
+		energyType,
+		doc.EnergyCapacity,
+		doc.ChargingState,
+		doc.EstimatedRange,
+		doc.DriverID,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+		archivedAt,
+		doc.Group,
+		doc.Tags,
+		doc.Attributes,
+	), nil
+}
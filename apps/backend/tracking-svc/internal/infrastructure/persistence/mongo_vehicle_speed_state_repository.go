@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/valueobject"
+)
+
+type MongoVehicleSpeedStateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoVehicleSpeedStateRepository(collection *mongo.Collection) *MongoVehicleSpeedStateRepository {
+	return &MongoVehicleSpeedStateRepository{collection: collection}
+}
+
+type vehicleSpeedStateDocument struct {
+	VehicleID     string              `bson:"_id"`
+	TenantID      string              `bson:"tenantId"`
+	SpeedLimit    float64             `bson:"speedLimitKmh"`
+	LastPosition  *coordinateDocument `bson:"lastPosition,omitempty"`
+	LastTimestamp int64               `bson:"lastTimestamp"`
+	Speeding      bool                `bson:"speeding"`
+	Version       int64               `bson:"version"`
+	UpdatedAt     int64               `bson:"updatedAt"`
+}
+
+func (r *MongoVehicleSpeedStateRepository) Save(ctx context.Context, state *entity.VehicleSpeedState) error {
+	doc := vehicleSpeedStateDocument{
+		VehicleID:     state.VehicleID(),
+		TenantID:      state.TenantID(),
+		SpeedLimit:    state.SpeedLimitKmh(),
+		LastTimestamp: state.LastTimestamp(),
+		Speeding:      state.Speeding(),
+		Version:       state.Version().Value(),
+		UpdatedAt:     state.UpdatedAt().Unix(),
+	}
+	if position := state.LastPosition(); position != nil {
+		doc.LastPosition = &coordinateDocument{
+			Latitude:  position.Latitude(),
+			Longitude: position.Longitude(),
+		}
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      state.VehicleID(),
+		"version":  state.Version().Value() - 1,
+		"tenantId": tenantMatch(state.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save vehicle speed state: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: vehicle speed state version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoVehicleSpeedStateRepository) FindByVehicleID(ctx context.Context, vehicleID string) (*entity.VehicleSpeedState, error) {
+	var doc vehicleSpeedStateDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": vehicleID})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find vehicle speed state: %w", err)
+	}
+
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var lastPosition *valueobject.Coordinate
+	if doc.LastPosition != nil {
+		position, err := valueobject.NewCoordinate(doc.LastPosition.Latitude, doc.LastPosition.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last position from database: %w", err)
+		}
+		lastPosition = &position
+	}
+
+	return entity.LoadVehicleSpeedStateFromHistory(
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleID,
+		doc.SpeedLimit,
+		lastPosition,
+		doc.LastTimestamp,
+		doc.Speeding,
+		version,
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+// scoped restricts a query to the tenant the context is scoped to. Every read,
+// update and delete goes through it so one fleet never sees another's data.
+func scoped(ctx context.Context, filter bson.M) bson.M {
+	filter["tenantId"] = tenantMatch(tenant.ID(ctx))
+	return filter
+}
+
+// tenantMatch matches documents of the given tenant. Documents written before
+// tenancy was introduced carry no tenantId and belong to the default tenant.
+func tenantMatch(tenantID string) interface{} {
+	if tenantID == tenant.DefaultID {
+		return bson.M{"$in": bson.A{tenant.DefaultID, nil}}
+	}
+	return tenantID
+}
+
+func tenantOrDefault(tenantID string) string {
+	if tenantID == "" {
+		return tenant.DefaultID
+	}
+	return tenantID
+}
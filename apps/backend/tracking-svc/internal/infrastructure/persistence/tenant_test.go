@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/domain/tenant"
+)
+
+func TestHistoryFilter_ScopedToTenant(t *testing.T) {
+	fleetA := tenant.WithID(context.Background(), "fleet-a")
+	fleetB := tenant.WithID(context.Background(), "fleet-b")
+
+	byVehicle := historyFilter(fleetA, bson.M{"vehicleId": "v-1"}, true)
+	assert.Equal(t, bson.M{
+		"vehicleId":  "v-1",
+		"tenantId":   "fleet-a",
+		"changeType": bson.M{"$nin": legacyLocationChangeTypes},
+	}, byVehicle)
+
+	byDriverA := historyFilter(fleetA, bson.M{"driverId": "d-1"}, false)
+	byDriverB := historyFilter(fleetB, bson.M{"driverId": "d-1"}, false)
+	assert.Equal(t, "fleet-a", byDriverA["tenantId"])
+	assert.Equal(t, "fleet-b", byDriverB["tenantId"])
+	assert.Equal(t, bson.M{"$ne": true}, byDriverA["archived"])
+
+	byType := historyFilter(fleetB, bson.M{"changeType": "created"}, false)
+	assert.Equal(t, "fleet-b", byType["tenantId"])
+	assert.Equal(t, "created", byType["changeType"])
+}
+
+func TestScoped_DefaultTenantIncludesLegacyDocuments(t *testing.T) {
+	query := scoped(context.Background(), bson.M{"archivedAt": nil})
+
+	assert.Equal(t, bson.M{"$in": bson.A{tenant.DefaultID, nil}}, query["tenantId"])
+	assert.Equal(t, tenant.DefaultID, tenantOrDefault(""))
+}
+
+func TestFuelProfileKey_PerTenant(t *testing.T) {
+	assert.Equal(t, "Hilux", fuelProfileKey(tenant.DefaultID, "Hilux"))
+	assert.Equal(t, "fleet-a/Hilux", fuelProfileKey("fleet-a", "Hilux"))
+	assert.NotEqual(t, fuelProfileKey("fleet-a", "Hilux"), fuelProfileKey("fleet-b", "Hilux"))
+}
@@ -134,11 +134,13 @@ func (w *DomainEventWorker) publishEvent(ctx context.Context, event repository.O
 
 func (w *DomainEventWorker) determineTopicFromEventType(eventType string) string {
 	topicMap := map[string]string{
-		"*event.VehicleCreatedEvent":          "vehicle.created",
-		"*event.VehicleLocationUpdatedEvent":  "vehicle.location.updated",
-		"*event.VehicleStatusChangedEvent":    "vehicle.status.changed",
-		"*event.VehicleMileageUpdatedEvent":   "vehicle.mileage.updated",
-		"*event.VehicleFuelLevelUpdatedEvent": "vehicle.fuel.updated",
+		"*event.VehicleCreatedEvent":         "vehicle.created",
+		"*event.VehicleLocationUpdatedEvent": "vehicle.location.updated",
+		"*event.VehicleStatusChangedEvent":   "vehicle.status.changed",
+		"*event.VehicleMileageUpdatedEvent":  "vehicle.mileage.updated",
+		"*event.GeofenceEnteredEvent":        "geofence.entered",
+		"*event.GeofenceExitedEvent":         "geofence.exited",
+		"*event.TrackingAlertEvent":          "tracking.alert",
 	}
 
 	if topic, exists := topicMap[eventType]; exists {
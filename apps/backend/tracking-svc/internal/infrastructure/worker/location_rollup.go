@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+)
+
+// LocationRollup periodically folds newly recorded location points into the
+// minute and hour rollups.
+type LocationRollup struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+	interval   time.Duration
+	done       chan struct{}
+}
+
+func NewLocationRollup(commandBus command.CommandBus, logger *zap.Logger, interval time.Duration) *LocationRollup {
+	return &LocationRollup{
+		commandBus: commandBus,
+		logger:     logger,
+		interval:   interval,
+		done:       make(chan struct{}),
+	}
+}
+
+func (r *LocationRollup) Start(ctx context.Context) {
+	go r.loop(ctx)
+}
+
+func (r *LocationRollup) Stop() {
+	close(r.done)
+}
+
+func (r *LocationRollup) loop(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			r.logger.Info("location rollup stopped")
+			return
+		case <-ctx.Done():
+			r.logger.Info("location rollup context cancelled")
+			return
+		case <-ticker.C:
+			if err := r.commandBus.Dispatch(ctx, &command.RollUpLocationsCommand{}); err != nil {
+				r.logger.Error("failed to roll up locations", zap.Error(err))
+			}
+		}
+	}
+}
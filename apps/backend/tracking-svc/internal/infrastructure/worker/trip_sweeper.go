@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/tracking-svc/internal/application/command"
+)
+
+// TripSweeper periodically closes trips for vehicles that went quiet while
+// moving, e.g. because the device was switched off with the ignition.
+type TripSweeper struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+	interval   time.Duration
+	done       chan struct{}
+}
+
+func NewTripSweeper(commandBus command.CommandBus, logger *zap.Logger, interval time.Duration) *TripSweeper {
+	return &TripSweeper{
+		commandBus: commandBus,
+		logger:     logger,
+		interval:   interval,
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *TripSweeper) Start(ctx context.Context) {
+	go s.loop(ctx)
+}
+
+func (s *TripSweeper) Stop() {
+	close(s.done)
+}
+
+func (s *TripSweeper) loop(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			s.logger.Info("trip sweeper stopped")
+			return
+		case <-ctx.Done():
+			s.logger.Info("trip sweeper context cancelled")
+			return
+		case <-ticker.C:
+			if err := s.commandBus.Dispatch(ctx, &command.CloseIdleTripsCommand{}); err != nil {
+				s.logger.Error("failed to close idle trips", zap.Error(err))
+			}
+		}
+	}
+}
@@ -3,16 +3,22 @@ package config
 import "time"
 
 type Config struct {
-	AppEnv string
-	HTTP   HTTPConfig
-	Mongo  MongoConfig
-	Kafka  KafkaConfig
+	AppEnv  string
+	HTTP    HTTPConfig
+	Mongo   MongoConfig
+	Kafka   KafkaConfig
+	Vehicle VehicleConfig
+	Gateway GatewayConfig
+	MQTT    MQTTConfig
 }
 
 type HTTPConfig struct {
 	Port         string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// AnonymousTenant lets requests without a bearer token use the default
+	// tenant, for callers that predate tenants. Off unless set.
+	AnonymousTenant bool
 }
 
 type MongoConfig struct {
@@ -25,3 +31,32 @@ type KafkaConfig struct {
 	Port    string
 	Brokers string
 }
+
+type VehicleConfig struct {
+	// RestoreGracePeriod is how long a soft-deleted vehicle can be restored.
+	RestoreGracePeriod time.Duration
+	// VINValidation is "strict" (check digit enforced) or "lenient".
+	VINValidation string
+}
+
+type GatewayConfig struct {
+	// GT06Addr and Codec8Addr are the listen addresses of the tracker
+	// protocols; an empty address disables that protocol.
+	GT06Addr   string
+	Codec8Addr string
+	// IdleTimeout drops connections that send nothing, not even a heartbeat.
+	IdleTimeout time.Duration
+}
+
+type MQTTConfig struct {
+	// BrokerURL is e.g. tcp://broker:1883; empty disables the MQTT bridge.
+	BrokerURL string
+	// ClientID must be stable across restarts so the broker keeps the
+	// bridge's session and redelivers what arrived while it was away.
+	ClientID string
+	Username string
+	Password string
+	// Topics are the filters to subscribe to. The first + wildcard in a
+	// filter names the device when the payload does not.
+	Topics []string
+}
@@ -0,0 +1,147 @@
+// Command gateway accepts TCP connections from hardware GPS trackers and
+// telemetry published on an MQTT broker, and applies them as vehicle updates. It shares vehicle-svc's
+// database; the resulting events reach Kafka through vehicle-svc's outbox
+// worker.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/cmd/config"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/di"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/gateway"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/mqttbridge"
+)
+
+func main() {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	if err := godotenv.Load(); err != nil {
+		logger.Warn("no .env file loaded", zap.Error(err))
+	}
+
+	cfg := loadConfig()
+	if cfg.Mongo.URI == "" {
+		logger.Fatal("ENV: MONGO_URI is required")
+	}
+
+	initCtx, cancelInit := context.WithTimeout(context.Background(), 10*time.Second)
+	container, err := di.NewContainer(initCtx, cfg, logger)
+	cancelInit()
+	if err != nil {
+		logger.Fatal("failed to initialize container", zap.Error(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := container.Close(ctx); err != nil {
+			logger.Error("failed to close container", zap.Error(err))
+		}
+	}()
+
+	listeners := map[string]gateway.Protocol{
+		cfg.Gateway.GT06Addr:   gateway.GT06{},
+		cfg.Gateway.Codec8Addr: gateway.Codec8{},
+	}
+	delete(listeners, "")
+	if len(listeners) == 0 && cfg.MQTT.BrokerURL == "" {
+		logger.Fatal("ENV: GATEWAY_GT06_ADDR, GATEWAY_CODEC8_ADDR or MQTT_BROKER_URL is required")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for addr, protocol := range listeners {
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			logger.Fatal("failed to listen", zap.String("protocol", protocol.Name()), zap.String("addr", addr), zap.Error(err))
+		}
+		server := gateway.NewServer(protocol, container.DeviceRepository, container.CommandBus, logger, cfg.Gateway.IdleTimeout)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.Info("starting gateway listener", zap.String("protocol", protocol.Name()), zap.String("addr", addr))
+			if err := server.Serve(ctx, listener); err != nil {
+				logger.Error("gateway listener stopped", zap.String("protocol", protocol.Name()), zap.Error(err))
+			}
+		}()
+	}
+
+	if cfg.MQTT.BrokerURL != "" {
+		bridge := mqttbridge.NewBridge(cfg.MQTT, container.DeviceRepository, container.CommandBus, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.Info("starting mqtt bridge", zap.String("broker", cfg.MQTT.BrokerURL), zap.Strings("topics", cfg.MQTT.Topics))
+			if err := bridge.Start(ctx); err != nil {
+				logger.Error("mqtt bridge stopped", zap.Error(err))
+			}
+		}()
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	<-sigChan
+	logger.Info("shutdown signal received")
+
+	cancel()
+	wg.Wait()
+	logger.Info("gateway stopped")
+}
+
+func loadConfig() config.Config {
+	return config.Config{
+		AppEnv: os.Getenv("APP_ENV"),
+		Mongo: config.MongoConfig{
+			URI:      os.Getenv("MONGO_URI"),
+			Database: os.Getenv("MONGO_DB"),
+		},
+		Kafka: config.KafkaConfig{Brokers: os.Getenv("KAFKA_BROKERS")},
+		Vehicle: config.VehicleConfig{
+			VINValidation: stringFromEnv("VEHICLE_VIN_VALIDATION", "strict"),
+		},
+		Gateway: config.GatewayConfig{
+			GT06Addr:    os.Getenv("GATEWAY_GT06_ADDR"),
+			Codec8Addr:  os.Getenv("GATEWAY_CODEC8_ADDR"),
+			IdleTimeout: durationFromEnv("GATEWAY_IDLE_TIMEOUT", 10*time.Minute),
+		},
+		MQTT: config.MQTTConfig{
+			BrokerURL: os.Getenv("MQTT_BROKER_URL"),
+			ClientID:  stringFromEnv("MQTT_CLIENT_ID", "vehicle-gateway"),
+			Username:  os.Getenv("MQTT_USERNAME"),
+			Password:  os.Getenv("MQTT_PASSWORD"),
+			Topics:    strings.Split(stringFromEnv("MQTT_TOPICS", "fleet/+/telemetry"), ","),
+		},
+	}
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return fallback
+}
+
+func stringFromEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -55,6 +56,9 @@ func main() {
 	backgroundContext, cancelBackground := context.WithCancel(context.Background())
 	domainEventWorker.Start(backgroundContext)
 
+	maintenanceScheduler := worker.NewMaintenanceScheduler(containerDI.CommandBus, appLogger, time.Hour)
+	maintenanceScheduler.Start(backgroundContext)
+
 	kafkaConsumer := initializeKafkaConsumer(containerDI, appLogger, cfg)
 	if kafkaConsumer != nil {
 		defer kafkaConsumer.Close()
@@ -67,7 +71,7 @@ func main() {
 	}
 
 	mux := registerApiRoutes(containerDI, appLogger)
-	httpServer := startHTTPServer(cfg, middleware.LoggingMiddleware(mux))
+	httpServer := startHTTPServer(cfg, middleware.LoggingMiddleware(middleware.TenantMiddleware(cfg.HTTP.AnonymousTenant)(middleware.UnitsMiddleware(mux))))
 
 	go func() {
 		appLogger.Info("starting http server", zap.String("addr", httpServer.Addr))
@@ -79,6 +83,7 @@ func main() {
 	handleGracefulShutdown(appLogger)
 
 	domainEventWorker.Stop()
+	maintenanceScheduler.Stop()
 	cancelBackground()
 	cancelHttpServer := shutdownHTTPServer(httpServer, appLogger)
 	cancelHttpServer()
@@ -96,16 +101,40 @@ func loadConfig() config.Config {
 	return config.Config{
 		AppEnv: appEnv,
 		HTTP: config.HTTPConfig{
-			Port:         ":" + appPort,
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 5 * time.Second,
+			Port:            ":" + appPort,
+			ReadTimeout:     5 * time.Second,
+			WriteTimeout:    5 * time.Second,
+			AnonymousTenant: boolFromEnv("HTTP_ANONYMOUS_TENANT"),
 		},
 		Mongo: config.MongoConfig{
 			URI:      mongoURI,
 			Database: mongoDb,
 		},
 		Kafka: config.KafkaConfig{Brokers: brokers},
+		Vehicle: config.VehicleConfig{
+			RestoreGracePeriod: durationFromEnv("VEHICLE_RESTORE_GRACE_PERIOD", 30*24*time.Hour),
+			VINValidation:      stringFromEnv("VEHICLE_VIN_VALIDATION", "strict"),
+		},
+	}
+}
+
+func boolFromEnv(key string) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	return err == nil && value
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return fallback
+}
+
+func stringFromEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+	return fallback
 }
 
 func initializeKafkaConsumer(container *di.Container, logger *zap.Logger, cfg config.Config) *messaging.KafkaConsumer {
@@ -118,12 +147,15 @@ func initializeKafkaConsumer(container *di.Container, logger *zap.Logger, cfg co
 	brokers := strings.Split(kafkaBrokers, ",")
 	topics := []string{
 		"vehicle.created",
+		"vehicle.mileage.updated",
 	}
 
 	consumer := messaging.NewKafkaConsumer(brokers, "vehicle-svc", topics, logger)
 
 	consumer.RegisterHandler("user.authorized",
 		container.UserAuthorizedEventHandler.Handle)
+	consumer.RegisterHandler("vehicle.mileage.updated",
+		container.VehicleMileageUpdatedEventHandler.Handle)
 
 	return consumer
 }
@@ -0,0 +1,25 @@
+package attribute
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type AttributeHandler struct {
+	commandBus command.CommandBus
+	queryBus   query.QueryBus
+	logger     *zap.Logger
+}
+
+func InitAttributeHandler(
+	commandBus command.CommandBus,
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *AttributeHandler {
+	return &AttributeHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger,
+	}
+}
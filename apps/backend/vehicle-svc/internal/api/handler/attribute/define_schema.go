@@ -0,0 +1,52 @@
+package attribute
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// DefineSchema replaces the tenant's custom attribute schema. Existing
+// vehicles are not revalidated.
+func (h *AttributeHandler) DefineSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.DefineAttributeSchemaRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode define attribute schema request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.DefineAttributeSchemaCommand{}
+	for _, attr := range req.Attributes {
+		cmd.Attributes = append(cmd.Attributes, command.AttributeDefinitionSpec{
+			Name:        attr.Name,
+			Type:        attr.Type,
+			Required:    attr.Required,
+			Enum:        attr.Enum,
+			Description: attr.Description,
+		})
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to define attribute schema", zap.Error(err))
+		if errors.Is(err, valueobject.ErrInvalidAttributeDefinition) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ATTRIBUTE_DEFINITION", err.Error())
+			return
+		}
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("attribute schema defined", zap.Int("attributes", len(req.Attributes)))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "attribute schema defined successfully",
+	})
+}
@@ -0,0 +1,23 @@
+package attribute
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+)
+
+func (h *AttributeHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.queryBus.Dispatch(ctx, &query.GetAttributeSchemaQuery{})
+	if err != nil {
+		h.logger.Error("failed to get attribute schema", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_QUERY_FAILED", err.Error())
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
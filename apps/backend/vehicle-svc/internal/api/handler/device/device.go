@@ -0,0 +1,25 @@
+package device
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type DeviceHandler struct {
+	commandBus command.CommandBus
+	queryBus   query.QueryBus
+	logger     *zap.Logger
+}
+
+func InitDeviceHandler(
+	commandBus command.CommandBus,
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *DeviceHandler {
+	return &DeviceHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,63 @@
+package device
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+)
+
+func (h *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	deviceID := r.PathValue("id")
+
+	if deviceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Device ID is required")
+		return
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, &query.GetDeviceQuery{DeviceID: deviceID})
+	if err != nil {
+		h.logger.Error("failed to get device", zap.String("deviceId", deviceID), zap.Error(err))
+		handler.RespondError(w, http.StatusNotFound, "DEVICE_NOT_FOUND", "Device not found")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
+
+func (h *DeviceHandler) GetAllDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 20
+	offset := 0
+
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if _, err := handler.ScanInt(l, &limit); err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_LIMIT", "Invalid limit parameter")
+			return
+		}
+	}
+
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if _, err := handler.ScanInt(o, &offset); err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_OFFSET", "Invalid offset parameter")
+			return
+		}
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, &query.GetAllDevicesQuery{Limit: limit, Offset: offset})
+	if err != nil {
+		h.logger.Error("failed to get all devices", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_QUERY_FAILED", err.Error())
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, map[string]interface{}{
+		"devices": result,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
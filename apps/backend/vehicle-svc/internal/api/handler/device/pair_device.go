@@ -0,0 +1,74 @@
+package device
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+)
+
+func (h *DeviceHandler) PairDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	deviceID := r.PathValue("id")
+
+	if deviceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Device ID is required")
+		return
+	}
+
+	var req dto.PairDeviceRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode pair device request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.PairDeviceCommand{
+		DeviceID:  deviceID,
+		VehicleID: req.VehicleID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to pair device",
+			zap.String("deviceId", deviceID),
+			zap.String("vehicleId", req.VehicleID),
+			zap.Error(err))
+		respondDeviceError(w, err, "ERR_UPDATE_FAILED")
+		return
+	}
+
+	h.logger.Info("device paired",
+		zap.String("deviceId", deviceID),
+		zap.String("vehicleId", req.VehicleID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "device paired successfully",
+	})
+}
+
+func (h *DeviceHandler) UnpairDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	deviceID := r.PathValue("id")
+
+	if deviceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Device ID is required")
+		return
+	}
+
+	cmd := &command.UnpairDeviceCommand{
+		DeviceID: deviceID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to unpair device", zap.String("deviceId", deviceID), zap.Error(err))
+		respondDeviceError(w, err, "ERR_UPDATE_FAILED")
+		return
+	}
+
+	h.logger.Info("device unpaired", zap.String("deviceId", deviceID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "device unpaired successfully",
+	})
+}
@@ -0,0 +1,67 @@
+package device
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *DeviceHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.RegisterDeviceRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode register device request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	deviceID := valueobject.GenerateDeviceID().String()
+	cmd := &command.RegisterDeviceCommand{
+		DeviceID:   deviceID,
+		HardwareID: req.HardwareID,
+		Protocol:   req.Protocol,
+		Firmware:   req.Firmware,
+		SIM:        req.SIM,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to register device", zap.Error(err))
+		respondDeviceError(w, err, "ERR_CREATE_FAILED")
+		return
+	}
+
+	h.logger.Info("device registered successfully", zap.String("deviceId", deviceID))
+	handler.RespondSuccess(w, http.StatusCreated, map[string]string{
+		"id":      deviceID,
+		"message": "device registered successfully",
+	})
+}
+
+func respondDeviceError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, valueobject.ErrInvalidHardwareID):
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_HARDWARE_ID", err.Error())
+	case errors.Is(err, valueobject.ErrInvalidDeviceProtocol):
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_PROTOCOL", err.Error())
+	case errors.Is(err, entity.ErrDeviceAlreadyRegistered):
+		handler.RespondError(w, http.StatusConflict, "ERR_DEVICE_ALREADY_REGISTERED", err.Error())
+	case errors.Is(err, entity.ErrDeviceAlreadyPaired):
+		handler.RespondError(w, http.StatusConflict, "ERR_DEVICE_ALREADY_PAIRED", err.Error())
+	case errors.Is(err, entity.ErrDeviceNotPaired):
+		handler.RespondError(w, http.StatusConflict, "ERR_DEVICE_NOT_PAIRED", err.Error())
+	case errors.Is(err, entity.ErrVehicleRetired):
+		handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_RETIRED", err.Error())
+	case errors.Is(err, entity.ErrVehicleDeleted):
+		handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+	default:
+		handler.RespondError(w, http.StatusInternalServerError, fallback, err.Error())
+	}
+}
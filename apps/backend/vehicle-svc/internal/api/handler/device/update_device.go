@@ -0,0 +1,45 @@
+package device
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+)
+
+func (h *DeviceHandler) UpdateDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	deviceID := r.PathValue("id")
+
+	if deviceID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Device ID is required")
+		return
+	}
+
+	var req dto.UpdateDeviceRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode update device request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.UpdateDeviceCommand{
+		DeviceID: deviceID,
+		Protocol: req.Protocol,
+		Firmware: req.Firmware,
+		SIM:      req.SIM,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to update device", zap.String("deviceId", deviceID), zap.Error(err))
+		respondDeviceError(w, err, "ERR_UPDATE_FAILED")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "device updated successfully",
+	})
+}
@@ -0,0 +1,68 @@
+package driver
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+)
+
+func (h *DriverHandler) AssignDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driverID := r.PathValue("id")
+
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Driver ID is required")
+		return
+	}
+
+	var req dto.AssignDriverRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode assign driver request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.AssignDriverCommand{
+		DriverID:  driverID,
+		VehicleID: req.VehicleID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to assign driver",
+			zap.String("driverId", driverID),
+			zap.String("vehicleId", req.VehicleID),
+			zap.Error(err))
+		respondAssignmentError(w, err)
+		return
+	}
+
+	h.logger.Info("driver assigned",
+		zap.String("driverId", driverID),
+		zap.String("vehicleId", req.VehicleID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "driver assigned successfully",
+	})
+}
+
+func respondAssignmentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, entity.ErrDriverAlreadyAssigned):
+		handler.RespondError(w, http.StatusConflict, "ERR_DRIVER_ALREADY_ASSIGNED", err.Error())
+	case errors.Is(err, entity.ErrVehicleAlreadyAssigned):
+		handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_ALREADY_ASSIGNED", err.Error())
+	case errors.Is(err, entity.ErrDriverNotAssigned):
+		handler.RespondError(w, http.StatusConflict, "ERR_DRIVER_NOT_ASSIGNED", err.Error())
+	case errors.Is(err, entity.ErrVehicleRetired):
+		handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_RETIRED", err.Error())
+	case errors.Is(err, entity.ErrVehicleDeleted):
+		handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+	default:
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+	}
+}
@@ -0,0 +1,43 @@
+package driver
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *DriverHandler) CreateDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.CreateDriverRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode create driver request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	driverID := valueobject.GenerateDriverID().String()
+	cmd := &command.CreateDriverCommand{
+		DriverID:      driverID,
+		Name:          req.Name,
+		LicenseNumber: req.LicenseNumber,
+		Phone:         req.Phone,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to create driver", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_CREATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("driver created successfully", zap.String("driverId", driverID))
+	handler.RespondSuccess(w, http.StatusCreated, map[string]string{
+		"id":      driverID,
+		"message": "driver created successfully",
+	})
+}
@@ -0,0 +1,37 @@
+package driver
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+)
+
+func (h *DriverHandler) DeleteDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driverID := r.PathValue("id")
+
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Driver ID is required")
+		return
+	}
+
+	cmd := &command.DeleteDriverCommand{
+		DriverID: driverID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to delete driver",
+			zap.String("driverId", driverID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_DELETE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("driver deleted", zap.String("driverId", driverID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "driver deleted successfully",
+	})
+}
@@ -0,0 +1,25 @@
+package driver
+
+import (
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"go.uber.org/zap"
+)
+
+type DriverHandler struct {
+	commandBus command.CommandBus
+	queryBus   query.QueryBus
+	logger     *zap.Logger
+}
+
+func InitDriverHandler(
+	commandBus command.CommandBus,
+	queryBus query.QueryBus,
+	logger *zap.Logger,
+) *DriverHandler {
+	return &DriverHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,33 @@
+package driver
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+)
+
+func (h *DriverHandler) GetDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driverID := r.PathValue("id")
+
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Driver ID is required")
+		return
+	}
+
+	q := &query.GetDriverQuery{
+		DriverID: driverID,
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, q)
+	if err != nil {
+		h.logger.Error("failed to get driver", zap.String("driverId", driverID), zap.Error(err))
+		handler.RespondError(w, http.StatusNotFound, "DRIVER_NOT_FOUND", "Driver not found")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
@@ -0,0 +1,37 @@
+package driver
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+)
+
+func (h *DriverHandler) UnassignDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driverID := r.PathValue("id")
+
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Driver ID is required")
+		return
+	}
+
+	cmd := &command.UnassignDriverCommand{
+		DriverID: driverID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to unassign driver",
+			zap.String("driverId", driverID),
+			zap.Error(err))
+		respondAssignmentError(w, err)
+		return
+	}
+
+	h.logger.Info("driver unassigned", zap.String("driverId", driverID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "driver unassigned successfully",
+	})
+}
@@ -0,0 +1,48 @@
+package driver
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+)
+
+func (h *DriverHandler) UpdateDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driverID := r.PathValue("id")
+
+	if driverID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Driver ID is required")
+		return
+	}
+
+	var req dto.UpdateDriverRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode update driver request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.UpdateDriverCommand{
+		DriverID:      driverID,
+		Name:          req.Name,
+		LicenseNumber: req.LicenseNumber,
+		Phone:         req.Phone,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to update driver",
+			zap.String("driverId", driverID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("driver updated", zap.String("driverId", driverID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "driver updated successfully",
+	})
+}
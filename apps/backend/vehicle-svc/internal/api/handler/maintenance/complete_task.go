@@ -0,0 +1,54 @@
+package maintenance
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+)
+
+func (h *MaintenanceHandler) CompleteTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	taskID := r.PathValue("id")
+
+	if taskID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Maintenance task ID is required")
+		return
+	}
+
+	// The body is optional; without it the vehicle's current mileage is used.
+	var req dto.CompleteMaintenanceTaskRequest
+	if err := handler.DecodeJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to decode complete maintenance task request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.CompleteMaintenanceTaskCommand{
+		TaskID:  taskID,
+		Mileage: req.Mileage,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to complete maintenance task",
+			zap.String("taskId", taskID),
+			zap.Error(err))
+		if errors.Is(err, entity.ErrMaintenanceTaskCompleted) {
+			handler.RespondError(w, http.StatusConflict, "ERR_TASK_ALREADY_COMPLETED", err.Error())
+			return
+		}
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("maintenance task completed", zap.String("taskId", taskID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "maintenance task completed successfully",
+	})
+}
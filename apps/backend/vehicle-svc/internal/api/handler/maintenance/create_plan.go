@@ -0,0 +1,45 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *MaintenanceHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.CreateMaintenancePlanRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode create maintenance plan request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	planID := valueobject.GenerateMaintenancePlanID().String()
+	cmd := &command.CreateMaintenancePlanCommand{
+		PlanID:          planID,
+		VehicleModel:    req.VehicleModel,
+		Name:            req.Name,
+		IntervalKm:      req.IntervalKm,
+		IntervalMonths:  req.IntervalMonths,
+		AutoMaintenance: req.AutoMaintenance,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to create maintenance plan", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_CREATE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("maintenance plan created", zap.String("planId", planID))
+	handler.RespondSuccess(w, http.StatusCreated, map[string]string{
+		"id":      planID,
+		"message": "maintenance plan created successfully",
+	})
+}
@@ -0,0 +1,37 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+)
+
+func (h *MaintenanceHandler) DeletePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	planID := r.PathValue("id")
+
+	if planID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Maintenance plan ID is required")
+		return
+	}
+
+	cmd := &command.DeleteMaintenancePlanCommand{
+		PlanID: planID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to delete maintenance plan",
+			zap.String("planId", planID),
+			zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_DELETE_FAILED", err.Error())
+		return
+	}
+
+	h.logger.Info("maintenance plan deleted", zap.String("planId", planID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "maintenance plan deleted successfully",
+	})
+}
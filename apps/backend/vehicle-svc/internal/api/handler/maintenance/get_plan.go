@@ -0,0 +1,33 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+)
+
+func (h *MaintenanceHandler) GetPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	planID := r.PathValue("id")
+
+	if planID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Maintenance plan ID is required")
+		return
+	}
+
+	q := &query.GetMaintenancePlanQuery{
+		PlanID: planID,
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, q)
+	if err != nil {
+		h.logger.Error("failed to get maintenance plan", zap.String("planId", planID), zap.Error(err))
+		handler.RespondError(w, http.StatusNotFound, "MAINTENANCE_PLAN_NOT_FOUND", "Maintenance plan not found")
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
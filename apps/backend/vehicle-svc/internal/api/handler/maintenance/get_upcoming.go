@@ -0,0 +1,70 @@
+package maintenance
+
+import (
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *MaintenanceHandler) GetUpcoming(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := r.URL.Query()
+
+	q := &query.GetUpcomingMaintenanceQuery{
+		VehicleID: params.Get("vehicleId"),
+		Limit:     50,
+	}
+
+	if s := params.Get("status"); s != "" {
+		status, err := valueobject.NewMaintenanceTaskStatus(s)
+		if err != nil || !status.IsOpen() {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_STATUS", "Invalid status parameter")
+			return
+		}
+		q.Status = s
+	}
+
+	if km := params.Get("withinKm"); km != "" {
+		parsed, err := strconv.ParseFloat(km, 64)
+		if err != nil || parsed < 0 {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_WITHIN_KM", "Invalid withinKm parameter")
+			return
+		}
+		q.WithinKm = parsed
+	}
+
+	if days := params.Get("withinDays"); days != "" {
+		if _, err := handler.ScanInt(days, &q.WithinDays); err != nil || q.WithinDays < 0 {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_WITHIN_DAYS", "Invalid withinDays parameter")
+			return
+		}
+	}
+
+	if l := params.Get("limit"); l != "" {
+		if _, err := handler.ScanInt(l, &q.Limit); err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_LIMIT", "Invalid limit parameter")
+			return
+		}
+	}
+
+	if o := params.Get("offset"); o != "" {
+		if _, err := handler.ScanInt(o, &q.Offset); err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_OFFSET", "Invalid offset parameter")
+			return
+		}
+	}
+
+	result, err := h.queryBus.Dispatch(ctx, q)
+	if err != nil {
+		h.logger.Error("failed to get upcoming maintenance", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_QUERY_FAILED", err.Error())
+		return
+	}
+
+	handler.RespondSuccess(w, http.StatusOK, result)
+}
@@ -0,0 +1,78 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *VehicleHandler) ApplyCorrection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleID := r.PathValue("id")
+
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Vehicle ID is required")
+		return
+	}
+
+	var req dto.ApplyTelemetryCorrectionRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode telemetry correction request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	actor := req.Actor
+	if claims := middleware.GetClaimsFromContext(r); claims != nil && claims.UserID != "" {
+		actor = claims.UserID
+	}
+
+	correctionID := valueobject.GenerateTelemetryCorrectionID().String()
+	cmd := &command.ApplyTelemetryCorrectionCommand{
+		CorrectionID: correctionID,
+		VehicleID:    vehicleID,
+		Field:        req.Field,
+		Value:        req.Value,
+		Reason:       req.Reason,
+		Actor:        actor,
+		Units:        string(middleware.GetUnitsFromContext(r)),
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to apply telemetry correction",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, entity.ErrVehicleDeleted):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+		case errors.Is(err, valueobject.ErrInvalidCorrectionField):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_FIELD", err.Error())
+		case errors.Is(err, entity.ErrCorrectionReasonRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_REASON_REQUIRED", err.Error())
+		case errors.Is(err, valueobject.ErrActorRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_ACTOR_REQUIRED", err.Error())
+		case errors.Is(err, entity.ErrCorrectionUnchanged):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_CORRECTION_UNCHANGED", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+
+	h.logger.Info("telemetry correction applied",
+		zap.String("vehicleId", vehicleID),
+		zap.String("correctionId", correctionID),
+		zap.String("field", req.Field))
+	handler.RespondSuccess(w, http.StatusCreated, map[string]string{
+		"id":      correctionID,
+		"message": "correction applied successfully",
+	})
+}
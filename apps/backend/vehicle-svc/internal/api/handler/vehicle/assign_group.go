@@ -0,0 +1,67 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *VehicleHandler) AssignGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleID := r.PathValue("id")
+
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Vehicle ID is required")
+		return
+	}
+
+	var req dto.AssignVehicleGroupRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode assign group request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	actor := req.Actor
+	if claims := middleware.GetClaimsFromContext(r); claims != nil && claims.UserID != "" {
+		actor = claims.UserID
+	}
+
+	cmd := &command.AssignVehicleGroupCommand{
+		VehicleID: vehicleID,
+		Group:     req.Group,
+		Actor:     actor,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to assign vehicle group",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, entity.ErrVehicleDeleted):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+		case errors.Is(err, valueobject.ErrInvalidGroupName):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_GROUP", err.Error())
+		case errors.Is(err, valueobject.ErrActorRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_ACTOR_REQUIRED", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+
+	h.logger.Info("vehicle group assigned",
+		zap.String("vehicleId", vehicleID),
+		zap.String("group", req.Group))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "group assigned successfully",
+	})
+}
@@ -0,0 +1,88 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// ChangeGroupStatus changes the status of every vehicle in a group. Vehicles
+// that cannot make the transition are reported individually; the rest are
+// changed regardless.
+func (h *VehicleHandler) ChangeGroupStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	group := r.PathValue("group")
+
+	if group == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_GROUP", "Group is required")
+		return
+	}
+
+	var req dto.ChangeGroupStatusRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode change group status request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	actor := req.Actor
+	if claims := middleware.GetClaimsFromContext(r); claims != nil && claims.UserID != "" {
+		actor = claims.UserID
+	}
+
+	cmd := &command.ChangeGroupStatusCommand{
+		Group:     group,
+		NewStatus: req.Status,
+		Reason:    req.Reason,
+		Actor:     actor,
+	}
+
+	err := h.commandBus.Dispatch(ctx, cmd)
+
+	var partial *command.GroupStatusChangeError
+	switch {
+	case err == nil:
+	case errors.As(err, &partial):
+		h.logger.Warn("group status change partially failed",
+			zap.String("group", group),
+			zap.Int("failed", len(partial.Failed)))
+		failed := make(map[string]string, len(partial.Failed))
+		for vehicleID, ferr := range partial.Failed {
+			failed[vehicleID] = ferr.Error()
+		}
+		handler.RespondSuccess(w, http.StatusMultiStatus, dto.ChangeGroupStatusResponse{
+			Group:   group,
+			Changed: partial.Changed,
+			Failed:  failed,
+		})
+		return
+	default:
+		h.logger.Error("failed to change group status",
+			zap.String("group", group),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, valueobject.ErrInvalidGroupName):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_GROUP", err.Error())
+		case errors.Is(err, valueobject.ErrInvalidStatusChangeReason):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REASON", err.Error())
+		case errors.Is(err, valueobject.ErrActorRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_ACTOR_REQUIRED", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+
+	h.logger.Info("group status changed", zap.String("group", group))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "group status changed successfully",
+		"group":   group,
+	})
+}
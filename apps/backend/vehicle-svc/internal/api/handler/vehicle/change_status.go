@@ -1,13 +1,17 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 func (h *VehicleHandler) ChangeStatus(w http.ResponseWriter, r *http.Request) {
@@ -26,16 +30,36 @@ func (h *VehicleHandler) ChangeStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	actor := req.Actor
+	if claims := middleware.GetClaimsFromContext(r); claims != nil && claims.UserID != "" {
+		actor = claims.UserID
+	}
+
 	cmd := &command.ChangeVehicleStatusCommand{
 		VehicleID: vehicleID,
 		NewStatus: req.Status,
+		Reason:    req.Reason,
+		Actor:     actor,
 	}
 
 	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
 		h.logger.Error("failed to change vehicle status",
 			zap.String("vehicleId", vehicleID),
 			zap.Error(err))
-		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		switch {
+		case errors.Is(err, entity.ErrVehicleDeleted):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+		case errors.Is(err, entity.ErrVehicleRetired):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_RETIRED", err.Error())
+		case errors.Is(err, entity.ErrInvalidStatusTransition):
+			handler.RespondError(w, http.StatusConflict, "ERR_INVALID_STATUS_TRANSITION", err.Error())
+		case errors.Is(err, valueobject.ErrInvalidStatusChangeReason):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REASON", err.Error())
+		case errors.Is(err, valueobject.ErrActorRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_ACTOR_REQUIRED", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
 		return
 	}
 
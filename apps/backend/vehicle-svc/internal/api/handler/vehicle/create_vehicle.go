@@ -1,13 +1,17 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 func (h *VehicleHandler) CreateVehicle(w http.ResponseWriter, r *http.Request) {
@@ -21,20 +25,36 @@ func (h *VehicleHandler) CreateVehicle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cmd := &command.CreateVehicleCommand{
-		VIN:           req.VIN,
-		VehicleName:   req.VehicleName,
-		VehicleModel:  req.VehicleModel,
-		LicenseNumber: req.LicenseNumber,
-		Status:        req.Status,
-		Latitude:      req.Latitude,
-		Longitude:     req.Longitude,
-		Altitude:      req.Altitude,
-		Mileage:       req.Mileage,
-		FuelLevel:     req.FuelLevel,
+		VIN:            req.VIN,
+		VehicleName:    req.VehicleName,
+		VehicleModel:   req.VehicleModel,
+		LicenseNumber:  req.LicenseNumber,
+		Status:         req.Status,
+		Latitude:       req.Latitude,
+		Longitude:      req.Longitude,
+		Altitude:       req.Altitude,
+		Mileage:        req.Mileage,
+		FuelLevel:      req.FuelLevel,
+		EnergyType:     req.EnergyType,
+		EnergyCapacity: req.EnergyCapacity,
+		Attributes:     req.Attributes,
+		Units:          string(middleware.GetUnitsFromContext(r)),
 	}
 
 	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
 		h.logger.Error("failed to create vehicle", zap.Error(err))
+		if errors.Is(err, valueobject.ErrInvalidVIN) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_VIN", err.Error())
+			return
+		}
+		if errors.Is(err, valueobject.ErrInvalidEnergyType) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ENERGY_TYPE", err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidAttributes) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ATTRIBUTES", err.Error())
+			return
+		}
 		handler.RespondError(w, http.StatusInternalServerError, "ERR_CREATE_FAILED", err.Error())
 		return
 	}
@@ -1,12 +1,17 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 func (h *VehicleHandler) GetAllVehicles(w http.ResponseWriter, r *http.Request) {
@@ -29,14 +34,45 @@ func (h *VehicleHandler) GetAllVehicles(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	modelYear := 0
+	if y := r.URL.Query().Get("year"); y != "" {
+		if _, err := handler.ScanInt(y, &modelYear); err != nil {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_YEAR", "Invalid year parameter")
+			return
+		}
+	}
+
+	// Custom attributes filter as ?attr.costCenter=CC-12.
+	attributes := map[string]string{}
+	for key, values := range r.URL.Query() {
+		if name, ok := strings.CutPrefix(key, "attr."); ok && len(values) > 0 {
+			attributes[name] = values[0]
+		}
+	}
+
 	q := &query.GetAllVehiclesQuery{
-		Limit:  limit,
-		Offset: offset,
+		Limit:      limit,
+		Offset:     offset,
+		Deleted:    r.URL.Query().Get("deleted") == "true",
+		Make:       r.URL.Query().Get("make"),
+		ModelYear:  modelYear,
+		Group:      r.URL.Query().Get("group"),
+		Tag:        r.URL.Query().Get("tag"),
+		Attributes: attributes,
+		Units:      string(middleware.GetUnitsFromContext(r)),
 	}
 
 	result, err := h.queryBus.Dispatch(ctx, q)
 	if err != nil {
 		h.logger.Error("failed to get all vehicles", zap.Error(err))
+		if errors.Is(err, valueobject.ErrInvalidTag) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_TAG", err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidAttributes) {
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ATTRIBUTES", err.Error())
+			return
+		}
 		handler.RespondError(w, http.StatusInternalServerError, "ERR_QUERY_FAILED", err.Error())
 		return
 	}
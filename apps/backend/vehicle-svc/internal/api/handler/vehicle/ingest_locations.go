@@ -0,0 +1,90 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+)
+
+// IngestLocations applies a buffered telemetry upload and reports every point.
+// Points that cannot be applied are reported individually; the rest are
+// applied regardless.
+func (h *VehicleHandler) IngestLocations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req dto.IngestLocationsRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode ingest locations request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	points := make([]command.LocationPoint, len(req.Points))
+	for i, p := range req.Points {
+		points[i] = command.LocationPoint{
+			VehicleID:  p.VehicleID,
+			DeviceID:   p.DeviceID,
+			Latitude:   p.Latitude,
+			Longitude:  p.Longitude,
+			Altitude:   p.Altitude,
+			Timestamp:  p.Timestamp,
+			SpeedKmh:   p.SpeedKmh,
+			Heading:    p.Heading,
+			Satellites: p.Satellites,
+			HDOP:       p.HDOP,
+		}
+	}
+
+	batchCmd := &command.IngestLocationBatchCommand{Points: points}
+	err := h.commandBus.Dispatch(ctx, batchCmd)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, command.ErrEmptyLocationBatch):
+		handler.RespondError(w, http.StatusBadRequest, "ERR_EMPTY_BATCH", err.Error())
+		return
+	case errors.Is(err, command.ErrLocationBatchTooLarge):
+		handler.RespondError(w, http.StatusRequestEntityTooLarge, "ERR_BATCH_TOO_LARGE", err.Error())
+		return
+	default:
+		h.logger.Error("failed to ingest locations", zap.Error(err))
+		handler.RespondError(w, http.StatusInternalServerError, "ERR_INGEST_FAILED", err.Error())
+		return
+	}
+
+	report := batchCmd.Report
+	response := dto.IngestLocationsResponse{
+		Accepted:   report.Accepted,
+		Archived:   len(report.Archived),
+		Duplicates: len(report.Duplicates),
+		Rejected:   len(report.Rejected),
+		Results:    make([]dto.LocationPointResult, len(points)),
+	}
+	for i, p := range points {
+		response.Results[i] = dto.LocationPointResult{Index: i, VehicleID: p.VehicleID, DeviceID: p.DeviceID, Status: "accepted"}
+	}
+	for _, i := range report.Archived {
+		response.Results[i].Status = "archived"
+	}
+	for _, i := range report.Duplicates {
+		response.Results[i].Status = "duplicate"
+	}
+	for i, rerr := range report.Rejected {
+		response.Results[i].Status = "rejected"
+		response.Results[i].Error = rerr.Error()
+	}
+
+	status := http.StatusOK
+	if len(report.Rejected) > 0 {
+		status = http.StatusMultiStatus
+		h.logger.Warn("location batch partially rejected",
+			zap.Int("accepted", report.Accepted),
+			zap.Int("rejected", len(report.Rejected)))
+	}
+	handler.RespondSuccess(w, status, response)
+}
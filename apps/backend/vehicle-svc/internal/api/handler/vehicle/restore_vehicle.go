@@ -0,0 +1,66 @@
+package vehicle
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *VehicleHandler) RestoreVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleID := r.PathValue("id")
+
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Vehicle ID is required")
+		return
+	}
+
+	// The body is optional when the actor comes from the auth claims.
+	var req dto.RestoreVehicleRequest
+	if err := handler.DecodeJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to decode restore vehicle request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	actor := req.Actor
+	if claims := middleware.GetClaimsFromContext(r); claims != nil && claims.UserID != "" {
+		actor = claims.UserID
+	}
+
+	cmd := &command.RestoreVehicleCommand{
+		VehicleID: vehicleID,
+		Actor:     actor,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to restore vehicle",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, valueobject.ErrActorRequired):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_ACTOR_REQUIRED", err.Error())
+		case errors.Is(err, entity.ErrVehicleNotDeleted):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_NOT_DELETED", err.Error())
+		case errors.Is(err, entity.ErrRestoreWindowExpired):
+			handler.RespondError(w, http.StatusConflict, "ERR_RESTORE_WINDOW_EXPIRED", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+
+	h.logger.Info("vehicle restored", zap.String("vehicleId", vehicleID))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "vehicle restored successfully",
+	})
+}
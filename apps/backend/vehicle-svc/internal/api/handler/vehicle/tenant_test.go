@@ -0,0 +1,124 @@
+package vehicle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/service"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/messaging"
+)
+
+// tenantVehicleRepo keeps vehicles in memory and, like the Mongo repository,
+// only sees those of the tenant in the context.
+type tenantVehicleRepo struct {
+	repository.VehicleRepository
+	vehicles []*entity.Vehicle
+}
+
+func (r *tenantVehicleRepo) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
+	for _, v := range r.vehicles {
+		if v.ID() == id && v.TenantID() == tenant.ID(ctx) {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("vehicle not found: %s", id)
+}
+
+func (r *tenantVehicleRepo) FindAll(ctx context.Context, filter repository.VehicleFilter, limit int, offset int) ([]*entity.Vehicle, error) {
+	var found []*entity.Vehicle
+	for _, v := range r.vehicles {
+		if v.TenantID() == tenant.ID(ctx) {
+			found = append(found, v)
+		}
+	}
+	return found, nil
+}
+
+func newTenantVehicle(t *testing.T, tenantID string) *entity.Vehicle {
+	t.Helper()
+	vin, err := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	require.NoError(t, err)
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 20, 0, 0)
+	require.NoError(t, err)
+	mileage, err := valueobject.NewMileage(0)
+	require.NoError(t, err)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), tenantID, vin, "Truck", "Model", license,
+		valueobject.StatusActive, location, mileage, valueobject.FuelLevel{}, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	return vehicle
+}
+
+// newTenantHandler serves one vehicle of fleet-a through the real query
+// handlers.
+func newTenantHandler(t *testing.T) (*VehicleHandler, *entity.Vehicle) {
+	t.Helper()
+	vehicle := newTenantVehicle(t, "fleet-a")
+	repo := &tenantVehicleRepo{vehicles: []*entity.Vehicle{vehicle}}
+
+	queryBus := messaging.NewInMemoryQueryBus()
+	queryBus.Register((&query.GetVehicleQuery{}).QueryName(), service.NewGetVehicleQueryHandler(repo))
+	queryBus.Register((&query.GetAllVehiclesQuery{}).QueryName(), service.NewGetAllVehiclesQueryHandler(repo, nil))
+
+	return InitVehicleHandler(messaging.NewInMemoryCommandBus(), queryBus, zap.NewNop()), vehicle
+}
+
+func requestAs(tenantID, method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	return req.WithContext(tenant.WithID(req.Context(), tenantID))
+}
+
+func TestGetVehicle_OtherTenantGetsNotFound(t *testing.T) {
+	h, vehicle := newTenantHandler(t)
+	id := vehicle.ID().String()
+
+	own := requestAs("fleet-a", http.MethodGet, "/api/v1/vehicles/"+id)
+	own.SetPathValue("id", id)
+	w := httptest.NewRecorder()
+	h.GetVehicle(w, own)
+	require.Equal(t, http.StatusOK, w.Code)
+	var found dto.VehicleResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&found))
+	assert.Equal(t, id, found.ID)
+
+	other := requestAs("fleet-b", http.MethodGet, "/api/v1/vehicles/"+id)
+	other.SetPathValue("id", id)
+	w = httptest.NewRecorder()
+	h.GetVehicle(w, other)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetAllVehicles_OtherTenantGetsNoRows(t *testing.T) {
+	h, vehicle := newTenantHandler(t)
+
+	list := func(tenantID string) []dto.VehicleResponse {
+		w := httptest.NewRecorder()
+		h.GetAllVehicles(w, requestAs(tenantID, http.MethodGet, "/api/v1/vehicles"))
+		require.Equal(t, http.StatusOK, w.Code)
+		var body struct {
+			Vehicles []dto.VehicleResponse `json:"vehicles"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+		return body.Vehicles
+	}
+
+	own := list("fleet-a")
+	require.Len(t, own, 1)
+	assert.Equal(t, vehicle.ID().String(), own[0].ID)
+	assert.Empty(t, list("fleet-b"))
+}
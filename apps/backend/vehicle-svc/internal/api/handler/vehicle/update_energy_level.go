@@ -0,0 +1,62 @@
+package vehicle
+
+import (
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func (h *VehicleHandler) UpdateEnergyLevel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vehicleID := r.PathValue("id")
+
+	if vehicleID == "" {
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_ID", "Vehicle ID is required")
+		return
+	}
+
+	var req dto.UpdateVehicleEnergyLevelRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		h.logger.Error("failed to decode update energy level request", zap.Error(err))
+		handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	cmd := &command.UpdateVehicleEnergyLevelCommand{
+		VehicleID:      vehicleID,
+		Level:          req.Level,
+		ChargingState:  req.ChargingState,
+		EstimatedRange: req.EstimatedRange,
+		Units:          string(middleware.GetUnitsFromContext(r)),
+	}
+
+	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		h.logger.Error("failed to update vehicle energy level",
+			zap.String("vehicleId", vehicleID),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, entity.ErrVehicleDeleted):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+		case errors.Is(err, entity.ErrVehicleNotChargeable):
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_NOT_CHARGEABLE", err.Error())
+		case errors.Is(err, valueobject.ErrInvalidChargingState):
+			handler.RespondError(w, http.StatusBadRequest, "ERR_INVALID_CHARGING_STATE", err.Error())
+		default:
+			handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
+		}
+		return
+	}
+
+	h.logger.Info("vehicle energy level updated", zap.String("vehicleId", vehicleID), zap.Float64("level", req.Level))
+	handler.RespondSuccess(w, http.StatusOK, map[string]string{
+		"message": "energy level updated successfully",
+	})
+}
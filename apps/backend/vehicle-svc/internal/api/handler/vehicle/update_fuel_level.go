@@ -1,6 +1,7 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -8,8 +9,11 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 )
 
+// UpdateFuelLevel is kept for clients that predate UpdateEnergyLevel; it
+// reports the level alone.
 func (h *VehicleHandler) UpdateFuelLevel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	vehicleID := r.PathValue("id")
@@ -26,15 +30,19 @@ func (h *VehicleHandler) UpdateFuelLevel(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	cmd := &command.UpdateVehicleFuelLevelCommand{
+	cmd := &command.UpdateVehicleEnergyLevelCommand{
 		VehicleID: vehicleID,
-		FuelLevel: req.FuelLevel,
+		Level:     req.FuelLevel,
 	}
 
 	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
 		h.logger.Error("failed to update vehicle fuel level",
 			zap.String("vehicleId", vehicleID),
 			zap.Error(err))
+		if errors.Is(err, entity.ErrVehicleDeleted) {
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+			return
+		}
 		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
 		return
 	}
@@ -1,6 +1,7 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -8,6 +9,7 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 )
 
 func (h *VehicleHandler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
@@ -27,23 +29,43 @@ func (h *VehicleHandler) UpdateLocation(w http.ResponseWriter, r *http.Request)
 	}
 
 	cmd := &command.UpdateVehicleLocationCommand{
-		VehicleID: vehicleID,
-		Latitude:  req.Latitude,
-		Longitude: req.Longitude,
-		Altitude:  req.Altitude,
-		Timestamp: req.Timestamp,
+		VehicleID:  vehicleID,
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+		Altitude:   req.Altitude,
+		Timestamp:  req.Timestamp,
+		SpeedKmh:   req.SpeedKmh,
+		Heading:    req.Heading,
+		Satellites: req.Satellites,
+		HDOP:       req.HDOP,
 	}
 
 	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
 		h.logger.Error("failed to update vehicle location",
 			zap.String("vehicleId", vehicleID),
 			zap.Error(err))
+		if errors.Is(err, entity.ErrVehicleDeleted) {
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+			return
+		}
 		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
 		return
 	}
 
+	if cmd.Outcome == entity.LocationArchived {
+		h.logger.Info("out-of-order vehicle location archived",
+			zap.String("vehicleId", vehicleID),
+			zap.Int64("timestamp", req.Timestamp))
+		handler.RespondSuccess(w, http.StatusOK, map[string]string{
+			"message": "location is older than the current position; recorded in history only",
+			"status":  string(entity.LocationArchived),
+		})
+		return
+	}
+
 	h.logger.Info("vehicle location updated", zap.String("vehicleId", vehicleID))
 	handler.RespondSuccess(w, http.StatusOK, map[string]string{
 		"message": "location updated successfully",
+		"status":  string(entity.LocationApplied),
 	})
 }
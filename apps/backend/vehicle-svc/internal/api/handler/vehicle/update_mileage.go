@@ -1,13 +1,16 @@
 package vehicle
 
 import (
+	"errors"
 	"net/http"
 
 	"go.uber.org/zap"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 )
 
 func (h *VehicleHandler) UpdateMileage(w http.ResponseWriter, r *http.Request) {
@@ -29,12 +32,21 @@ func (h *VehicleHandler) UpdateMileage(w http.ResponseWriter, r *http.Request) {
 	cmd := &command.UpdateVehicleMileageCommand{
 		VehicleID: vehicleID,
 		Mileage:   req.Mileage,
+		Units:     string(middleware.GetUnitsFromContext(r)),
 	}
 
 	if err := h.commandBus.Dispatch(ctx, cmd); err != nil {
 		h.logger.Error("failed to update vehicle mileage",
 			zap.String("vehicleId", vehicleID),
 			zap.Error(err))
+		if errors.Is(err, entity.ErrMileageDecreased) {
+			handler.RespondError(w, http.StatusConflict, "ERR_MILEAGE_DECREASED", err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrVehicleDeleted) {
+			handler.RespondError(w, http.StatusConflict, "ERR_VEHICLE_DELETED", err.Error())
+			return
+		}
 		handler.RespondError(w, http.StatusInternalServerError, "ERR_UPDATE_FAILED", err.Error())
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -17,6 +18,8 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 // --- Mocks ---
@@ -88,6 +91,27 @@ func TestUpdateLocation_CommandError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 }
 
+func TestUpdateLocation_Archived(t *testing.T) {
+	cmdBus := new(MockCommandBus)
+	qryBus := new(MockQueryBus)
+	h := &VehicleHandler{commandBus: cmdBus, queryBus: qryBus, logger: zap.NewNop()}
+	reqBody := dto.UpdateVehicleLocationRequest{Latitude: 1.1, Longitude: 2.2, Timestamp: 100}
+	b, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/location", bytes.NewReader(b))
+	req.SetPathValue("id", "VIN123")
+	w := httptest.NewRecorder()
+	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.UpdateVehicleLocationCommand")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*command.UpdateVehicleLocationCommand).Outcome = entity.LocationArchived
+		}).Return(nil)
+	h.UpdateLocation(w, req)
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]string
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Equal(t, string(entity.LocationArchived), body["status"])
+}
+
 func TestUpdateLocation_BadID(t *testing.T) {
 	cmdBus := new(MockCommandBus)
 	qryBus := new(MockQueryBus)
@@ -163,7 +187,7 @@ func TestUpdateFuelLevel_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/fuel", bytes.NewReader(b))
 	req.SetPathValue("id", "VIN123")
 	w := httptest.NewRecorder()
-	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.UpdateVehicleFuelLevelCommand")).Return(nil)
+	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.UpdateVehicleEnergyLevelCommand")).Return(nil)
 	h.UpdateFuelLevel(w, req)
 	resp := w.Result()
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -190,7 +214,7 @@ func TestUpdateFuelLevel_CommandError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/fuel", bytes.NewReader(b))
 	req.SetPathValue("id", "VIN123")
 	w := httptest.NewRecorder()
-	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.UpdateVehicleFuelLevelCommand")).Return(errors.New("fail"))
+	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.UpdateVehicleEnergyLevelCommand")).Return(errors.New("fail"))
 	h.UpdateFuelLevel(w, req)
 	resp := w.Result()
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
@@ -212,7 +236,7 @@ func TestChangeStatus_Success(t *testing.T) {
 	cmdBus := new(MockCommandBus)
 	qryBus := new(MockQueryBus)
 	h := &VehicleHandler{commandBus: cmdBus, queryBus: qryBus, logger: zap.NewNop()}
-	reqBody := dto.ChangeVehicleStatusRequest{Status: "inactive"}
+	reqBody := dto.ChangeVehicleStatusRequest{Status: "inactive", Reason: "idle", Actor: "ops@fleet"}
 	b, _ := json.Marshal(reqBody)
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/status", bytes.NewReader(b))
 	req.SetPathValue("id", "VIN123")
@@ -239,7 +263,7 @@ func TestChangeStatus_CommandError(t *testing.T) {
 	cmdBus := new(MockCommandBus)
 	qryBus := new(MockQueryBus)
 	h := &VehicleHandler{commandBus: cmdBus, queryBus: qryBus, logger: zap.NewNop()}
-	reqBody := dto.ChangeVehicleStatusRequest{Status: "inactive"}
+	reqBody := dto.ChangeVehicleStatusRequest{Status: "inactive", Reason: "idle", Actor: "ops@fleet"}
 	b, _ := json.Marshal(reqBody)
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/status", bytes.NewReader(b))
 	req.SetPathValue("id", "VIN123")
@@ -261,3 +285,38 @@ func TestChangeStatus_BadID(t *testing.T) {
 	resp := w.Result()
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
+
+func TestChangeStatus_InvalidTransition(t *testing.T) {
+	cmdBus := new(MockCommandBus)
+	qryBus := new(MockQueryBus)
+	h := &VehicleHandler{commandBus: cmdBus, queryBus: qryBus, logger: zap.NewNop()}
+	reqBody := dto.ChangeVehicleStatusRequest{Status: "active", Reason: "reactivated", Actor: "ops@fleet"}
+	b, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/status", bytes.NewReader(b))
+	req.SetPathValue("id", "VIN123")
+	w := httptest.NewRecorder()
+	transitionErr := &entity.StatusTransitionError{From: valueobject.StatusRetired, To: valueobject.StatusActive}
+	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.ChangeVehicleStatusCommand")).Return(transitionErr)
+	h.ChangeStatus(w, req)
+	resp := w.Result()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	var body dto.ErrorResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "ERR_VEHICLE_RETIRED", body.Code)
+}
+
+func TestChangeStatus_MissingReason(t *testing.T) {
+	cmdBus := new(MockCommandBus)
+	qryBus := new(MockQueryBus)
+	h := &VehicleHandler{commandBus: cmdBus, queryBus: qryBus, logger: zap.NewNop()}
+	reqBody := dto.ChangeVehicleStatusRequest{Status: "inactive", Actor: "ops@fleet"}
+	b, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/vehicles/VIN123/status", bytes.NewReader(b))
+	req.SetPathValue("id", "VIN123")
+	w := httptest.NewRecorder()
+	cmdBus.On("Dispatch", mock.Anything, mock.AnythingOfType("*command.ChangeVehicleStatusCommand")).
+		Return(fmt.Errorf("invalid reason: %w", valueobject.ErrInvalidStatusChangeReason))
+	h.ChangeStatus(w, req)
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -5,6 +5,10 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler/attribute"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler/device"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler/driver"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler/maintenance"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/handler/vehicle"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/api/middleware"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
@@ -18,6 +22,10 @@ func RegisterRoutes(
 	logger *zap.Logger,
 ) {
 	h := vehicle.InitVehicleHandler(commandBus, queryBus, logger)
+	dh := driver.InitDriverHandler(commandBus, queryBus, logger)
+	deh := device.InitDeviceHandler(commandBus, queryBus, logger)
+	mh := maintenance.InitMaintenanceHandler(commandBus, queryBus, logger)
+	ah := attribute.InitAttributeHandler(commandBus, queryBus, logger)
 	authMiddleware := middleware.AuthMiddleware("")
 
 	mux.HandleFunc("GET /health", healthCheck)
@@ -25,13 +33,49 @@ func RegisterRoutes(
 	mux.HandleFunc("POST /api/v1/vehicles", h.CreateVehicle)
 	mux.HandleFunc("GET /api/v1/vehicles", h.GetAllVehicles)
 	mux.HandleFunc("GET /api/v1/vehicles/{id}", h.GetVehicle)
+	mux.HandleFunc("PATCH /api/v1/vehicles/{id}", h.UpdateDetails)
+	mux.HandleFunc("DELETE /api/v1/vehicles/{id}", h.DeleteVehicle)
+	mux.HandleFunc("POST /api/v1/vehicles/{id}/restore", h.RestoreVehicle)
 	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/location", h.UpdateLocation)
 	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/status", h.ChangeStatus)
 	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/mileage", h.UpdateMileage)
 	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/fuel", h.UpdateFuelLevel)
+	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/energy", h.UpdateEnergyLevel)
+	mux.HandleFunc("POST /api/v1/vehicles/{id}/corrections", h.ApplyCorrection)
+	mux.HandleFunc("GET /api/v1/vehicles/{id}/corrections", h.GetCorrections)
+	mux.HandleFunc("PUT /api/v1/vehicles/{id}/group", h.AssignGroup)
+	mux.HandleFunc("PATCH /api/v1/vehicles/{id}/tags", h.UpdateTags)
+	mux.HandleFunc("POST /api/v1/vehicle-groups/{group}/status", h.ChangeGroupStatus)
+	mux.HandleFunc("POST /api/v1/telemetry/locations", h.IngestLocations)
+
+	mux.HandleFunc("GET /api/v1/vehicle-attributes/schema", ah.GetSchema)
+
+	mux.HandleFunc("POST /api/v1/drivers", dh.CreateDriver)
+	mux.HandleFunc("GET /api/v1/drivers", dh.GetAllDrivers)
+	mux.HandleFunc("GET /api/v1/drivers/{id}", dh.GetDriver)
+	mux.HandleFunc("PATCH /api/v1/drivers/{id}", dh.UpdateDriver)
+	mux.HandleFunc("DELETE /api/v1/drivers/{id}", dh.DeleteDriver)
+	mux.HandleFunc("POST /api/v1/drivers/{id}/assign", dh.AssignDriver)
+	mux.HandleFunc("POST /api/v1/drivers/{id}/unassign", dh.UnassignDriver)
+
+	mux.HandleFunc("POST /api/v1/devices", deh.RegisterDevice)
+	mux.HandleFunc("GET /api/v1/devices", deh.GetAllDevices)
+	mux.HandleFunc("GET /api/v1/devices/{id}", deh.GetDevice)
+	mux.HandleFunc("PATCH /api/v1/devices/{id}", deh.UpdateDevice)
+	mux.HandleFunc("POST /api/v1/devices/{id}/pair", deh.PairDevice)
+	mux.HandleFunc("POST /api/v1/devices/{id}/unpair", deh.UnpairDevice)
+
+	mux.HandleFunc("POST /api/v1/maintenance/plans", mh.CreatePlan)
+	mux.HandleFunc("GET /api/v1/maintenance/plans", mh.GetAllPlans)
+	mux.HandleFunc("GET /api/v1/maintenance/plans/{id}", mh.GetPlan)
+	mux.HandleFunc("DELETE /api/v1/maintenance/plans/{id}", mh.DeletePlan)
+	mux.HandleFunc("GET /api/v1/maintenance/upcoming", mh.GetUpcoming)
+	mux.HandleFunc("POST /api/v1/maintenance/tasks/{id}/complete", mh.CompleteTask)
 
 	adminMux := http.NewServeMux()
 	adminMux.HandleFunc("GET /api/v1/admin/vehicles", h.GetAllVehicles)
+	adminMux.Handle("PUT /api/v1/admin/vehicle-attributes/schema",
+		middleware.AuthMiddleware("admin")(http.HandlerFunc(ah.DefineSchema)))
 	middlewareHandler := authMiddleware(adminMux)
 
 	mux.Handle("/api/v1/admin/", middlewareHandler)
@@ -0,0 +1,15 @@
+package command
+
+type ApplyTelemetryCorrectionCommand struct {
+	CorrectionID string
+	VehicleID    string
+	Field        string // mileage, fuel_level
+	Value        float64
+	Reason       string
+	Actor        string
+	Units        string // unit system a mileage Value is given in
+}
+
+func (c *ApplyTelemetryCorrectionCommand) CommandName() string {
+	return "ApplyTelemetryCorrection"
+}
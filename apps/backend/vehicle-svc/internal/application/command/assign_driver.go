@@ -0,0 +1,10 @@
+package command
+
+type AssignDriverCommand struct {
+	DriverID  string
+	VehicleID string
+}
+
+func (c *AssignDriverCommand) CommandName() string {
+	return "AssignDriver"
+}
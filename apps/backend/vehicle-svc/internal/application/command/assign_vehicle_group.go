@@ -0,0 +1,13 @@
+package command
+
+// AssignVehicleGroupCommand removes the vehicle from its group when Group is
+// empty.
+type AssignVehicleGroupCommand struct {
+	VehicleID string
+	Group     string
+	Actor     string
+}
+
+func (c *AssignVehicleGroupCommand) CommandName() string {
+	return "AssignVehicleGroup"
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeGroupStatusCommand changes the status of every live vehicle in a
+// group by dispatching one ChangeVehicleStatusCommand per vehicle.
+type ChangeGroupStatusCommand struct {
+	Group     string
+	NewStatus string
+	Reason    string
+	Actor     string
+}
+
+func (c *ChangeGroupStatusCommand) CommandName() string {
+	return "ChangeGroupStatus"
+}
+
+// GroupStatusChangeError reports the vehicles of a bulk status change that
+// could not be changed. The other vehicles keep their new status.
+type GroupStatusChangeError struct {
+	Group   string
+	Changed int
+	Failed  map[string]error // keyed by vehicle id
+}
+
+func (e *GroupStatusChangeError) Error() string {
+	ids := make([]string, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf("status change failed for %d of %d vehicles in group %s: %s",
+		len(e.Failed), len(e.Failed)+e.Changed, e.Group, strings.Join(ids, ", "))
+}
@@ -3,6 +3,8 @@ package command
 type ChangeVehicleStatusCommand struct {
 	VehicleID string
 	NewStatus string
+	Reason    string
+	Actor     string
 }
 
 func (c *ChangeVehicleStatusCommand) CommandName() string {
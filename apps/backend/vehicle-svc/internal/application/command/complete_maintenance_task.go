@@ -0,0 +1,12 @@
+package command
+
+type CompleteMaintenanceTaskCommand struct {
+	TaskID string
+	// Mileage is the odometer reading at service time. When nil the vehicle's
+	// current mileage is used.
+	Mileage *float64
+}
+
+func (c *CompleteMaintenanceTaskCommand) CommandName() string {
+	return "CompleteMaintenanceTask"
+}
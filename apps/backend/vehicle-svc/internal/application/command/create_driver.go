@@ -0,0 +1,12 @@
+package command
+
+type CreateDriverCommand struct {
+	DriverID      string
+	Name          string
+	LicenseNumber string
+	Phone         string
+}
+
+func (c *CreateDriverCommand) CommandName() string {
+	return "CreateDriver"
+}
@@ -0,0 +1,14 @@
+package command
+
+type CreateMaintenancePlanCommand struct {
+	PlanID          string
+	VehicleModel    string
+	Name            string
+	IntervalKm      float64
+	IntervalMonths  int
+	AutoMaintenance bool
+}
+
+func (c *CreateMaintenancePlanCommand) CommandName() string {
+	return "CreateMaintenancePlan"
+}
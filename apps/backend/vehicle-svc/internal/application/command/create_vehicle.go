@@ -1,16 +1,20 @@
 package command
 
 type CreateVehicleCommand struct {
-	VIN           string
-	VehicleName   string
-	VehicleModel  string
-	LicenseNumber string
-	Status        string
-	Latitude      float64
-	Longitude     float64
-	Altitude      float64
-	Mileage       float64
-	FuelLevel     float64
+	VIN            string
+	VehicleName    string
+	VehicleModel   string
+	LicenseNumber  string
+	Status         string
+	Latitude       float64
+	Longitude      float64
+	Altitude       float64
+	Mileage        float64
+	FuelLevel      float64
+	EnergyType     string
+	EnergyCapacity float64 // tank volume in Units, or kWh for a BEV
+	Attributes     map[string]interface{}
+	Units          string // unit system Mileage and EnergyCapacity are given in; empty means metric
 }
 
 func (c *CreateVehicleCommand) CommandName() string {
@@ -0,0 +1,19 @@
+package command
+
+// DefineAttributeSchemaCommand replaces the custom attribute schema of the
+// context tenant.
+type DefineAttributeSchemaCommand struct {
+	Attributes []AttributeDefinitionSpec
+}
+
+type AttributeDefinitionSpec struct {
+	Name        string
+	Type        string
+	Required    bool
+	Enum        []string
+	Description string
+}
+
+func (c *DefineAttributeSchemaCommand) CommandName() string {
+	return "DefineAttributeSchema"
+}
@@ -0,0 +1,9 @@
+package command
+
+type DeleteDriverCommand struct {
+	DriverID string
+}
+
+func (c *DeleteDriverCommand) CommandName() string {
+	return "DeleteDriver"
+}
@@ -0,0 +1,9 @@
+package command
+
+type DeleteMaintenancePlanCommand struct {
+	PlanID string
+}
+
+func (c *DeleteMaintenancePlanCommand) CommandName() string {
+	return "DeleteMaintenancePlan"
+}
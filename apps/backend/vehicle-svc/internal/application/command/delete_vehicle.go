@@ -0,0 +1,11 @@
+package command
+
+type DeleteVehicleCommand struct {
+	VehicleID string
+	Reason    string
+	Actor     string
+}
+
+func (c *DeleteVehicleCommand) CommandName() string {
+	return "DeleteVehicle"
+}
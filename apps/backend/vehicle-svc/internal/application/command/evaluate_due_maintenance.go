@@ -0,0 +1,10 @@
+package command
+
+// EvaluateDueMaintenanceCommand re-evaluates every vehicle with a maintenance
+// task whose due or overdue date has passed. It is dispatched periodically,
+// since dates pass without any event.
+type EvaluateDueMaintenanceCommand struct{}
+
+func (c *EvaluateDueMaintenanceCommand) CommandName() string {
+	return "EvaluateDueMaintenance"
+}
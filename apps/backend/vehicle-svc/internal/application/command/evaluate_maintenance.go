@@ -0,0 +1,9 @@
+package command
+
+type EvaluateMaintenanceCommand struct {
+	VehicleID string
+}
+
+func (c *EvaluateMaintenanceCommand) CommandName() string {
+	return "EvaluateMaintenance"
+}
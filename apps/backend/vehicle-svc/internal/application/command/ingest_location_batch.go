@@ -0,0 +1,56 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxLocationBatchPoints caps one upload so a batch fits in a single request
+// and its outbox writes stay bounded.
+const MaxLocationBatchPoints = 5000
+
+var ErrLocationBatchTooLarge = fmt.Errorf("location batch exceeds %d points", MaxLocationBatchPoints)
+
+var ErrEmptyLocationBatch = errors.New("location batch has no points")
+
+// IngestLocationBatchCommand applies buffered telemetry positions for one or
+// many vehicles. Each vehicle is loaded and saved once, with its points
+// applied in timestamp order.
+type IngestLocationBatchCommand struct {
+	Points []LocationPoint
+
+	// Report is set by the handler once the batch has been applied.
+	Report LocationBatchReport
+}
+
+func (c *IngestLocationBatchCommand) CommandName() string {
+	return "IngestLocationBatch"
+}
+
+// LocationPoint names its vehicle directly or through DeviceID, the hardware
+// id of the reporting unit, which is resolved to the vehicle the unit was
+// paired with at the point's timestamp.
+type LocationPoint struct {
+	VehicleID  string
+	DeviceID   string
+	Latitude   float64
+	Longitude  float64
+	Altitude   float64
+	Timestamp  int64 // device time, unix seconds
+	SpeedKmh   *float64
+	Heading    *float64
+	Satellites *int
+	HDOP       *float64
+}
+
+// LocationBatchReport accounts for every point of a batch by its index.
+// Duplicates repeat the timestamp of an earlier point for the same vehicle
+// and were dropped. Archived points are older than the vehicle's current
+// position and were only recorded in its history; they count as accepted.
+// Rejected points were not applied; all others were.
+type LocationBatchReport struct {
+	Accepted   int
+	Archived   []int
+	Duplicates []int
+	Rejected   map[int]error
+}
@@ -0,0 +1,10 @@
+package command
+
+type PairDeviceCommand struct {
+	DeviceID  string
+	VehicleID string
+}
+
+func (c *PairDeviceCommand) CommandName() string {
+	return "PairDevice"
+}
@@ -0,0 +1,13 @@
+package command
+
+type RegisterDeviceCommand struct {
+	DeviceID   string
+	HardwareID string
+	Protocol   string
+	Firmware   string
+	SIM        string
+}
+
+func (c *RegisterDeviceCommand) CommandName() string {
+	return "RegisterDevice"
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// rejections are the errors a telemetry command fails with when the reading
+// itself is unacceptable. Sending it again cannot succeed.
+var rejections = []error{
+	entity.ErrVehicleDeleted,
+	entity.ErrVehicleRetired,
+	entity.ErrMileageDecreased,
+	entity.ErrVehicleNotChargeable,
+	valueobject.ErrInvalidLocation,
+	valueobject.ErrInvalidMileage,
+	valueobject.ErrInvalidFuelLevel,
+	valueobject.ErrInvalidChargingState,
+	valueobject.ErrInvalidUnitSystem,
+}
+
+// IsRejection reports whether err means the domain refused a telemetry
+// command, as opposed to the command failing to run. Device adapters drop
+// rejected readings and leave anything else unacknowledged for redelivery.
+func IsRejection(err error) bool {
+	for _, target := range rejections {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+package command
+
+type RestoreVehicleCommand struct {
+	VehicleID string
+	Actor     string
+}
+
+func (c *RestoreVehicleCommand) CommandName() string {
+	return "RestoreVehicle"
+}
@@ -0,0 +1,9 @@
+package command
+
+type UnassignDriverCommand struct {
+	DriverID string
+}
+
+func (c *UnassignDriverCommand) CommandName() string {
+	return "UnassignDriver"
+}
@@ -0,0 +1,9 @@
+package command
+
+type UnpairDeviceCommand struct {
+	DeviceID string
+}
+
+func (c *UnpairDeviceCommand) CommandName() string {
+	return "UnpairDevice"
+}
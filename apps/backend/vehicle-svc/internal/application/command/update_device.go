@@ -0,0 +1,12 @@
+package command
+
+type UpdateDeviceCommand struct {
+	DeviceID string
+	Protocol string
+	Firmware string
+	SIM      string
+}
+
+func (c *UpdateDeviceCommand) CommandName() string {
+	return "UpdateDevice"
+}
@@ -0,0 +1,12 @@
+package command
+
+type UpdateDriverCommand struct {
+	DriverID      string
+	Name          string
+	LicenseNumber string
+	Phone         string
+}
+
+func (c *UpdateDriverCommand) CommandName() string {
+	return "UpdateDriver"
+}
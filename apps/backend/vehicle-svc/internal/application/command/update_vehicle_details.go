@@ -0,0 +1,16 @@
+package command
+
+// UpdateVehicleDetailsCommand leaves nil fields untouched. Attributes patches
+// the custom attributes: listed ones are set, or unset when their value is nil.
+type UpdateVehicleDetailsCommand struct {
+	VehicleID     string
+	VehicleName   *string
+	VehicleModel  *string
+	LicenseNumber *string
+	Attributes    map[string]interface{}
+	Actor         string
+}
+
+func (c *UpdateVehicleDetailsCommand) CommandName() string {
+	return "UpdateVehicleDetails"
+}
@@ -0,0 +1,16 @@
+package command
+
+// UpdateVehicleEnergyLevelCommand records a fuel level, or battery state of
+// charge for a BEV. An empty ChargingState or nil EstimatedRange keeps the
+// vehicle's current one.
+type UpdateVehicleEnergyLevelCommand struct {
+	VehicleID      string
+	Level          float64
+	ChargingState  string
+	EstimatedRange *float64
+	Units          string // unit system EstimatedRange is given in; empty means metric
+}
+
+func (c *UpdateVehicleEnergyLevelCommand) CommandName() string {
+	return "UpdateVehicleEnergyLevel"
+}
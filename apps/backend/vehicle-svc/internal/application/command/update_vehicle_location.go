@@ -1,11 +1,23 @@
 package command
 
+import "github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+
+// UpdateVehicleLocationCommand reports a position. Speed, heading and the
+// GNSS quality fields are optional.
 type UpdateVehicleLocationCommand struct {
-	VehicleID string
-	Latitude  float64
-	Longitude float64
-	Altitude  float64
-	Timestamp int64
+	VehicleID  string
+	Latitude   float64
+	Longitude  float64
+	Altitude   float64
+	Timestamp  int64
+	SpeedKmh   *float64
+	Heading    *float64 // degrees clockwise from north
+	Satellites *int
+	HDOP       *float64
+
+	// Outcome is set by the handler once the location is stored: applied, or
+	// archived when the vehicle already has a newer position.
+	Outcome entity.LocationOutcome
 }
 
 func (c *UpdateVehicleLocationCommand) CommandName() string {
@@ -3,6 +3,7 @@ package command
 type UpdateVehicleMileageCommand struct {
 	VehicleID string
 	Mileage   float64
+	Units     string // unit system Mileage is given in; empty means metric
 }
 
 func (c *UpdateVehicleMileageCommand) CommandName() string {
@@ -0,0 +1,12 @@
+package command
+
+type UpdateVehicleTagsCommand struct {
+	VehicleID string
+	Add       []string
+	Remove    []string
+	Actor     string
+}
+
+func (c *UpdateVehicleTagsCommand) CommandName() string {
+	return "UpdateVehicleTags"
+}
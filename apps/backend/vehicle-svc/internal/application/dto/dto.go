@@ -3,16 +3,19 @@ package dto
 import "time"
 
 type CreateVehicleRequest struct {
-	VIN           string  `json:"vin" binding:"required"`
-	VehicleName   string  `json:"vehicleName" binding:"required"`
-	VehicleModel  string  `json:"vehicleModel" binding:"required"`
-	LicenseNumber string  `json:"licenseNumber" binding:"required"`
-	Status        string  `json:"status" binding:"required"`
-	Latitude      float64 `json:"latitude" binding:"required"`
-	Longitude     float64 `json:"longitude" binding:"required"`
-	Altitude      float64 `json:"altitude"`
-	Mileage       float64 `json:"mileage"`
-	FuelLevel     float64 `json:"fuelLevel"`
+	VIN            string                 `json:"vin" binding:"required"`
+	VehicleName    string                 `json:"vehicleName" binding:"required"`
+	VehicleModel   string                 `json:"vehicleModel" binding:"required"`
+	LicenseNumber  string                 `json:"licenseNumber" binding:"required"`
+	Status         string                 `json:"status" binding:"required"`
+	Latitude       float64                `json:"latitude" binding:"required"`
+	Longitude      float64                `json:"longitude" binding:"required"`
+	Altitude       float64                `json:"altitude"`
+	Mileage        float64                `json:"mileage"`
+	FuelLevel      float64                `json:"fuelLevel"`
+	EnergyType     string                 `json:"energyType"`     // ice, hybrid or bev; defaults to ice
+	EnergyCapacity float64                `json:"energyCapacity"` // tank volume, or battery kWh for a bev
+	Attributes     map[string]interface{} `json:"attributes"`     // custom attributes of the tenant's schema
 }
 
 type CreateVehicleResponse struct {
@@ -31,10 +34,14 @@ type CreateVehicleResponse struct {
 }
 
 type UpdateVehicleLocationRequest struct {
-	Latitude  float64 `json:"latitude" binding:"required"`
-	Longitude float64 `json:"longitude" binding:"required"`
-	Altitude  float64 `json:"altitude"`
-	Timestamp int64   `json:"timestamp" binding:"required"`
+	Latitude   float64  `json:"latitude" binding:"required"`
+	Longitude  float64  `json:"longitude" binding:"required"`
+	Altitude   float64  `json:"altitude"`
+	Timestamp  int64    `json:"timestamp" binding:"required"`
+	SpeedKmh   *float64 `json:"speedKmh"`
+	Heading    *float64 `json:"heading"` // degrees clockwise from north
+	Satellites *int     `json:"satellites"`
+	HDOP       *float64 `json:"hdop"`
 }
 
 type UpdateVehicleLocationResponse struct {
@@ -46,8 +53,56 @@ type UpdateVehicleLocationResponse struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// IngestLocationsRequest is a buffered telemetry upload; points may belong to
+// any number of vehicles and arrive in any order.
+type IngestLocationsRequest struct {
+	Points []LocationPointDTO `json:"points"`
+}
+
+// LocationPointDTO names either the vehicle or the reporting device.
+type LocationPointDTO struct {
+	VehicleID  string   `json:"vehicleId"`
+	DeviceID   string   `json:"deviceId"` // IMEI or serial of a registered device
+	Latitude   float64  `json:"latitude"`
+	Longitude  float64  `json:"longitude"`
+	Altitude   float64  `json:"altitude"`
+	Timestamp  int64    `json:"timestamp"` // device time, unix seconds
+	SpeedKmh   *float64 `json:"speedKmh"`
+	Heading    *float64 `json:"heading"`
+	Satellites *int     `json:"satellites"`
+	HDOP       *float64 `json:"hdop"`
+}
+
+// IngestLocationsResponse reports every point of an upload in request order.
+type IngestLocationsResponse struct {
+	Accepted   int                   `json:"accepted"`
+	Archived   int                   `json:"archived"`
+	Duplicates int                   `json:"duplicates"`
+	Rejected   int                   `json:"rejected"`
+	Results    []LocationPointResult `json:"results"`
+}
+
+type LocationPointResult struct {
+	Index     int    `json:"index"`
+	VehicleID string `json:"vehicleId,omitempty"`
+	DeviceID  string `json:"deviceId,omitempty"`
+	Status    string `json:"status"` // accepted, archived, duplicate or rejected
+	Error     string `json:"error,omitempty"`
+}
+
+// UpdateVehicleDetailsRequest is a partial update; omitted fields are kept.
+type UpdateVehicleDetailsRequest struct {
+	VehicleName   *string                `json:"vehicleName"`
+	VehicleModel  *string                `json:"vehicleModel"`
+	LicenseNumber *string                `json:"licenseNumber"`
+	Attributes    map[string]interface{} `json:"attributes"` // patch; a null value unsets the attribute
+	Actor         string                 `json:"actor"`
+}
+
 type ChangeVehicleStatusRequest struct {
 	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+	Actor  string `json:"actor"`
 }
 
 type ChangeVehicleStatusResponse struct {
@@ -59,20 +114,92 @@ type ChangeVehicleStatusResponse struct {
 }
 
 type VehicleResponse struct {
-	ID            string    `json:"id"`
-	VIN           string    `json:"vin"`
-	VehicleName   string    `json:"vehicleName"`
-	VehicleModel  string    `json:"vehicleModel"`
-	LicenseNumber string    `json:"licenseNumber"`
-	Status        string    `json:"status"`
-	Latitude      float64   `json:"latitude"`
-	Longitude     float64   `json:"longitude"`
-	Altitude      float64   `json:"altitude"`
-	Mileage       float64   `json:"mileage"`
-	FuelLevel     float64   `json:"fuelLevel"`
-	Version       int64     `json:"version"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID             string                 `json:"id"`
+	VIN            string                 `json:"vin"`
+	Manufacturer   string                 `json:"manufacturer,omitempty"`
+	ModelYear      int                    `json:"modelYear,omitempty"`
+	PlantCode      string                 `json:"plantCode,omitempty"`
+	VehicleName    string                 `json:"vehicleName"`
+	VehicleModel   string                 `json:"vehicleModel"`
+	LicenseNumber  string                 `json:"licenseNumber"`
+	Status         string                 `json:"status"`
+	Latitude       float64                `json:"latitude"`
+	Longitude      float64                `json:"longitude"`
+	Altitude       float64                `json:"altitude"`
+	LocationAt     int64                  `json:"locationAt"` // device time of the position, unix seconds
+	SpeedKmh       *float64               `json:"speedKmh,omitempty"`
+	Heading        *float64               `json:"heading,omitempty"`
+	Satellites     *int                   `json:"satellites,omitempty"`
+	HDOP           *float64               `json:"hdop,omitempty"`
+	Mileage        float64                `json:"mileage"`
+	MileageUnit    string                 `json:"mileageUnit"` // km or mi
+	FuelLevel      float64                `json:"fuelLevel"`   // battery state of charge for a bev
+	EnergyType     string                 `json:"energyType"`
+	EnergyCapacity float64                `json:"energyCapacity,omitempty"`
+	CapacityUnit   string                 `json:"capacityUnit"` // L, gal or kWh
+	ChargingState  string                 `json:"chargingState,omitempty"`
+	EstimatedRange *float64               `json:"estimatedRange,omitempty"` // in MileageUnit
+	Version        int64                  `json:"version"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	DeletedAt      *time.Time             `json:"deletedAt,omitempty"`
+	DeletionReason string                 `json:"deletionReason,omitempty"`
+	Group          string                 `json:"group,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Attributes     map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// AssignVehicleGroupRequest removes the vehicle from its group when Group is
+// empty.
+type AssignVehicleGroupRequest struct {
+	Group string `json:"group"`
+	Actor string `json:"actor"`
+}
+
+type UpdateVehicleTagsRequest struct {
+	Add    []string `json:"add"`
+	Remove []string `json:"remove"`
+	Actor  string   `json:"actor"`
+}
+
+type ChangeGroupStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+	Actor  string `json:"actor"`
+}
+
+type ChangeGroupStatusResponse struct {
+	Group   string            `json:"group"`
+	Changed int               `json:"changed"`
+	Failed  map[string]string `json:"failed,omitempty"` // error by vehicle id
+}
+
+type AttributeDefinitionDTO struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"` // string, number, integer, boolean or enum
+	Required    bool     `json:"required"`
+	Enum        []string `json:"enum,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+type DefineAttributeSchemaRequest struct {
+	Attributes []AttributeDefinitionDTO `json:"attributes"`
+}
+
+type AttributeSchemaResponse struct {
+	TenantID   string                   `json:"tenantId"`
+	Attributes []AttributeDefinitionDTO `json:"attributes"`
+	Version    int64                    `json:"version"`
+	UpdatedAt  *time.Time               `json:"updatedAt,omitempty"`
+}
+
+type DeleteVehicleRequest struct {
+	Reason string `json:"reason" binding:"required"`
+	Actor  string `json:"actor"`
+}
+
+type RestoreVehicleRequest struct {
+	Actor string `json:"actor"`
 }
 
 type UpdateVehicleMileageRequest struct {
@@ -90,12 +217,13 @@ type UpdateVehicleFuelLevelRequest struct {
 	FuelLevel float64 `json:"fuelLevel" binding:"required"`
 }
 
-type UpdateVehicleFuelLevelResponse struct {
-	ID        string    `json:"id"`
-	FuelLevel float64   `json:"fuelLevel"`
-	IsLow     bool      `json:"isLow"`
-	Version   int64     `json:"version"`
-	UpdatedAt time.Time `json:"updatedAt"`
+// UpdateVehicleEnergyLevelRequest is a telemetry reading. Level is the fuel
+// percentage, or the battery state of charge for a BEV; an omitted charging
+// state or range keeps the last one reported.
+type UpdateVehicleEnergyLevelRequest struct {
+	Level          float64  `json:"level" binding:"required"`
+	ChargingState  string   `json:"chargingState"`
+	EstimatedRange *float64 `json:"estimatedRange"` // in the request's distance unit
 }
 
 type ErrorResponse struct {
@@ -103,3 +231,132 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 	Details string `json:"details,omitempty"`
 }
+
+type CreateDriverRequest struct {
+	Name          string `json:"name" binding:"required"`
+	LicenseNumber string `json:"licenseNumber" binding:"required"`
+	Phone         string `json:"phone"`
+}
+
+type UpdateDriverRequest struct {
+	Name          string `json:"name" binding:"required"`
+	LicenseNumber string `json:"licenseNumber" binding:"required"`
+	Phone         string `json:"phone"`
+}
+
+type AssignDriverRequest struct {
+	VehicleID string `json:"vehicleId" binding:"required"`
+}
+
+type DriverResponse struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	LicenseNumber     string     `json:"licenseNumber"`
+	Phone             string     `json:"phone"`
+	AssignedVehicleID string     `json:"assignedVehicleId,omitempty"`
+	AssignedAt        *time.Time `json:"assignedAt,omitempty"`
+	Version           int64      `json:"version"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+type RegisterDeviceRequest struct {
+	HardwareID string `json:"hardwareId" binding:"required"` // IMEI or serial number
+	Protocol   string `json:"protocol" binding:"required"`
+	Firmware   string `json:"firmware"`
+	SIM        string `json:"sim"`
+}
+
+type UpdateDeviceRequest struct {
+	Protocol string `json:"protocol" binding:"required"`
+	Firmware string `json:"firmware"`
+	SIM      string `json:"sim"`
+}
+
+type PairDeviceRequest struct {
+	VehicleID string `json:"vehicleId" binding:"required"`
+}
+
+type DeviceResponse struct {
+	ID              string                  `json:"id"`
+	HardwareID      string                  `json:"hardwareId"`
+	Protocol        string                  `json:"protocol"`
+	Firmware        string                  `json:"firmware,omitempty"`
+	SIM             string                  `json:"sim,omitempty"`
+	PairedVehicleID string                  `json:"pairedVehicleId,omitempty"`
+	Pairings        []DevicePairingResponse `json:"pairings"`
+	Version         int64                   `json:"version"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       time.Time               `json:"updatedAt"`
+}
+
+type DevicePairingResponse struct {
+	VehicleID  string     `json:"vehicleId"`
+	PairedAt   time.Time  `json:"pairedAt"`
+	UnpairedAt *time.Time `json:"unpairedAt,omitempty"`
+}
+
+type CreateMaintenancePlanRequest struct {
+	VehicleModel    string  `json:"vehicleModel" binding:"required"`
+	Name            string  `json:"name" binding:"required"`
+	IntervalKm      float64 `json:"intervalKm"`
+	IntervalMonths  int     `json:"intervalMonths"`
+	AutoMaintenance bool    `json:"autoMaintenance"`
+}
+
+type MaintenancePlanResponse struct {
+	ID              string    `json:"id"`
+	VehicleModel    string    `json:"vehicleModel"`
+	Name            string    `json:"name"`
+	IntervalKm      float64   `json:"intervalKm,omitempty"`
+	IntervalMonths  int       `json:"intervalMonths,omitempty"`
+	AutoMaintenance bool      `json:"autoMaintenance"`
+	Version         int64     `json:"version"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type CompleteMaintenanceTaskRequest struct {
+	Mileage *float64 `json:"mileage"`
+}
+
+type MaintenanceTaskResponse struct {
+	ID             string     `json:"id"`
+	PlanID         string     `json:"planId"`
+	PlanName       string     `json:"planName"`
+	VehicleID      string     `json:"vehicleId"`
+	VehicleName    string     `json:"vehicleName,omitempty"`
+	VehicleModel   string     `json:"vehicleModel,omitempty"`
+	Status         string     `json:"status"`
+	DueMileage     float64    `json:"dueMileage,omitempty"`
+	DueAt          *time.Time `json:"dueAt,omitempty"`
+	CurrentMileage float64    `json:"currentMileage"`
+	RemainingKm    *float64   `json:"remainingKm,omitempty"`
+	Version        int64      `json:"version"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+type UpcomingMaintenanceResponse struct {
+	Tasks []*MaintenanceTaskResponse `json:"tasks"`
+	Total int                        `json:"total"`
+}
+
+type ApplyTelemetryCorrectionRequest struct {
+	Field  string  `json:"field"`
+	Value  float64 `json:"value"`
+	Reason string  `json:"reason"`
+	Actor  string  `json:"actor"`
+}
+
+type TelemetryCorrectionResponse struct {
+	ID        string    `json:"id"`
+	VehicleID string    `json:"vehicleId"`
+	Field     string    `json:"field"`
+	OldValue  float64   `json:"oldValue"`
+	NewValue  float64   `json:"newValue"`
+	Unit      string    `json:"unit,omitempty"` // km or mi for mileage corrections
+	Reason    string    `json:"reason"`
+	Actor     string    `json:"actor"`
+	AppliedAt time.Time `json:"appliedAt"`
+}
@@ -0,0 +1,8 @@
+package event
+
+type VehicleMileageUpdatedEvent struct {
+	VehicleID string  `json:"vehicleId"`
+	Mileage   float64 `json:"mileage"`
+	UpdatedAt int64   `json:"updatedAt"`
+	Version   int64   `json:"version"`
+}
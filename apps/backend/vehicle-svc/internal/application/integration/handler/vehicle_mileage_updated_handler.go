@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/integration/event"
+	"go.uber.org/zap"
+)
+
+// VehicleMileageUpdatedEventHandler re-evaluates maintenance plans whenever a
+// vehicle's odometer moves.
+type VehicleMileageUpdatedEventHandler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewVehicleMileageUpdatedEventHandler(commandBus command.CommandBus, logger *zap.Logger) *VehicleMileageUpdatedEventHandler {
+	return &VehicleMileageUpdatedEventHandler{commandBus: commandBus, logger: logger}
+}
+
+func (h *VehicleMileageUpdatedEventHandler) Handle(ctx context.Context, payload []byte) error {
+	var evt event.VehicleMileageUpdatedEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		h.logger.Error("failed to unmarshal vehicle mileage updated event", zap.Error(err))
+		return err
+	}
+
+	evaluateCmd := &command.EvaluateMaintenanceCommand{
+		VehicleID: evt.VehicleID,
+	}
+
+	if err := h.commandBus.Dispatch(ctx, evaluateCmd); err != nil {
+		h.logger.Error("failed to evaluate maintenance plans",
+			zap.String("vehicle_id", evt.VehicleID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
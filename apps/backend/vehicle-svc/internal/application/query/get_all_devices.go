@@ -0,0 +1,10 @@
+package query
+
+type GetAllDevicesQuery struct {
+	Limit  int
+	Offset int
+}
+
+func (q *GetAllDevicesQuery) QueryName() string {
+	return "GetAllDevices"
+}
@@ -0,0 +1,10 @@
+package query
+
+type GetAllDriversQuery struct {
+	Limit  int
+	Offset int
+}
+
+func (q *GetAllDriversQuery) QueryName() string {
+	return "GetAllDrivers"
+}
@@ -0,0 +1,11 @@
+package query
+
+type GetAllMaintenancePlansQuery struct {
+	VehicleModel string
+	Limit        int
+	Offset       int
+}
+
+func (q *GetAllMaintenancePlansQuery) QueryName() string {
+	return "GetAllMaintenancePlans"
+}
@@ -1,8 +1,16 @@
 package query
 
 type GetAllVehiclesQuery struct {
-	Limit  int
-	Offset int
+	Limit     int
+	Offset    int
+	Deleted   bool   // list soft-deleted vehicles instead of live ones
+	Make      string // manufacturer decoded from the VIN
+	ModelYear int
+	Group     string
+	Tag       string
+	// Attributes filters by custom attribute, values as given in the URL.
+	Attributes map[string]string
+	Units      string
 }
 
 func (q *GetAllVehiclesQuery) QueryName() string {
@@ -0,0 +1,7 @@
+package query
+
+type GetAttributeSchemaQuery struct{}
+
+func (q *GetAttributeSchemaQuery) QueryName() string {
+	return "GetAttributeSchema"
+}
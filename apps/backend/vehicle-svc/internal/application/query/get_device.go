@@ -0,0 +1,9 @@
+package query
+
+type GetDeviceQuery struct {
+	DeviceID string
+}
+
+func (q *GetDeviceQuery) QueryName() string {
+	return "GetDevice"
+}
@@ -0,0 +1,9 @@
+package query
+
+type GetDriverQuery struct {
+	DriverID string
+}
+
+func (q *GetDriverQuery) QueryName() string {
+	return "GetDriver"
+}
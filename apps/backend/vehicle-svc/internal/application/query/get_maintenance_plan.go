@@ -0,0 +1,9 @@
+package query
+
+type GetMaintenancePlanQuery struct {
+	PlanID string
+}
+
+func (q *GetMaintenancePlanQuery) QueryName() string {
+	return "GetMaintenancePlan"
+}
@@ -0,0 +1,16 @@
+package query
+
+type GetUpcomingMaintenanceQuery struct {
+	Status    string
+	VehicleID string
+	// WithinKm and WithinDays narrow scheduled tasks to those coming due soon.
+	// Due and overdue tasks are always included.
+	WithinKm   float64
+	WithinDays int
+	Limit      int
+	Offset     int
+}
+
+func (q *GetUpcomingMaintenanceQuery) QueryName() string {
+	return "GetUpcomingMaintenance"
+}
@@ -2,6 +2,7 @@ package query
 
 type GetVehicleQuery struct {
 	VehicleID string
+	Units     string
 }
 
 func (q *GetVehicleQuery) QueryName() string {
@@ -0,0 +1,12 @@
+package query
+
+type GetVehicleCorrectionsQuery struct {
+	VehicleID string
+	Limit     int
+	Offset    int
+	Units     string
+}
+
+func (q *GetVehicleCorrectionsQuery) QueryName() string {
+	return "GetVehicleCorrections"
+}
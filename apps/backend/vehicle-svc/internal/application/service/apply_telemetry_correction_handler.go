@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type ApplyTelemetryCorrectionCommandHandler struct {
+	vehicleRepo    repository.VehicleRepository
+	correctionRepo repository.TelemetryCorrectionRepository
+	outboxRepo     repository.OutboxRepository
+}
+
+func NewApplyTelemetryCorrectionCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	correctionRepo repository.TelemetryCorrectionRepository,
+	outboxRepo repository.OutboxRepository,
+) *ApplyTelemetryCorrectionCommandHandler {
+	return &ApplyTelemetryCorrectionCommandHandler{
+		vehicleRepo:    vehicleRepo,
+		correctionRepo: correctionRepo,
+		outboxRepo:     outboxRepo,
+	}
+}
+
+func (h *ApplyTelemetryCorrectionCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	correctionCmd, ok := cmd.(*command.ApplyTelemetryCorrectionCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for ApplyTelemetryCorrectionCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(correctionCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	correctionID := valueobject.GenerateTelemetryCorrectionID()
+	if correctionCmd.CorrectionID != "" {
+		id, err := valueobject.NewTelemetryCorrectionID(correctionCmd.CorrectionID)
+		if err != nil {
+			return fmt.Errorf("invalid correction id: %w", err)
+		}
+		correctionID = id
+	}
+
+	field, err := valueobject.NewCorrectionField(correctionCmd.Field)
+	if err != nil {
+		return fmt.Errorf("invalid field: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(correctionCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	units, err := valueobject.NewUnitSystem(correctionCmd.Units)
+	if err != nil {
+		return err
+	}
+	value := correctionCmd.Value
+	if field == valueobject.CorrectionMileage {
+		value = units.ToKilometers(value)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	correction, err := vehicle.ApplyCorrection(correctionID, field, value, correctionCmd.Reason, actor)
+	if err != nil {
+		return fmt.Errorf("failed to apply correction: %w", err)
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	if err := h.correctionRepo.Save(ctx, correction); err != nil {
+		return fmt.Errorf("failed to save telemetry correction: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
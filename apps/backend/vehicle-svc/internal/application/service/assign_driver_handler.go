@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type AssignDriverCommandHandler struct {
+	driverRepo  repository.DriverRepository
+	vehicleRepo repository.VehicleRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewAssignDriverCommandHandler(
+	driverRepo repository.DriverRepository,
+	vehicleRepo repository.VehicleRepository,
+	outboxRepo repository.OutboxRepository,
+) *AssignDriverCommandHandler {
+	return &AssignDriverCommandHandler{
+		driverRepo:  driverRepo,
+		vehicleRepo: vehicleRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *AssignDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	assignCmd, ok := cmd.(*command.AssignDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for AssignDriverCommandHandler")
+	}
+
+	driverID, err := valueobject.NewDriverID(assignCmd.DriverID)
+	if err != nil {
+		return fmt.Errorf("invalid driver id: %w", err)
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(assignCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	driver, err := h.driverRepo.FindByID(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+	if vehicle.IsDeleted() {
+		return fmt.Errorf("cannot assign driver: %w", entity.ErrVehicleDeleted)
+	}
+	if vehicle.Status() == valueobject.StatusRetired {
+		return fmt.Errorf("cannot assign driver: %w", entity.ErrVehicleRetired)
+	}
+
+	current, err := h.driverRepo.FindByAssignedVehicle(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find assigned driver: %w", err)
+	}
+	if current != nil && !current.ID().Equals(driverID) {
+		return fmt.Errorf("%w: %s", entity.ErrVehicleAlreadyAssigned, current.ID().String())
+	}
+
+	if err := driver.AssignTo(vehicleID); err != nil {
+		return fmt.Errorf("failed to assign driver: %w", err)
+	}
+
+	if err := h.driverRepo.Save(ctx, driver); err != nil {
+		return fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	for _, event := range driver.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, driverID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type AssignVehicleGroupCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewAssignVehicleGroupCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	outboxRepo repository.OutboxRepository,
+) *AssignVehicleGroupCommandHandler {
+	return &AssignVehicleGroupCommandHandler{
+		vehicleRepo: vehicleRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *AssignVehicleGroupCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	groupCmd, ok := cmd.(*command.AssignVehicleGroupCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for AssignVehicleGroupCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(groupCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	var group valueobject.GroupName
+	if strings.TrimSpace(groupCmd.Group) != "" {
+		group, err = valueobject.NewGroupName(groupCmd.Group)
+		if err != nil {
+			return fmt.Errorf("invalid group: %w", err)
+		}
+	}
+
+	actor, err := valueobject.NewActor(groupCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if err := vehicle.AssignGroup(group, actor); err != nil {
+		return fmt.Errorf("failed to assign group: %w", err)
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
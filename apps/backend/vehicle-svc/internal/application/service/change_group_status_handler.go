@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+const groupStatusPageSize = 100
+
+// ChangeGroupStatusCommandHandler fans a group status change out as one
+// ChangeVehicleStatusCommand per vehicle, so each vehicle is saved and
+// published exactly as a single status change would be.
+type ChangeGroupStatusCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	commandBus  command.CommandBus
+}
+
+func NewChangeGroupStatusCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	commandBus command.CommandBus,
+) *ChangeGroupStatusCommandHandler {
+	return &ChangeGroupStatusCommandHandler{
+		vehicleRepo: vehicleRepo,
+		commandBus:  commandBus,
+	}
+}
+
+func (h *ChangeGroupStatusCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	groupCmd, ok := cmd.(*command.ChangeGroupStatusCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for ChangeGroupStatusCommandHandler")
+	}
+
+	group, err := valueobject.NewGroupName(groupCmd.Group)
+	if err != nil {
+		return fmt.Errorf("invalid group: %w", err)
+	}
+	if _, err := valueobject.NewVehicleStatus(groupCmd.NewStatus); err != nil {
+		return fmt.Errorf("invalid status: %w", err)
+	}
+	if _, err := valueobject.NewStatusChangeReason(groupCmd.Reason); err != nil {
+		return fmt.Errorf("invalid reason: %w", err)
+	}
+	if _, err := valueobject.NewActor(groupCmd.Actor); err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	// Collect the members first; status changes do not move vehicles between
+	// groups, but paging over a collection while writing to it is fragile.
+	var vehicleIDs []string
+	filter := repository.VehicleFilter{Group: group.String()}
+	for offset := 0; ; offset += groupStatusPageSize {
+		vehicles, err := h.vehicleRepo.FindAll(ctx, filter, groupStatusPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("failed to find vehicles in group: %w", err)
+		}
+		for _, vehicle := range vehicles {
+			vehicleIDs = append(vehicleIDs, vehicle.ID().String())
+		}
+		if len(vehicles) < groupStatusPageSize {
+			break
+		}
+	}
+
+	result := &command.GroupStatusChangeError{Group: group.String(), Failed: map[string]error{}}
+	for _, vehicleID := range vehicleIDs {
+		statusCmd := &command.ChangeVehicleStatusCommand{
+			VehicleID: vehicleID,
+			NewStatus: groupCmd.NewStatus,
+			Reason:    groupCmd.Reason,
+			Actor:     groupCmd.Actor,
+		}
+		if err := h.commandBus.Dispatch(ctx, statusCmd); err != nil {
+			result.Failed[vehicleID] = err
+			continue
+		}
+		result.Changed++
+	}
+
+	if len(result.Failed) > 0 {
+		return result
+	}
+	return nil
+}
@@ -40,12 +40,22 @@ func (h *ChangeVehicleStatusCommandHandler) Handle(ctx context.Context, cmd comm
 		return fmt.Errorf("invalid status: %w", err)
 	}
 
+	reason, err := valueobject.NewStatusChangeReason(statusCmd.Reason)
+	if err != nil {
+		return fmt.Errorf("invalid reason: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(statusCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
 	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
 	if err != nil {
 		return fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
-	if err := vehicle.ChangeStatus(newStatus); err != nil {
+	if err := vehicle.ChangeStatus(newStatus, reason, actor); err != nil {
 		return fmt.Errorf("failed to change status: %w", err)
 	}
 
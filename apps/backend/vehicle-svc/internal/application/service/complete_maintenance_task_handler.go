@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type CompleteMaintenanceTaskCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	planRepo    repository.MaintenancePlanRepository
+	taskRepo    repository.MaintenanceTaskRepository
+}
+
+func NewCompleteMaintenanceTaskCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	planRepo repository.MaintenancePlanRepository,
+	taskRepo repository.MaintenanceTaskRepository,
+) *CompleteMaintenanceTaskCommandHandler {
+	return &CompleteMaintenanceTaskCommandHandler{
+		vehicleRepo: vehicleRepo,
+		planRepo:    planRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+func (h *CompleteMaintenanceTaskCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	completeCmd, ok := cmd.(*command.CompleteMaintenanceTaskCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for CompleteMaintenanceTaskCommandHandler")
+	}
+
+	taskID, err := valueobject.NewMaintenanceTaskID(completeCmd.TaskID)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance task id: %w", err)
+	}
+
+	task, err := h.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to find maintenance task: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, task.VehicleID())
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	mileage := vehicle.Mileage()
+	if completeCmd.Mileage != nil {
+		mileage, err = valueobject.NewMileage(*completeCmd.Mileage)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := task.Complete(mileage); err != nil {
+		return err
+	}
+
+	if err := h.taskRepo.Save(ctx, task); err != nil {
+		return fmt.Errorf("failed to save maintenance task: %w", err)
+	}
+
+	plan, err := h.planRepo.FindByID(ctx, task.PlanID())
+	if err != nil {
+		// The plan was deleted after this task was opened; nothing to schedule.
+		return nil
+	}
+
+	next := plan.ScheduleFor(vehicle.ID(), mileage, *task.CompletedAt())
+	if err := h.taskRepo.Save(ctx, next); err != nil {
+		return fmt.Errorf("failed to schedule next maintenance task: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type CreateDriverCommandHandler struct {
+	driverRepo repository.DriverRepository
+}
+
+func NewCreateDriverCommandHandler(driverRepo repository.DriverRepository) *CreateDriverCommandHandler {
+	return &CreateDriverCommandHandler{driverRepo: driverRepo}
+}
+
+func (h *CreateDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	createCmd, ok := cmd.(*command.CreateDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for CreateDriverCommandHandler")
+	}
+
+	driverID := valueobject.GenerateDriverID()
+	if createCmd.DriverID != "" {
+		id, err := valueobject.NewDriverID(createCmd.DriverID)
+		if err != nil {
+			return fmt.Errorf("invalid driver id: %w", err)
+		}
+		driverID = id
+	}
+
+	licenseNumber, err := valueobject.NewLicenseNumber(createCmd.LicenseNumber)
+	if err != nil {
+		return fmt.Errorf("invalid license number: %w", err)
+	}
+
+	exists, err := h.driverRepo.ExistsByLicenseNumber(ctx, createCmd.LicenseNumber)
+	if err != nil {
+		return fmt.Errorf("failed to check license number existence: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("driver with license number %s already exists", createCmd.LicenseNumber)
+	}
+
+	driver, err := entity.NewDriver(driverID, tenant.ID(ctx), createCmd.Name, licenseNumber, createCmd.Phone)
+	if err != nil {
+		return fmt.Errorf("failed to create driver: %w", err)
+	}
+
+	if err := h.driverRepo.Save(ctx, driver); err != nil {
+		return fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	return nil
+}
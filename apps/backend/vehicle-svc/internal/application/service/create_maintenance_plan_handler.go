@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type CreateMaintenancePlanCommandHandler struct {
+	planRepo repository.MaintenancePlanRepository
+}
+
+func NewCreateMaintenancePlanCommandHandler(planRepo repository.MaintenancePlanRepository) *CreateMaintenancePlanCommandHandler {
+	return &CreateMaintenancePlanCommandHandler{planRepo: planRepo}
+}
+
+func (h *CreateMaintenancePlanCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	createCmd, ok := cmd.(*command.CreateMaintenancePlanCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for CreateMaintenancePlanCommandHandler")
+	}
+
+	planID := valueobject.GenerateMaintenancePlanID()
+	if createCmd.PlanID != "" {
+		id, err := valueobject.NewMaintenancePlanID(createCmd.PlanID)
+		if err != nil {
+			return fmt.Errorf("invalid maintenance plan id: %w", err)
+		}
+		planID = id
+	}
+
+	interval, err := valueobject.NewMaintenanceInterval(createCmd.IntervalKm, createCmd.IntervalMonths)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance interval: %w", err)
+	}
+
+	plan, err := entity.NewMaintenancePlan(
+		planID,
+		tenant.ID(ctx),
+		createCmd.VehicleModel,
+		createCmd.Name,
+		interval,
+		createCmd.AutoMaintenance,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create maintenance plan: %w", err)
+	}
+
+	if err := h.planRepo.Save(ctx, plan); err != nil {
+		return fmt.Errorf("failed to save maintenance plan: %w", err)
+	}
+
+	return nil
+}
@@ -7,21 +7,28 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 type CreateVehicleCommandHandler struct {
-	vehicleRepo repository.VehicleRepository
-	outboxRepo  repository.OutboxRepository
+	vehicleRepo   repository.VehicleRepository
+	schemaRepo    repository.AttributeSchemaRepository
+	outboxRepo    repository.OutboxRepository
+	vinValidation valueobject.VINValidation
 }
 
 func NewCreateVehicleCommandHandler(
 	vehicleRepo repository.VehicleRepository,
+	schemaRepo repository.AttributeSchemaRepository,
 	outboxRepo repository.OutboxRepository,
+	vinValidation valueobject.VINValidation,
 ) *CreateVehicleCommandHandler {
 	return &CreateVehicleCommandHandler{
-		vehicleRepo: vehicleRepo,
-		outboxRepo:  outboxRepo,
+		vehicleRepo:   vehicleRepo,
+		schemaRepo:    schemaRepo,
+		outboxRepo:    outboxRepo,
+		vinValidation: vinValidation,
 	}
 }
 
@@ -37,6 +44,11 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		return fmt.Errorf("invalid status: %w", err)
 	}
 
+	vin, err := valueobject.NewVIN(createCmd.VIN, h.vinValidation)
+	if err != nil {
+		return fmt.Errorf("invalid vin: %w", err)
+	}
+
 	location, err := valueobject.NewLocation(
 		createCmd.Latitude,
 		createCmd.Longitude,
@@ -44,7 +56,7 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		0,
 	)
 	if err != nil {
-		return fmt.Errorf("invalid location: %w", err)
+		return err
 	}
 
 	licenseNumber, err := valueobject.NewLicenseNumber(createCmd.LicenseNumber)
@@ -52,28 +64,51 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		return fmt.Errorf("invalid license number: %w", err)
 	}
 
-	mileage, err := valueobject.NewMileage(createCmd.Mileage)
+	units, err := valueobject.NewUnitSystem(createCmd.Units)
+	if err != nil {
+		return err
+	}
+	mileage, err := valueobject.NewMileageIn(createCmd.Mileage, units)
 	if err != nil {
-		return fmt.Errorf("invalid mileage: %w", err)
+		return err
 	}
 
 	fuelLevel, err := valueobject.NewFuelLevel(createCmd.FuelLevel)
 	if err != nil {
-		return fmt.Errorf("invalid fuel level: %w", err)
+		return err
+	}
+
+	energyType, err := valueobject.NewEnergyType(createCmd.EnergyType)
+	if err != nil {
+		return err
+	}
+	energySource, err := valueobject.NewEnergySourceIn(energyType, createCmd.EnergyCapacity, units)
+	if err != nil {
+		return fmt.Errorf("invalid energy capacity: %w", err)
+	}
+
+	schema, err := h.schemaRepo.Find(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load attribute schema: %w", err)
+	}
+	attributes, err := schema.Validate(createCmd.Attributes)
+	if err != nil {
+		return err
 	}
 
-	exists, err := h.vehicleRepo.ExistsByVIN(ctx, createCmd.VIN)
+	exists, err := h.vehicleRepo.ExistsByVIN(ctx, vin.String())
 	if err != nil {
 		return fmt.Errorf("failed to check vin existence: %w", err)
 	}
 	if exists {
-		return fmt.Errorf("vehicle with vin %s already exists", createCmd.VIN)
+		return fmt.Errorf("vehicle with vin %s already exists", vin)
 	}
 
 	vehicleID := valueobject.GenerateVehicleID()
 	vehicle, err := entity.NewVehicle(
 		vehicleID,
-		createCmd.VIN,
+		tenant.ID(ctx),
+		vin,
 		createCmd.VehicleName,
 		createCmd.VehicleModel,
 		licenseNumber,
@@ -81,6 +116,8 @@ func (h *CreateVehicleCommandHandler) Handle(ctx context.Context, cmd command.Co
 		location,
 		mileage,
 		fuelLevel,
+		energySource,
+		attributes,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create vehicle: %w", err)
@@ -25,7 +25,7 @@ func (m *MockVehicleRepo) Save(ctx context.Context, v *entity.Vehicle) error {
 func (m *MockVehicleRepo) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
 	return nil, nil
 }
-func (m *MockVehicleRepo) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error) {
+func (m *MockVehicleRepo) FindAll(ctx context.Context, filter repository.VehicleFilter, limit int, offset int) ([]*entity.Vehicle, error) {
 	return nil, nil
 }
 func (m *MockVehicleRepo) Delete(ctx context.Context, id valueobject.VehicleID) error {
@@ -49,14 +49,30 @@ func (m *MockOutboxRepo) MarkEventAsPublished(ctx context.Context, eventID strin
 	return nil
 }
 
+// MockAttributeSchemaRepo serves a fixed schema; nil means the tenant has not
+// defined one.
+type MockAttributeSchemaRepo struct{ schema *entity.AttributeSchema }
+
+func (m *MockAttributeSchemaRepo) Save(ctx context.Context, schema *entity.AttributeSchema) error {
+	m.schema = schema
+	return nil
+}
+func (m *MockAttributeSchemaRepo) Find(ctx context.Context) (*entity.AttributeSchema, error) {
+	if m.schema == nil {
+		return entity.NewAttributeSchema("default")
+	}
+	return m.schema, nil
+}
+
 // --- Tests ---
 func TestCreateVehicleCommandHandler_Success(t *testing.T) {
 	vehicleRepo := new(MockVehicleRepo)
 	outboxRepo := new(MockOutboxRepo)
-	h := NewCreateVehicleCommandHandler(vehicleRepo, outboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
 
 	cmd := &command.CreateVehicleCommand{
-		VIN:           "VIN123",
+		VIN:           "1HGBH41JXMN109186",
 		VehicleName:   "TestCar",
 		VehicleModel:  "ModelX",
 		LicenseNumber: "ABC123",
@@ -68,7 +84,7 @@ func TestCreateVehicleCommandHandler_Success(t *testing.T) {
 		FuelLevel:     80,
 	}
 
-	vehicleRepo.On("ExistsByVIN", mock.Anything, "VIN123").Return(false, nil)
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "1HGBH41JXMN109186").Return(false, nil)
 	vehicleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
 	outboxRepo.On("SaveOutboxEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
@@ -81,10 +97,11 @@ func TestCreateVehicleCommandHandler_Success(t *testing.T) {
 func TestCreateVehicleCommandHandler_AlreadyExists(t *testing.T) {
 	vehicleRepo := new(MockVehicleRepo)
 	outboxRepo := new(MockOutboxRepo)
-	h := NewCreateVehicleCommandHandler(vehicleRepo, outboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
 
 	cmd := &command.CreateVehicleCommand{
-		VIN:           "VIN123",
+		VIN:           "1HGBH41JXMN109186",
 		VehicleName:   "TestCar",
 		VehicleModel:  "ModelX",
 		LicenseNumber: "ABC123",
@@ -96,7 +113,7 @@ func TestCreateVehicleCommandHandler_AlreadyExists(t *testing.T) {
 		FuelLevel:     80,
 	}
 
-	vehicleRepo.On("ExistsByVIN", mock.Anything, "VIN123").Return(false, nil)
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "1HGBH41JXMN109186").Return(false, nil)
 	vehicleRepo.On("Save", mock.Anything, mock.Anything).Return(errors.New("db error"))
 
 	err := h.Handle(context.Background(), cmd)
@@ -109,7 +126,8 @@ func TestCreateVehicleCommandHandler_AlreadyExists(t *testing.T) {
 func TestCreateVehicleCommandHandler_InvalidStatus(t *testing.T) {
 	vehicleRepo := new(MockVehicleRepo)
 	outboxRepo := new(MockOutboxRepo)
-	h := NewCreateVehicleCommandHandler(vehicleRepo, outboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
 
 	cmd := &command.CreateVehicleCommand{
 		Status: "badstatus",
@@ -124,10 +142,11 @@ func TestCreateVehicleCommandHandler_InvalidStatus(t *testing.T) {
 func TestCreateVehicleCommandHandler_SaveError(t *testing.T) {
 	vehicleRepo := new(MockVehicleRepo)
 	outboxRepo := new(MockOutboxRepo)
-	h := NewCreateVehicleCommandHandler(vehicleRepo, outboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
 
 	cmd := &command.CreateVehicleCommand{
-		VIN:           "VIN123",
+		VIN:           "1HGBH41JXMN109186",
 		VehicleName:   "TestCar",
 		VehicleModel:  "ModelX",
 		LicenseNumber: "ABC123",
@@ -139,7 +158,7 @@ func TestCreateVehicleCommandHandler_SaveError(t *testing.T) {
 		FuelLevel:     80,
 	}
 
-	vehicleRepo.On("ExistsByVIN", mock.Anything, "VIN123").Return(false, nil)
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "1HGBH41JXMN109186").Return(false, nil)
 	vehicleRepo.On("Save", mock.Anything, mock.Anything).Return(errors.New("db error"))
 
 	err := h.Handle(context.Background(), cmd)
@@ -147,3 +166,99 @@ func TestCreateVehicleCommandHandler_SaveError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to save vehicle")
 	vehicleRepo.AssertExpectations(t)
 }
+
+func TestCreateVehicleCommandHandler_InvalidVINCheckDigit(t *testing.T) {
+	vehicleRepo := new(MockVehicleRepo)
+	outboxRepo := new(MockOutboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+
+	cmd := &command.CreateVehicleCommand{
+		VIN:           "2HGBH41JXMN109187",
+		VehicleName:   "TestCar",
+		VehicleModel:  "ModelX",
+		LicenseNumber: "ABC123",
+		Status:        "active",
+		Latitude:      1.23,
+		Longitude:     4.56,
+	}
+
+	err := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict).Handle(context.Background(), cmd)
+	assert.ErrorIs(t, err, valueobject.ErrInvalidVIN)
+	vehicleRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "2HGBH41JXMN109187").Return(false, nil)
+	vehicleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	err = NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationLenient).Handle(context.Background(), cmd)
+	assert.NoError(t, err)
+}
+
+func TestCreateVehicleCommandHandler_ValidatesAttributes(t *testing.T) {
+	vehicleRepo := new(MockVehicleRepo)
+	outboxRepo := new(MockOutboxRepo)
+	schema, _ := entity.NewAttributeSchema("default")
+	costCenter, _ := valueobject.NewAttributeDefinition("costCenter", valueobject.AttributeString, true, nil, "")
+	axles, _ := valueobject.NewAttributeDefinition("axles", valueobject.AttributeInteger, false, nil, "")
+	assert.NoError(t, schema.Define([]valueobject.AttributeDefinition{costCenter, axles}))
+	schemaRepo := &MockAttributeSchemaRepo{schema: schema}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
+
+	cmd := &command.CreateVehicleCommand{
+		VIN:           "1HGBH41JXMN109186",
+		VehicleName:   "TestCar",
+		VehicleModel:  "ModelX",
+		LicenseNumber: "ABC123",
+		Status:        "active",
+		Attributes:    map[string]interface{}{"axles": 2.5},
+	}
+
+	err := h.Handle(context.Background(), cmd)
+	assert.ErrorIs(t, err, entity.ErrInvalidAttributes)
+	assert.Contains(t, err.Error(), "axles must be integer")
+	assert.Contains(t, err.Error(), "costCenter is required")
+	vehicleRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "1HGBH41JXMN109186").Return(false, nil)
+	vehicleRepo.On("Save", mock.Anything, mock.MatchedBy(func(v *entity.Vehicle) bool {
+		return v.Attributes()["axles"] == int64(2) && v.Attributes()["costCenter"] == "CC-12"
+	})).Return(nil)
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	cmd.Attributes = map[string]interface{}{"axles": float64(2), "costCenter": "CC-12"}
+	assert.NoError(t, h.Handle(context.Background(), cmd))
+	vehicleRepo.AssertExpectations(t)
+}
+
+func TestCreateVehicleCommandHandler_StoresImperialMileageInKilometers(t *testing.T) {
+	vehicleRepo := new(MockVehicleRepo)
+	outboxRepo := new(MockOutboxRepo)
+	schemaRepo := &MockAttributeSchemaRepo{}
+	h := NewCreateVehicleCommandHandler(vehicleRepo, schemaRepo, outboxRepo, valueobject.VINValidationStrict)
+
+	cmd := &command.CreateVehicleCommand{
+		VIN:           "1HGBH41JXMN109186",
+		VehicleName:   "TestCar",
+		VehicleModel:  "ModelX",
+		LicenseNumber: "ABC123",
+		Status:        "active",
+		Mileage:       100,
+		Units:         "imperial",
+	}
+
+	vehicleRepo.On("ExistsByVIN", mock.Anything, "1HGBH41JXMN109186").Return(false, nil)
+	vehicleRepo.On("Save", mock.Anything, mock.MatchedBy(func(v *entity.Vehicle) bool {
+		return v.Mileage().Equals(mustMileage(t, 160.9344))
+	})).Return(nil)
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, h.Handle(context.Background(), cmd))
+	vehicleRepo.AssertExpectations(t)
+}
+
+func mustMileage(t *testing.T, km float64) valueobject.Mileage {
+	t.Helper()
+	mileage, err := valueobject.NewMileage(km)
+	assert.NoError(t, err)
+	return mileage
+}
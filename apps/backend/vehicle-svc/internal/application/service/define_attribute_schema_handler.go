@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DefineAttributeSchemaCommandHandler struct {
+	schemaRepo repository.AttributeSchemaRepository
+}
+
+func NewDefineAttributeSchemaCommandHandler(schemaRepo repository.AttributeSchemaRepository) *DefineAttributeSchemaCommandHandler {
+	return &DefineAttributeSchemaCommandHandler{schemaRepo: schemaRepo}
+}
+
+func (h *DefineAttributeSchemaCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	defineCmd, ok := cmd.(*command.DefineAttributeSchemaCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DefineAttributeSchemaCommandHandler")
+	}
+
+	definitions := make([]valueobject.AttributeDefinition, 0, len(defineCmd.Attributes))
+	for _, spec := range defineCmd.Attributes {
+		attrType, err := valueobject.NewAttributeType(spec.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %w", spec.Name, err)
+		}
+		def, err := valueobject.NewAttributeDefinition(spec.Name, attrType, spec.Required, spec.Enum, spec.Description)
+		if err != nil {
+			return err
+		}
+		definitions = append(definitions, def)
+	}
+
+	schema, err := h.schemaRepo.Find(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load attribute schema: %w", err)
+	}
+
+	if err := schema.Define(definitions); err != nil {
+		return err
+	}
+
+	if err := h.schemaRepo.Save(ctx, schema); err != nil {
+		return fmt.Errorf("failed to save attribute schema: %w", err)
+	}
+
+	return nil
+}
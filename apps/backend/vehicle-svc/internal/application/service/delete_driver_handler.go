@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DeleteDriverCommandHandler struct {
+	driverRepo repository.DriverRepository
+	outboxRepo repository.OutboxRepository
+}
+
+func NewDeleteDriverCommandHandler(
+	driverRepo repository.DriverRepository,
+	outboxRepo repository.OutboxRepository,
+) *DeleteDriverCommandHandler {
+	return &DeleteDriverCommandHandler{
+		driverRepo: driverRepo,
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (h *DeleteDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	deleteCmd, ok := cmd.(*command.DeleteDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DeleteDriverCommandHandler")
+	}
+
+	driverID, err := valueobject.NewDriverID(deleteCmd.DriverID)
+	if err != nil {
+		return fmt.Errorf("invalid driver id: %w", err)
+	}
+
+	driver, err := h.driverRepo.FindByID(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	// Release the vehicle first so tracking-svc stops attributing changes to this driver.
+	if driver.IsAssigned() {
+		if err := driver.Unassign(); err != nil {
+			return fmt.Errorf("failed to unassign driver: %w", err)
+		}
+		for _, event := range driver.UncommittedEvents() {
+			if err := h.outboxRepo.SaveOutboxEvent(ctx, driverID.String(), event); err != nil {
+				return fmt.Errorf("failed to save outbox event: %w", err)
+			}
+		}
+	}
+
+	if err := h.driverRepo.Delete(ctx, driverID); err != nil {
+		return fmt.Errorf("failed to delete driver: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DeleteMaintenancePlanCommandHandler struct {
+	planRepo repository.MaintenancePlanRepository
+	taskRepo repository.MaintenanceTaskRepository
+}
+
+func NewDeleteMaintenancePlanCommandHandler(
+	planRepo repository.MaintenancePlanRepository,
+	taskRepo repository.MaintenanceTaskRepository,
+) *DeleteMaintenancePlanCommandHandler {
+	return &DeleteMaintenancePlanCommandHandler{
+		planRepo: planRepo,
+		taskRepo: taskRepo,
+	}
+}
+
+func (h *DeleteMaintenancePlanCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	deleteCmd, ok := cmd.(*command.DeleteMaintenancePlanCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DeleteMaintenancePlanCommandHandler")
+	}
+
+	planID, err := valueobject.NewMaintenancePlanID(deleteCmd.PlanID)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance plan id: %w", err)
+	}
+
+	if err := h.planRepo.Delete(ctx, planID); err != nil {
+		return fmt.Errorf("failed to delete maintenance plan: %w", err)
+	}
+
+	// Completed tasks stay as service history; open ones would never be
+	// re-evaluated without their plan.
+	if err := h.taskRepo.DeleteOpenByPlan(ctx, planID); err != nil {
+		return fmt.Errorf("failed to delete open maintenance tasks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DeleteVehicleCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	driverRepo  repository.DriverRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewDeleteVehicleCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	driverRepo repository.DriverRepository,
+	outboxRepo repository.OutboxRepository,
+) *DeleteVehicleCommandHandler {
+	return &DeleteVehicleCommandHandler{
+		vehicleRepo: vehicleRepo,
+		driverRepo:  driverRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *DeleteVehicleCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	deleteCmd, ok := cmd.(*command.DeleteVehicleCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for DeleteVehicleCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(deleteCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(deleteCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if err := vehicle.Delete(deleteCmd.Reason, actor); err != nil {
+		return fmt.Errorf("failed to delete vehicle: %w", err)
+	}
+
+	// A deleted vehicle cannot keep a driver; the driver is released rather
+	// than blocking the delete, and is not reassigned on restore.
+	driver, err := h.driverRepo.FindByAssignedVehicle(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find assigned driver: %w", err)
+	}
+	if driver != nil {
+		if err := driver.Unassign(); err != nil {
+			return fmt.Errorf("failed to unassign driver: %w", err)
+		}
+		if err := h.driverRepo.Save(ctx, driver); err != nil {
+			return fmt.Errorf("failed to save driver: %w", err)
+		}
+		for _, event := range driver.UncommittedEvents() {
+			if err := h.outboxRepo.SaveOutboxEvent(ctx, driver.ID().String(), event); err != nil {
+				return fmt.Errorf("failed to save outbox event: %w", err)
+			}
+		}
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+)
+
+// EvaluateDueMaintenanceCommandHandler fans a date check out as one
+// EvaluateMaintenance command per vehicle, so time-based plans escalate and
+// move vehicles into maintenance exactly as a mileage update would.
+type EvaluateDueMaintenanceCommandHandler struct {
+	taskRepo   repository.MaintenanceTaskRepository
+	commandBus command.CommandBus
+}
+
+func NewEvaluateDueMaintenanceCommandHandler(
+	taskRepo repository.MaintenanceTaskRepository,
+	commandBus command.CommandBus,
+) *EvaluateDueMaintenanceCommandHandler {
+	return &EvaluateDueMaintenanceCommandHandler{
+		taskRepo:   taskRepo,
+		commandBus: commandBus,
+	}
+}
+
+func (h *EvaluateDueMaintenanceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	if _, ok := cmd.(*command.EvaluateDueMaintenanceCommand); !ok {
+		return fmt.Errorf("invalid command type for EvaluateDueMaintenanceCommandHandler")
+	}
+
+	tasks, err := h.taskRepo.FindDateDueInAnyTenant(ctx, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to find date-due maintenance tasks: %w", err)
+	}
+
+	// One vehicle failing does not hold up the others; the next run retries it.
+	evaluated := make(map[string]bool)
+	var failed []error
+	for _, task := range tasks {
+		vehicleID := task.VehicleID().String()
+		if evaluated[vehicleID] {
+			continue
+		}
+		evaluated[vehicleID] = true
+
+		// The check spans every tenant; each vehicle is evaluated within its own.
+		vehicleCtx := tenant.WithID(ctx, task.TenantID())
+		if err := h.commandBus.Dispatch(vehicleCtx, &command.EvaluateMaintenanceCommand{VehicleID: vehicleID}); err != nil {
+			failed = append(failed, fmt.Errorf("vehicle %s: %w", vehicleID, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to evaluate maintenance for %d of %d vehicles: %w", len(failed), len(evaluated), errors.Join(failed...))
+	}
+	return nil
+}
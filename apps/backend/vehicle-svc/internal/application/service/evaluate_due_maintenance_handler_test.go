@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// stubTaskRepo serves a fixed set of date-due tasks.
+type stubTaskRepo struct {
+	repository.MaintenanceTaskRepository
+	due []*entity.MaintenanceTask
+}
+
+func (r *stubTaskRepo) FindDateDueInAnyTenant(ctx context.Context, now time.Time) ([]*entity.MaintenanceTask, error) {
+	return r.due, nil
+}
+
+// tenantBus records the vehicle and tenant of each EvaluateMaintenance
+// command and fails the vehicles in fail.
+type tenantBus struct {
+	vehicles []string
+	tenants  []string
+	fail     map[string]error
+}
+
+func (b *tenantBus) Dispatch(ctx context.Context, cmd command.Command) error {
+	evaluateCmd := cmd.(*command.EvaluateMaintenanceCommand)
+	b.vehicles = append(b.vehicles, evaluateCmd.VehicleID)
+	b.tenants = append(b.tenants, tenant.ID(ctx))
+	return b.fail[evaluateCmd.VehicleID]
+}
+
+func (b *tenantBus) Register(commandName string, handler command.CommandHandler) {}
+
+func dueTask(tenantID string, vehicleID valueobject.VehicleID, dueAt time.Time) *entity.MaintenanceTask {
+	now := time.Now().UTC()
+	return entity.LoadMaintenanceTaskFromHistory(valueobject.GenerateMaintenanceTaskID(), tenantID,
+		valueobject.GenerateMaintenancePlanID(), "Oil change", vehicleID, valueobject.MaintenanceScheduled,
+		0, 0, &dueAt, nil, 0, nil, valueobject.Version{}, now, now)
+}
+
+func TestEvaluateDueMaintenance_EvaluatesEachVehicleInItsTenant(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	first := valueobject.GenerateVehicleID()
+	second := valueobject.GenerateVehicleID()
+	taskRepo := &stubTaskRepo{due: []*entity.MaintenanceTask{
+		dueTask("fleet-a", first, past),
+		dueTask("fleet-a", first, past),
+		dueTask("fleet-b", second, past),
+	}}
+	bus := &tenantBus{}
+
+	h := NewEvaluateDueMaintenanceCommandHandler(taskRepo, bus)
+	require.NoError(t, h.Handle(context.Background(), &command.EvaluateDueMaintenanceCommand{}))
+
+	assert.Equal(t, []string{first.String(), second.String()}, bus.vehicles)
+	assert.Equal(t, []string{"fleet-a", "fleet-b"}, bus.tenants)
+}
+
+func TestEvaluateDueMaintenance_ContinuesPastFailedVehicle(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	first := valueobject.GenerateVehicleID()
+	second := valueobject.GenerateVehicleID()
+	taskRepo := &stubTaskRepo{due: []*entity.MaintenanceTask{
+		dueTask("fleet-a", first, past),
+		dueTask("fleet-a", second, past),
+	}}
+	saveFailed := errors.New("write conflict")
+	bus := &tenantBus{fail: map[string]error{first.String(): saveFailed}}
+
+	h := NewEvaluateDueMaintenanceCommandHandler(taskRepo, bus)
+	err := h.Handle(context.Background(), &command.EvaluateDueMaintenanceCommand{})
+
+	assert.ErrorIs(t, err, saveFailed)
+	assert.Len(t, bus.vehicles, 2)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+const maintenanceSchedulerActor = "system:maintenance-scheduler"
+
+type EvaluateMaintenanceCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	planRepo    repository.MaintenancePlanRepository
+	taskRepo    repository.MaintenanceTaskRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewEvaluateMaintenanceCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	planRepo repository.MaintenancePlanRepository,
+	taskRepo repository.MaintenanceTaskRepository,
+	outboxRepo repository.OutboxRepository,
+) *EvaluateMaintenanceCommandHandler {
+	return &EvaluateMaintenanceCommandHandler{
+		vehicleRepo: vehicleRepo,
+		planRepo:    planRepo,
+		taskRepo:    taskRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *EvaluateMaintenanceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	evaluateCmd, ok := cmd.(*command.EvaluateMaintenanceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for EvaluateMaintenanceCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(evaluateCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+	if vehicle.Status().IsTerminal() {
+		return nil
+	}
+
+	plans, err := h.planRepo.FindByVehicleModel(ctx, vehicle.VehicleModel())
+	if err != nil {
+		return fmt.Errorf("failed to find maintenance plans: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var autoMaintenance bool
+	for _, plan := range plans {
+		task, err := h.taskRepo.FindOpenByVehicleAndPlan(ctx, vehicleID, plan.ID())
+		if err != nil {
+			return fmt.Errorf("failed to find maintenance task: %w", err)
+		}
+		scheduled := task == nil
+		if scheduled {
+			task, err = h.scheduleFirst(ctx, vehicle, plan)
+			if err != nil {
+				return err
+			}
+		}
+
+		escalated := task.Evaluate(vehicle.Mileage(), now)
+		if !scheduled && !escalated {
+			continue
+		}
+
+		if err := h.taskRepo.Save(ctx, task); err != nil {
+			return fmt.Errorf("failed to save maintenance task: %w", err)
+		}
+
+		for _, event := range task.UncommittedEvents() {
+			if err := h.outboxRepo.SaveOutboxEvent(ctx, task.ID().String(), event); err != nil {
+				return fmt.Errorf("failed to save outbox event: %w", err)
+			}
+		}
+
+		if escalated && plan.AutoMaintenance() {
+			autoMaintenance = true
+		}
+	}
+
+	if autoMaintenance && vehicle.Status().CanTransitionTo(valueobject.StatusMaintenance) {
+		actor, _ := valueobject.NewActor(maintenanceSchedulerActor)
+		if err := vehicle.ChangeStatus(valueobject.StatusMaintenance, valueobject.ReasonScheduledService, actor); err != nil {
+			return fmt.Errorf("failed to move vehicle to maintenance: %w", err)
+		}
+		if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+			return fmt.Errorf("failed to save vehicle: %w", err)
+		}
+		for _, event := range vehicle.UncommittedEvents() {
+			if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+				return fmt.Errorf("failed to save outbox event: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// scheduleFirst opens a task from the last completed service, or, for a
+// vehicle that was never serviced under this plan, from the last interval
+// boundary on the odometer and the later of plan and vehicle creation.
+func (h *EvaluateMaintenanceCommandHandler) scheduleFirst(
+	ctx context.Context,
+	vehicle *entity.Vehicle,
+	plan *entity.MaintenancePlan,
+) (*entity.MaintenanceTask, error) {
+	last, err := h.taskRepo.FindLastCompletedByVehicleAndPlan(ctx, vehicle.ID(), plan.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find last completed maintenance task: %w", err)
+	}
+	if last != nil && last.CompletedAt() != nil {
+		mileage, err := valueobject.NewMileage(last.CompletedMileage())
+		if err != nil {
+			return nil, fmt.Errorf("invalid completed mileage: %w", err)
+		}
+		return plan.ScheduleFor(vehicle.ID(), mileage, *last.CompletedAt()), nil
+	}
+
+	baseline := valueobject.Mileage{}
+	if km := plan.Interval().Kilometers(); km > 0 {
+		baseline, err = valueobject.NewMileage(math.Floor(vehicle.Mileage().Kilometers()/km) * km)
+		if err != nil {
+			return nil, fmt.Errorf("invalid baseline mileage: %w", err)
+		}
+	}
+
+	since := plan.CreatedAt()
+	if vehicle.CreatedAt().After(since) {
+		since = vehicle.CreatedAt()
+	}
+
+	return plan.ScheduleFor(vehicle.ID(), baseline, since), nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+)
+
+type GetAllDevicesQueryHandler struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewGetAllDevicesQueryHandler(deviceRepo repository.DeviceRepository) *GetAllDevicesQueryHandler {
+	return &GetAllDevicesQueryHandler{deviceRepo: deviceRepo}
+}
+
+func (h *GetAllDevicesQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	allQuery, ok := q.(*query.GetAllDevicesQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetAllDevicesQueryHandler")
+	}
+
+	devices, err := h.deviceRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find devices: %w", err)
+	}
+
+	var responses []*dto.DeviceResponse
+	for _, device := range devices {
+		responses = append(responses, toDeviceResponse(device))
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+)
+
+type GetAllDriversQueryHandler struct {
+	driverRepo repository.DriverRepository
+}
+
+func NewGetAllDriversQueryHandler(driverRepo repository.DriverRepository) *GetAllDriversQueryHandler {
+	return &GetAllDriversQueryHandler{driverRepo: driverRepo}
+}
+
+func (h *GetAllDriversQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	allQuery, ok := q.(*query.GetAllDriversQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetAllDriversQueryHandler")
+	}
+
+	drivers, err := h.driverRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find drivers: %w", err)
+	}
+
+	var responses []*dto.DriverResponse
+	for _, driver := range drivers {
+		responses = append(responses, toDriverResponse(driver))
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+)
+
+type GetAllMaintenancePlansQueryHandler struct {
+	planRepo repository.MaintenancePlanRepository
+}
+
+func NewGetAllMaintenancePlansQueryHandler(planRepo repository.MaintenancePlanRepository) *GetAllMaintenancePlansQueryHandler {
+	return &GetAllMaintenancePlansQueryHandler{planRepo: planRepo}
+}
+
+func (h *GetAllMaintenancePlansQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	allQuery, ok := q.(*query.GetAllMaintenancePlansQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetAllMaintenancePlansQueryHandler")
+	}
+
+	var plans []*entity.MaintenancePlan
+	var err error
+	if allQuery.VehicleModel != "" {
+		plans, err = h.planRepo.FindByVehicleModel(ctx, allQuery.VehicleModel)
+	} else {
+		plans, err = h.planRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance plans: %w", err)
+	}
+
+	var responses []*dto.MaintenancePlanResponse
+	for _, plan := range plans {
+		responses = append(responses, toMaintenancePlanResponse(plan))
+	}
+
+	return responses, nil
+}
@@ -6,15 +6,21 @@ import (
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
 type GetAllVehiclesQueryHandler struct {
 	vehicleRepo repository.VehicleRepository
+	schemaRepo  repository.AttributeSchemaRepository
 }
 
-func NewGetAllVehiclesQueryHandler(vehicleRepo repository.VehicleRepository) *GetAllVehiclesQueryHandler {
-	return &GetAllVehiclesQueryHandler{vehicleRepo: vehicleRepo}
+func NewGetAllVehiclesQueryHandler(
+	vehicleRepo repository.VehicleRepository,
+	schemaRepo repository.AttributeSchemaRepository,
+) *GetAllVehiclesQueryHandler {
+	return &GetAllVehiclesQueryHandler{vehicleRepo: vehicleRepo, schemaRepo: schemaRepo}
 }
 
 func (h *GetAllVehiclesQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
@@ -23,29 +29,50 @@ func (h *GetAllVehiclesQueryHandler) Handle(ctx context.Context, q query.Query)
 		return nil, fmt.Errorf("invalid query type for GetAllVehiclesQueryHandler")
 	}
 
-	vehicles, err := h.vehicleRepo.FindAll(ctx, allQuery.Limit, allQuery.Offset)
+	units, err := valueobject.NewUnitSystem(allQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.VehicleFilter{
+		Deleted:      allQuery.Deleted,
+		Manufacturer: allQuery.Make,
+		ModelYear:    allQuery.ModelYear,
+		Group:        allQuery.Group,
+	}
+	if allQuery.Tag != "" {
+		tag, err := valueobject.NewTag(allQuery.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag filter: %w", err)
+		}
+		filter.Tag = tag.String()
+	}
+	if len(allQuery.Attributes) > 0 {
+		schema, err := h.schemaRepo.Find(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load attribute schema: %w", err)
+		}
+		filter.Attributes = make(map[string]interface{}, len(allQuery.Attributes))
+		for name, raw := range allQuery.Attributes {
+			def, ok := schema.Definition(name)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s is not defined for this fleet", entity.ErrInvalidAttributes, name)
+			}
+			value, err := def.ParseFilter(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", entity.ErrInvalidAttributes, err)
+			}
+			filter.Attributes[name] = value
+		}
+	}
+	vehicles, err := h.vehicleRepo.FindAll(ctx, filter, allQuery.Limit, allQuery.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find vehicles: %w", err)
 	}
 
 	var responses []*dto.VehicleResponse
 	for _, vehicle := range vehicles {
-		responses = append(responses, &dto.VehicleResponse{
-			ID:            vehicle.ID().String(),
-			VIN:           vehicle.VIN(),
-			VehicleName:   vehicle.VehicleName(),
-			VehicleModel:  vehicle.VehicleModel(),
-			LicenseNumber: vehicle.LicenseNumber().String(),
-			Status:        string(vehicle.Status()),
-			Latitude:      vehicle.CurrentLocation().Latitude(),
-			Longitude:     vehicle.CurrentLocation().Longitude(),
-			Altitude:      vehicle.CurrentLocation().Altitude(),
-			Mileage:       vehicle.Mileage().Kilometers(),
-			FuelLevel:     vehicle.FuelLevel().Percentage(),
-			Version:       vehicle.Version().Value(),
-			CreatedAt:     vehicle.CreatedAt(),
-			UpdatedAt:     vehicle.UpdatedAt(),
-		})
+		responses = append(responses, toVehicleResponse(vehicle, units))
 	}
 
 	return responses, nil
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+)
+
+type GetAttributeSchemaQueryHandler struct {
+	schemaRepo repository.AttributeSchemaRepository
+}
+
+func NewGetAttributeSchemaQueryHandler(schemaRepo repository.AttributeSchemaRepository) *GetAttributeSchemaQueryHandler {
+	return &GetAttributeSchemaQueryHandler{schemaRepo: schemaRepo}
+}
+
+func (h *GetAttributeSchemaQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	if _, ok := q.(*query.GetAttributeSchemaQuery); !ok {
+		return nil, fmt.Errorf("invalid query type for GetAttributeSchemaQueryHandler")
+	}
+
+	schema, err := h.schemaRepo.Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attribute schema: %w", err)
+	}
+
+	response := &dto.AttributeSchemaResponse{
+		TenantID:   schema.TenantID(),
+		Attributes: []dto.AttributeDefinitionDTO{},
+		Version:    schema.Version().Value(),
+	}
+	if !schema.UpdatedAt().IsZero() {
+		updatedAt := schema.UpdatedAt()
+		response.UpdatedAt = &updatedAt
+	}
+	for _, def := range schema.Definitions() {
+		response.Attributes = append(response.Attributes, dto.AttributeDefinitionDTO{
+			Name:        def.Name(),
+			Type:        string(def.Type()),
+			Required:    def.Required(),
+			Enum:        def.Enum(),
+			Description: def.Description(),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type GetDeviceQueryHandler struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewGetDeviceQueryHandler(deviceRepo repository.DeviceRepository) *GetDeviceQueryHandler {
+	return &GetDeviceQueryHandler{deviceRepo: deviceRepo}
+}
+
+func (h *GetDeviceQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetDeviceQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetDeviceQueryHandler")
+	}
+
+	deviceID, err := valueobject.NewDeviceID(getQuery.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid device id: %w", err)
+	}
+
+	device, err := h.deviceRepo.FindByID(ctx, deviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find device: %w", err)
+	}
+
+	return toDeviceResponse(device), nil
+}
+
+func toDeviceResponse(device *entity.Device) *dto.DeviceResponse {
+	response := &dto.DeviceResponse{
+		ID:              device.ID().String(),
+		HardwareID:      device.HardwareID().String(),
+		Protocol:        device.Protocol().String(),
+		Firmware:        device.Firmware(),
+		SIM:             device.SIM(),
+		PairedVehicleID: device.PairedVehicleID(),
+		Pairings:        []dto.DevicePairingResponse{},
+		Version:         device.Version().Value(),
+		CreatedAt:       device.CreatedAt(),
+		UpdatedAt:       device.UpdatedAt(),
+	}
+	for _, p := range device.Pairings() {
+		response.Pairings = append(response.Pairings, dto.DevicePairingResponse{
+			VehicleID:  p.VehicleID,
+			PairedAt:   p.PairedAt,
+			UnpairedAt: p.UnpairedAt,
+		})
+	}
+	return response
+}
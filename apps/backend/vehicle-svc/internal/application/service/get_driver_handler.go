@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type GetDriverQueryHandler struct {
+	driverRepo repository.DriverRepository
+}
+
+func NewGetDriverQueryHandler(driverRepo repository.DriverRepository) *GetDriverQueryHandler {
+	return &GetDriverQueryHandler{driverRepo: driverRepo}
+}
+
+func (h *GetDriverQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetDriverQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetDriverQueryHandler")
+	}
+
+	driverID, err := valueobject.NewDriverID(getQuery.DriverID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid driver id: %w", err)
+	}
+
+	driver, err := h.driverRepo.FindByID(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	return toDriverResponse(driver), nil
+}
+
+func toDriverResponse(driver *entity.Driver) *dto.DriverResponse {
+	return &dto.DriverResponse{
+		ID:                driver.ID().String(),
+		Name:              driver.Name(),
+		LicenseNumber:     driver.LicenseNumber().String(),
+		Phone:             driver.Phone(),
+		AssignedVehicleID: driver.AssignedVehicleID(),
+		AssignedAt:        driver.AssignedAt(),
+		Version:           driver.Version().Value(),
+		CreatedAt:         driver.CreatedAt(),
+		UpdatedAt:         driver.UpdatedAt(),
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type GetMaintenancePlanQueryHandler struct {
+	planRepo repository.MaintenancePlanRepository
+}
+
+func NewGetMaintenancePlanQueryHandler(planRepo repository.MaintenancePlanRepository) *GetMaintenancePlanQueryHandler {
+	return &GetMaintenancePlanQueryHandler{planRepo: planRepo}
+}
+
+func (h *GetMaintenancePlanQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	getQuery, ok := q.(*query.GetMaintenancePlanQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetMaintenancePlanQueryHandler")
+	}
+
+	planID, err := valueobject.NewMaintenancePlanID(getQuery.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance plan id: %w", err)
+	}
+
+	plan, err := h.planRepo.FindByID(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance plan: %w", err)
+	}
+
+	return toMaintenancePlanResponse(plan), nil
+}
+
+func toMaintenancePlanResponse(plan *entity.MaintenancePlan) *dto.MaintenancePlanResponse {
+	return &dto.MaintenancePlanResponse{
+		ID:              plan.ID().String(),
+		VehicleModel:    plan.VehicleModel(),
+		Name:            plan.Name(),
+		IntervalKm:      plan.Interval().Kilometers(),
+		IntervalMonths:  plan.Interval().Months(),
+		AutoMaintenance: plan.AutoMaintenance(),
+		Version:         plan.Version().Value(),
+		CreatedAt:       plan.CreatedAt(),
+		UpdatedAt:       plan.UpdatedAt(),
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type GetUpcomingMaintenanceQueryHandler struct {
+	taskRepo    repository.MaintenanceTaskRepository
+	vehicleRepo repository.VehicleRepository
+}
+
+func NewGetUpcomingMaintenanceQueryHandler(
+	taskRepo repository.MaintenanceTaskRepository,
+	vehicleRepo repository.VehicleRepository,
+) *GetUpcomingMaintenanceQueryHandler {
+	return &GetUpcomingMaintenanceQueryHandler{
+		taskRepo:    taskRepo,
+		vehicleRepo: vehicleRepo,
+	}
+}
+
+func (h *GetUpcomingMaintenanceQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	upcomingQuery, ok := q.(*query.GetUpcomingMaintenanceQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetUpcomingMaintenanceQueryHandler")
+	}
+
+	tasks, err := h.taskRepo.FindOpen(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance tasks: %w", err)
+	}
+
+	now := time.Now().UTC()
+	vehicles := make(map[string]*entity.Vehicle)
+	responses := make([]*dto.MaintenanceTaskResponse, 0)
+	for _, task := range tasks {
+		if upcomingQuery.Status != "" && string(task.Status()) != upcomingQuery.Status {
+			continue
+		}
+		if upcomingQuery.VehicleID != "" && task.VehicleID().String() != upcomingQuery.VehicleID {
+			continue
+		}
+
+		vehicle, ok := vehicles[task.VehicleID().String()]
+		if !ok {
+			vehicle, err = h.vehicleRepo.FindByID(ctx, task.VehicleID())
+			if err != nil {
+				// Vehicle was removed; its tasks are not actionable.
+				continue
+			}
+			vehicles[task.VehicleID().String()] = vehicle
+		}
+
+		response := toMaintenanceTaskResponse(task, vehicle)
+		if !withinHorizon(task, response.RemainingKm, now, upcomingQuery) {
+			continue
+		}
+		responses = append(responses, response)
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		return lessUrgent(responses[j], responses[i])
+	})
+
+	total := len(responses)
+	start := min(upcomingQuery.Offset, total)
+	end := total
+	if upcomingQuery.Limit > 0 {
+		end = min(start+upcomingQuery.Limit, total)
+	}
+
+	return &dto.UpcomingMaintenanceResponse{
+		Tasks: responses[start:end],
+		Total: total,
+	}, nil
+}
+
+func withinHorizon(task *entity.MaintenanceTask, remainingKm *float64, now time.Time, q *query.GetUpcomingMaintenanceQuery) bool {
+	if task.Status() != valueobject.MaintenanceScheduled {
+		return true
+	}
+	if q.WithinKm <= 0 && q.WithinDays <= 0 {
+		return true
+	}
+	if q.WithinKm > 0 && remainingKm != nil && *remainingKm <= q.WithinKm {
+		return true
+	}
+	if q.WithinDays > 0 && task.DueAt() != nil && task.DueAt().Before(now.AddDate(0, 0, q.WithinDays)) {
+		return true
+	}
+	return false
+}
+
+// lessUrgent orders overdue before due before scheduled, then by whichever
+// bound comes first.
+func lessUrgent(a, b *dto.MaintenanceTaskResponse) bool {
+	rank := map[string]int{
+		string(valueobject.MaintenanceOverdue):   2,
+		string(valueobject.MaintenanceDue):       1,
+		string(valueobject.MaintenanceScheduled): 0,
+	}
+	if rank[a.Status] != rank[b.Status] {
+		return rank[a.Status] < rank[b.Status]
+	}
+	if a.RemainingKm != nil && b.RemainingKm != nil && *a.RemainingKm != *b.RemainingKm {
+		return *a.RemainingKm > *b.RemainingKm
+	}
+	if a.DueAt != nil && b.DueAt != nil {
+		return a.DueAt.After(*b.DueAt)
+	}
+	return false
+}
+
+func toMaintenanceTaskResponse(task *entity.MaintenanceTask, vehicle *entity.Vehicle) *dto.MaintenanceTaskResponse {
+	response := &dto.MaintenanceTaskResponse{
+		ID:             task.ID().String(),
+		PlanID:         task.PlanID().String(),
+		PlanName:       task.PlanName(),
+		VehicleID:      task.VehicleID().String(),
+		VehicleName:    vehicle.VehicleName(),
+		VehicleModel:   vehicle.VehicleModel(),
+		Status:         string(task.Status()),
+		DueMileage:     task.DueMileage(),
+		DueAt:          task.DueAt(),
+		CurrentMileage: vehicle.Mileage().Kilometers(),
+		Version:        task.Version().Value(),
+		CreatedAt:      task.CreatedAt(),
+		UpdatedAt:      task.UpdatedAt(),
+	}
+	if task.DueMileage() > 0 {
+		remaining := task.DueMileage() - vehicle.Mileage().Kilometers()
+		response.RemainingKm = &remaining
+	}
+	return response
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type GetVehicleCorrectionsQueryHandler struct {
+	correctionRepo repository.TelemetryCorrectionRepository
+}
+
+func NewGetVehicleCorrectionsQueryHandler(correctionRepo repository.TelemetryCorrectionRepository) *GetVehicleCorrectionsQueryHandler {
+	return &GetVehicleCorrectionsQueryHandler{correctionRepo: correctionRepo}
+}
+
+func (h *GetVehicleCorrectionsQueryHandler) Handle(ctx context.Context, q query.Query) (query.QueryResult, error) {
+	correctionsQuery, ok := q.(*query.GetVehicleCorrectionsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid query type for GetVehicleCorrectionsQueryHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(correctionsQuery.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	units, err := valueobject.NewUnitSystem(correctionsQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	corrections, err := h.correctionRepo.FindByVehicleID(ctx, vehicleID, correctionsQuery.Limit, correctionsQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find telemetry corrections: %w", err)
+	}
+
+	responses := make([]*dto.TelemetryCorrectionResponse, 0, len(corrections))
+	for _, correction := range corrections {
+		response := &dto.TelemetryCorrectionResponse{
+			ID:        correction.ID().String(),
+			VehicleID: correction.VehicleID().String(),
+			Field:     string(correction.Field()),
+			OldValue:  correction.OldValue(),
+			NewValue:  correction.NewValue(),
+			Reason:    correction.Reason(),
+			Actor:     correction.Actor().String(),
+			AppliedAt: correction.AppliedAt(),
+		}
+		if correction.Field() == valueobject.CorrectionMileage {
+			response.OldValue = units.FromKilometers(response.OldValue)
+			response.NewValue = units.FromKilometers(response.NewValue)
+			response.Unit = units.DistanceUnit()
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
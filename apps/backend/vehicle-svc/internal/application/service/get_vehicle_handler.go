@@ -6,6 +6,7 @@ import (
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/dto"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
@@ -34,20 +35,55 @@ func (h *GetVehicleQueryHandler) Handle(ctx context.Context, q query.Query) (que
 		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
+	units, err := valueobject.NewUnitSystem(getQuery.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	return toVehicleResponse(vehicle, units), nil
+}
+
+func toVehicleResponse(vehicle *entity.Vehicle, units valueobject.UnitSystem) *dto.VehicleResponse {
+	var estimatedRange *float64
+	if r := vehicle.EstimatedRange(); r != nil {
+		distance := r.In(units)
+		estimatedRange = &distance
+	}
+	energy := vehicle.EnergySource()
+
 	return &dto.VehicleResponse{
-		ID:            vehicle.ID().String(),
-		VIN:           vehicle.VIN(),
-		VehicleName:   vehicle.VehicleName(),
-		VehicleModel:  vehicle.VehicleModel(),
-		LicenseNumber: vehicle.LicenseNumber().String(),
-		Status:        string(vehicle.Status()),
-		Latitude:      vehicle.CurrentLocation().Latitude(),
-		Longitude:     vehicle.CurrentLocation().Longitude(),
-		Altitude:      vehicle.CurrentLocation().Altitude(),
-		Mileage:       vehicle.Mileage().Kilometers(),
-		FuelLevel:     vehicle.FuelLevel().Percentage(),
-		Version:       vehicle.Version().Value(),
-		CreatedAt:     vehicle.CreatedAt(),
-		UpdatedAt:     vehicle.UpdatedAt(),
-	}, nil
+		ID:             vehicle.ID().String(),
+		VIN:            vehicle.VIN().String(),
+		Manufacturer:   vehicle.VIN().Manufacturer(),
+		ModelYear:      vehicle.VIN().ModelYear(),
+		PlantCode:      vehicle.VIN().PlantCode(),
+		VehicleName:    vehicle.VehicleName(),
+		VehicleModel:   vehicle.VehicleModel(),
+		LicenseNumber:  vehicle.LicenseNumber().String(),
+		Status:         string(vehicle.Status()),
+		Latitude:       vehicle.CurrentLocation().Latitude(),
+		Longitude:      vehicle.CurrentLocation().Longitude(),
+		Altitude:       vehicle.CurrentLocation().Altitude(),
+		LocationAt:     vehicle.CurrentLocation().Timestamp(),
+		SpeedKmh:       vehicle.CurrentLocation().SpeedKmh(),
+		Heading:        vehicle.CurrentLocation().Heading(),
+		Satellites:     vehicle.CurrentLocation().Satellites(),
+		HDOP:           vehicle.CurrentLocation().HDOP(),
+		Mileage:        vehicle.Mileage().In(units),
+		MileageUnit:    units.DistanceUnit(),
+		FuelLevel:      vehicle.FuelLevel().Percentage(),
+		EnergyType:     string(energy.Type()),
+		EnergyCapacity: energy.CapacityIn(units),
+		CapacityUnit:   energy.CapacityUnitIn(units),
+		ChargingState:  string(vehicle.ChargingState()),
+		EstimatedRange: estimatedRange,
+		Version:        vehicle.Version().Value(),
+		CreatedAt:      vehicle.CreatedAt(),
+		UpdatedAt:      vehicle.UpdatedAt(),
+		DeletedAt:      vehicle.DeletedAt(),
+		DeletionReason: vehicle.DeletionReason(),
+		Group:          vehicle.Group(),
+		Tags:           vehicle.Tags(),
+		Attributes:     vehicle.Attributes(),
+	}
 }
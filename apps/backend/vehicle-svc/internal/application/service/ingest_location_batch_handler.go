@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// IngestLocationBatchCommandHandler applies a telemetry upload vehicle by
+// vehicle. A vehicle that cannot be loaded or saved rejects its own points
+// without affecting the others.
+type IngestLocationBatchCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	deviceRepo  repository.DeviceRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewIngestLocationBatchCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	deviceRepo repository.DeviceRepository,
+	outboxRepo repository.OutboxRepository,
+) *IngestLocationBatchCommandHandler {
+	return &IngestLocationBatchCommandHandler{
+		vehicleRepo: vehicleRepo,
+		deviceRepo:  deviceRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+type indexedLocation struct {
+	index    int
+	location valueobject.Location
+}
+
+func (h *IngestLocationBatchCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	batchCmd, ok := cmd.(*command.IngestLocationBatchCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for IngestLocationBatchCommandHandler")
+	}
+	if len(batchCmd.Points) == 0 {
+		return command.ErrEmptyLocationBatch
+	}
+	if len(batchCmd.Points) > command.MaxLocationBatchPoints {
+		return command.ErrLocationBatchTooLarge
+	}
+
+	result := command.LocationBatchReport{Rejected: map[int]error{}}
+
+	tracks := map[valueobject.VehicleID][]indexedLocation{}
+	devices := map[string]*entity.Device{}
+	for i, point := range batchCmd.Points {
+		if point.Timestamp <= 0 {
+			result.Rejected[i] = errors.New("device timestamp is required")
+			continue
+		}
+		vehicleID, err := h.resolveVehicle(ctx, point, devices)
+		if err != nil {
+			result.Rejected[i] = err
+			continue
+		}
+		location, err := newReportedLocation(point.Latitude, point.Longitude, point.Altitude, point.Timestamp,
+			point.SpeedKmh, point.Heading, point.Satellites, point.HDOP)
+		if err != nil {
+			result.Rejected[i] = err
+			continue
+		}
+		tracks[vehicleID] = append(tracks[vehicleID], indexedLocation{index: i, location: location})
+	}
+
+	vehicleIDs := make([]valueobject.VehicleID, 0, len(tracks))
+	for id := range tracks {
+		vehicleIDs = append(vehicleIDs, id)
+	}
+	sort.Slice(vehicleIDs, func(a, b int) bool {
+		return vehicleIDs[a].String() < vehicleIDs[b].String()
+	})
+
+	for _, id := range vehicleIDs {
+		points := tracks[id]
+		sort.SliceStable(points, func(a, b int) bool {
+			return points[a].location.Timestamp() < points[b].location.Timestamp()
+		})
+
+		var track []valueobject.Location
+		var applied []int
+		for i, p := range points {
+			if i > 0 && p.location.Timestamp() == points[i-1].location.Timestamp() {
+				result.Duplicates = append(result.Duplicates, p.index)
+				continue
+			}
+			track = append(track, p.location)
+			applied = append(applied, p.index)
+		}
+
+		outcomes, err := h.applyTrack(ctx, id, track)
+		if err != nil {
+			for _, index := range applied {
+				result.Rejected[index] = err
+			}
+			continue
+		}
+		for i, outcome := range outcomes {
+			if outcome == entity.LocationArchived {
+				result.Archived = append(result.Archived, applied[i])
+			}
+		}
+		result.Accepted += len(applied)
+	}
+
+	sort.Ints(result.Archived)
+	sort.Ints(result.Duplicates)
+	batchCmd.Report = result
+	return nil
+}
+
+// resolveVehicle returns the vehicle a point belongs to. Devices are looked up
+// once per batch and cached in devices, unknown ones as nil.
+func (h *IngestLocationBatchCommandHandler) resolveVehicle(ctx context.Context, point command.LocationPoint, devices map[string]*entity.Device) (valueobject.VehicleID, error) {
+	if point.DeviceID == "" {
+		vehicleID, err := valueobject.NewVehicleID(point.VehicleID)
+		if err != nil {
+			return valueobject.VehicleID{}, fmt.Errorf("invalid vehicle id: %w", err)
+		}
+		return vehicleID, nil
+	}
+	if point.VehicleID != "" {
+		return valueobject.VehicleID{}, errors.New("point must name either a vehicle or a device, not both")
+	}
+
+	hardwareID, err := valueobject.NewHardwareID(point.DeviceID)
+	if err != nil {
+		return valueobject.VehicleID{}, err
+	}
+	device, cached := devices[hardwareID.String()]
+	if !cached {
+		device, err = h.deviceRepo.FindByHardwareID(ctx, hardwareID)
+		if err != nil {
+			return valueobject.VehicleID{}, fmt.Errorf("failed to find device: %w", err)
+		}
+		devices[hardwareID.String()] = device
+	}
+	if device == nil {
+		return valueobject.VehicleID{}, fmt.Errorf("device %s is not registered", hardwareID.String())
+	}
+
+	paired, ok := device.VehicleAt(time.Unix(point.Timestamp, 0))
+	if !ok {
+		return valueobject.VehicleID{}, fmt.Errorf("%w at %d", entity.ErrDeviceNotPaired, point.Timestamp)
+	}
+	return valueobject.NewVehicleID(paired)
+}
+
+func (h *IngestLocationBatchCommandHandler) applyTrack(ctx context.Context, vehicleID valueobject.VehicleID, track []valueobject.Location) ([]entity.LocationOutcome, error) {
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	outcomes, changed, err := vehicle.ApplyLocationTrack(track)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update location: %w", err)
+	}
+
+	if changed {
+		if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+			return nil, fmt.Errorf("failed to save vehicle: %w", err)
+		}
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return nil, fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return outcomes, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// stubVehicleRepo serves fixed vehicles by id and records saves.
+type stubVehicleRepo struct {
+	MockVehicleRepo
+	vehicles map[string]*entity.Vehicle
+}
+
+func (r *stubVehicleRepo) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
+	if v, ok := r.vehicles[id.String()]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("vehicle not found: %s", id)
+}
+
+func TestIngestLocationBatch_SortsDedupesAndReportsPerPoint(t *testing.T) {
+	vin, _ := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	license, _ := valueobject.NewLicenseNumber("ABC-123")
+	location, _ := valueobject.NewLocation(10, 20, 0, 0)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), "default", vin, "Truck", "Model", license,
+		valueobject.StatusActive, location, mustMileage(t, 0), valueobject.FuelLevel{}, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	vehicle.UncommittedEvents()
+	id := vehicle.ID().String()
+	unknown := valueobject.GenerateVehicleID().String()
+
+	vehicleRepo := &stubVehicleRepo{vehicles: map[string]*entity.Vehicle{id: vehicle}}
+	vehicleRepo.On("Save", mock.Anything, vehicle).Return(nil).Once()
+	outboxRepo := new(MockOutboxRepo)
+	var published []*event.VehicleLocationUpdatedEvent
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, id, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = append(published, args.Get(2).(*event.VehicleLocationUpdatedEvent))
+		}).Return(nil)
+
+	h := NewIngestLocationBatchCommandHandler(vehicleRepo, nil, outboxRepo)
+	batchCmd := &command.IngestLocationBatchCommand{Points: []command.LocationPoint{
+		{VehicleID: id, Latitude: 10.2, Longitude: 20.2, Timestamp: 300},
+		{VehicleID: id, Latitude: 10.1, Longitude: 20.1, Timestamp: 200},
+		{VehicleID: id, Latitude: 10.1, Longitude: 20.1, Timestamp: 200},
+		{VehicleID: id, Latitude: 91, Longitude: 20, Timestamp: 400},
+		{VehicleID: unknown, Latitude: 1, Longitude: 1, Timestamp: 100},
+	}}
+	require.NoError(t, h.Handle(context.Background(), batchCmd))
+
+	report := batchCmd.Report
+	assert.Equal(t, 2, report.Accepted)
+	assert.Equal(t, []int{2}, report.Duplicates)
+	assert.Len(t, report.Rejected, 2)
+	assert.Contains(t, report.Rejected, 3)
+	assert.Contains(t, report.Rejected, 4)
+
+	require.Len(t, published, 2)
+	assert.Equal(t, int64(200), published[0].Timestamp)
+	assert.Equal(t, int64(300), published[1].Timestamp)
+	assert.Equal(t, published[0].Version, published[1].Version)
+	assert.Equal(t, 10.2, vehicle.CurrentLocation().Latitude())
+	vehicleRepo.AssertExpectations(t)
+}
+
+func TestIngestLocationBatch_ArchivesPointsOlderThanCurrent(t *testing.T) {
+	vin, _ := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	license, _ := valueobject.NewLicenseNumber("ABC-123")
+	location, _ := valueobject.NewLocation(10, 20, 0, 1000)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), "default", vin, "Truck", "Model", license,
+		valueobject.StatusActive, location, mustMileage(t, 0), valueobject.FuelLevel{}, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	vehicle.UncommittedEvents()
+	id := vehicle.ID().String()
+
+	vehicleRepo := &stubVehicleRepo{vehicles: map[string]*entity.Vehicle{id: vehicle}}
+	vehicleRepo.On("Save", mock.Anything, vehicle).Return(nil).Once()
+	outboxRepo := new(MockOutboxRepo)
+	var published []interface{}
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, id, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = append(published, args.Get(2))
+		}).Return(nil)
+
+	h := NewIngestLocationBatchCommandHandler(vehicleRepo, nil, outboxRepo)
+	batchCmd := &command.IngestLocationBatchCommand{Points: []command.LocationPoint{
+		{VehicleID: id, Latitude: 10.2, Longitude: 20.2, Timestamp: 1500},
+		{VehicleID: id, Latitude: 10.1, Longitude: 20.1, Timestamp: 500},
+	}}
+	require.NoError(t, h.Handle(context.Background(), batchCmd))
+
+	report := batchCmd.Report
+	assert.Equal(t, 2, report.Accepted)
+	assert.Equal(t, []int{1}, report.Archived)
+	assert.Empty(t, report.Rejected)
+
+	require.Len(t, published, 2)
+	assert.IsType(t, &event.VehicleLocationArchivedEvent{}, published[0])
+	assert.IsType(t, &event.VehicleLocationUpdatedEvent{}, published[1])
+	assert.Equal(t, int64(1500), vehicle.CurrentLocation().Timestamp())
+	vehicleRepo.AssertExpectations(t)
+}
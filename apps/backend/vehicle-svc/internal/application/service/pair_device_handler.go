@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type PairDeviceCommandHandler struct {
+	deviceRepo  repository.DeviceRepository
+	vehicleRepo repository.VehicleRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewPairDeviceCommandHandler(
+	deviceRepo repository.DeviceRepository,
+	vehicleRepo repository.VehicleRepository,
+	outboxRepo repository.OutboxRepository,
+) *PairDeviceCommandHandler {
+	return &PairDeviceCommandHandler{
+		deviceRepo:  deviceRepo,
+		vehicleRepo: vehicleRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *PairDeviceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	pairCmd, ok := cmd.(*command.PairDeviceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for PairDeviceCommandHandler")
+	}
+
+	deviceID, err := valueobject.NewDeviceID(pairCmd.DeviceID)
+	if err != nil {
+		return fmt.Errorf("invalid device id: %w", err)
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(pairCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	device, err := h.deviceRepo.FindByID(ctx, deviceID)
+	if err != nil {
+		return fmt.Errorf("failed to find device: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+	if vehicle.IsDeleted() {
+		return fmt.Errorf("cannot pair device: %w", entity.ErrVehicleDeleted)
+	}
+	if vehicle.Status() == valueobject.StatusRetired {
+		return fmt.Errorf("cannot pair device: %w", entity.ErrVehicleRetired)
+	}
+
+	if err := device.PairWith(vehicleID); err != nil {
+		return fmt.Errorf("failed to pair device: %w", err)
+	}
+
+	if err := h.deviceRepo.Save(ctx, device); err != nil {
+		return fmt.Errorf("failed to save device: %w", err)
+	}
+
+	for _, event := range device.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, deviceID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type RegisterDeviceCommandHandler struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewRegisterDeviceCommandHandler(deviceRepo repository.DeviceRepository) *RegisterDeviceCommandHandler {
+	return &RegisterDeviceCommandHandler{deviceRepo: deviceRepo}
+}
+
+func (h *RegisterDeviceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	registerCmd, ok := cmd.(*command.RegisterDeviceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for RegisterDeviceCommandHandler")
+	}
+
+	deviceID := valueobject.GenerateDeviceID()
+	if registerCmd.DeviceID != "" {
+		id, err := valueobject.NewDeviceID(registerCmd.DeviceID)
+		if err != nil {
+			return fmt.Errorf("invalid device id: %w", err)
+		}
+		deviceID = id
+	}
+
+	hardwareID, err := valueobject.NewHardwareID(registerCmd.HardwareID)
+	if err != nil {
+		return err
+	}
+	protocol, err := valueobject.NewDeviceProtocol(registerCmd.Protocol)
+	if err != nil {
+		return err
+	}
+
+	existing, err := h.deviceRepo.FindByHardwareIDInAnyTenant(ctx, hardwareID)
+	if err != nil {
+		return fmt.Errorf("failed to check hardware id: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("%w: %s", entity.ErrDeviceAlreadyRegistered, hardwareID.String())
+	}
+
+	device, err := entity.NewDevice(deviceID, tenant.ID(ctx), hardwareID, protocol, registerCmd.Firmware, registerCmd.SIM)
+	if err != nil {
+		return fmt.Errorf("failed to register device: %w", err)
+	}
+
+	if err := h.deviceRepo.Save(ctx, device); err != nil {
+		return fmt.Errorf("failed to save device: %w", err)
+	}
+
+	return nil
+}
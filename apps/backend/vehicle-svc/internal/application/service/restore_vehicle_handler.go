@@ -3,41 +3,45 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
-type UpdateVehicleFuelLevelCommandHandler struct {
+type RestoreVehicleCommandHandler struct {
 	vehicleRepo repository.VehicleRepository
 	outboxRepo  repository.OutboxRepository
+	gracePeriod time.Duration
 }
 
-func NewUpdateVehicleFuelLevelCommandHandler(
+func NewRestoreVehicleCommandHandler(
 	vehicleRepo repository.VehicleRepository,
 	outboxRepo repository.OutboxRepository,
-) *UpdateVehicleFuelLevelCommandHandler {
-	return &UpdateVehicleFuelLevelCommandHandler{
+	gracePeriod time.Duration,
+) *RestoreVehicleCommandHandler {
+	return &RestoreVehicleCommandHandler{
 		vehicleRepo: vehicleRepo,
 		outboxRepo:  outboxRepo,
+		gracePeriod: gracePeriod,
 	}
 }
 
-func (h *UpdateVehicleFuelLevelCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
-	fuelCmd, ok := cmd.(*command.UpdateVehicleFuelLevelCommand)
+func (h *RestoreVehicleCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	restoreCmd, ok := cmd.(*command.RestoreVehicleCommand)
 	if !ok {
-		return fmt.Errorf("invalid command type for UpdateVehicleFuelLevelCommandHandler")
+		return fmt.Errorf("invalid command type for RestoreVehicleCommandHandler")
 	}
 
-	vehicleID, err := valueobject.NewVehicleID(fuelCmd.VehicleID)
+	vehicleID, err := valueobject.NewVehicleID(restoreCmd.VehicleID)
 	if err != nil {
 		return fmt.Errorf("invalid vehicle id: %w", err)
 	}
 
-	newFuelLevel, err := valueobject.NewFuelLevel(fuelCmd.FuelLevel)
+	actor, err := valueobject.NewActor(restoreCmd.Actor)
 	if err != nil {
-		return fmt.Errorf("invalid fuel level: %w", err)
+		return fmt.Errorf("invalid actor: %w", err)
 	}
 
 	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
@@ -45,8 +49,8 @@ func (h *UpdateVehicleFuelLevelCommandHandler) Handle(ctx context.Context, cmd c
 		return fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
-	if err := vehicle.UpdateFuelLevel(newFuelLevel); err != nil {
-		return fmt.Errorf("failed to update fuel level: %w", err)
+	if err := vehicle.Restore(actor, h.gracePeriod); err != nil {
+		return fmt.Errorf("failed to restore vehicle: %w", err)
 	}
 
 	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
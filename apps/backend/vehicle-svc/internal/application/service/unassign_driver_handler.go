@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UnassignDriverCommandHandler struct {
+	driverRepo repository.DriverRepository
+	outboxRepo repository.OutboxRepository
+}
+
+func NewUnassignDriverCommandHandler(
+	driverRepo repository.DriverRepository,
+	outboxRepo repository.OutboxRepository,
+) *UnassignDriverCommandHandler {
+	return &UnassignDriverCommandHandler{
+		driverRepo: driverRepo,
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (h *UnassignDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	unassignCmd, ok := cmd.(*command.UnassignDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UnassignDriverCommandHandler")
+	}
+
+	driverID, err := valueobject.NewDriverID(unassignCmd.DriverID)
+	if err != nil {
+		return fmt.Errorf("invalid driver id: %w", err)
+	}
+
+	driver, err := h.driverRepo.FindByID(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	if err := driver.Unassign(); err != nil {
+		return fmt.Errorf("failed to unassign driver: %w", err)
+	}
+
+	if err := h.driverRepo.Save(ctx, driver); err != nil {
+		return fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	for _, event := range driver.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, driverID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
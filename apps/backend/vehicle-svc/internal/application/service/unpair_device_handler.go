@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UnpairDeviceCommandHandler struct {
+	deviceRepo repository.DeviceRepository
+	outboxRepo repository.OutboxRepository
+}
+
+func NewUnpairDeviceCommandHandler(
+	deviceRepo repository.DeviceRepository,
+	outboxRepo repository.OutboxRepository,
+) *UnpairDeviceCommandHandler {
+	return &UnpairDeviceCommandHandler{
+		deviceRepo: deviceRepo,
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (h *UnpairDeviceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	unpairCmd, ok := cmd.(*command.UnpairDeviceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UnpairDeviceCommandHandler")
+	}
+
+	deviceID, err := valueobject.NewDeviceID(unpairCmd.DeviceID)
+	if err != nil {
+		return fmt.Errorf("invalid device id: %w", err)
+	}
+
+	device, err := h.deviceRepo.FindByID(ctx, deviceID)
+	if err != nil {
+		return fmt.Errorf("failed to find device: %w", err)
+	}
+
+	if err := device.Unpair(); err != nil {
+		return fmt.Errorf("failed to unpair device: %w", err)
+	}
+
+	if err := h.deviceRepo.Save(ctx, device); err != nil {
+		return fmt.Errorf("failed to save device: %w", err)
+	}
+
+	for _, event := range device.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, deviceID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UpdateDeviceCommandHandler struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewUpdateDeviceCommandHandler(deviceRepo repository.DeviceRepository) *UpdateDeviceCommandHandler {
+	return &UpdateDeviceCommandHandler{deviceRepo: deviceRepo}
+}
+
+func (h *UpdateDeviceCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	updateCmd, ok := cmd.(*command.UpdateDeviceCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateDeviceCommandHandler")
+	}
+
+	deviceID, err := valueobject.NewDeviceID(updateCmd.DeviceID)
+	if err != nil {
+		return fmt.Errorf("invalid device id: %w", err)
+	}
+	protocol, err := valueobject.NewDeviceProtocol(updateCmd.Protocol)
+	if err != nil {
+		return err
+	}
+
+	device, err := h.deviceRepo.FindByID(ctx, deviceID)
+	if err != nil {
+		return fmt.Errorf("failed to find device: %w", err)
+	}
+
+	device.UpdateDetails(protocol, updateCmd.Firmware, updateCmd.SIM)
+
+	if err := h.deviceRepo.Save(ctx, device); err != nil {
+		return fmt.Errorf("failed to save device: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UpdateDriverCommandHandler struct {
+	driverRepo repository.DriverRepository
+}
+
+func NewUpdateDriverCommandHandler(driverRepo repository.DriverRepository) *UpdateDriverCommandHandler {
+	return &UpdateDriverCommandHandler{driverRepo: driverRepo}
+}
+
+func (h *UpdateDriverCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	updateCmd, ok := cmd.(*command.UpdateDriverCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateDriverCommandHandler")
+	}
+
+	driverID, err := valueobject.NewDriverID(updateCmd.DriverID)
+	if err != nil {
+		return fmt.Errorf("invalid driver id: %w", err)
+	}
+
+	licenseNumber, err := valueobject.NewLicenseNumber(updateCmd.LicenseNumber)
+	if err != nil {
+		return fmt.Errorf("invalid license number: %w", err)
+	}
+
+	driver, err := h.driverRepo.FindByID(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	if !driver.LicenseNumber().Equals(licenseNumber) {
+		exists, err := h.driverRepo.ExistsByLicenseNumber(ctx, updateCmd.LicenseNumber)
+		if err != nil {
+			return fmt.Errorf("failed to check license number existence: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("driver with license number %s already exists", updateCmd.LicenseNumber)
+		}
+	}
+
+	if err := driver.UpdateDetails(updateCmd.Name, licenseNumber, updateCmd.Phone); err != nil {
+		return fmt.Errorf("failed to update driver: %w", err)
+	}
+
+	if err := h.driverRepo.Save(ctx, driver); err != nil {
+		return fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	return nil
+}
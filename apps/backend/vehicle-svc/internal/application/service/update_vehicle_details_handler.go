@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UpdateVehicleDetailsCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	schemaRepo  repository.AttributeSchemaRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewUpdateVehicleDetailsCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	schemaRepo repository.AttributeSchemaRepository,
+	outboxRepo repository.OutboxRepository,
+) *UpdateVehicleDetailsCommandHandler {
+	return &UpdateVehicleDetailsCommandHandler{
+		vehicleRepo: vehicleRepo,
+		schemaRepo:  schemaRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *UpdateVehicleDetailsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	detailsCmd, ok := cmd.(*command.UpdateVehicleDetailsCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateVehicleDetailsCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(detailsCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(detailsCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	vehicleName := vehicle.VehicleName()
+	if detailsCmd.VehicleName != nil {
+		vehicleName = *detailsCmd.VehicleName
+	}
+	vehicleModel := vehicle.VehicleModel()
+	if detailsCmd.VehicleModel != nil {
+		vehicleModel = *detailsCmd.VehicleModel
+	}
+	licenseNumber := vehicle.LicenseNumber()
+	if detailsCmd.LicenseNumber != nil {
+		licenseNumber, err = valueobject.NewLicenseNumber(*detailsCmd.LicenseNumber)
+		if err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidVehicleDetails, err)
+		}
+	}
+
+	attributes := vehicle.Attributes()
+	if len(detailsCmd.Attributes) > 0 {
+		schema, err := h.schemaRepo.Find(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load attribute schema: %w", err)
+		}
+		// Attributes dropped from the schema since the vehicle was last
+		// updated are pruned rather than blocking the update.
+		merged := schema.Prune(attributes)
+		for name, value := range detailsCmd.Attributes {
+			if value == nil {
+				delete(merged, name)
+				continue
+			}
+			merged[name] = value
+		}
+		attributes, err = schema.Validate(merged)
+		if err != nil {
+			return err
+		}
+	}
+
+	changed, err := vehicle.UpdateDetails(vehicleName, vehicleModel, licenseNumber, attributes, actor)
+	if err != nil {
+		return fmt.Errorf("failed to update vehicle details: %w", err)
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func TestUpdateVehicleDetails_NoChangeIsNotSaved(t *testing.T) {
+	vin, _ := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	license, _ := valueobject.NewLicenseNumber("ABC-123")
+	location, _ := valueobject.NewLocation(10, 20, 0, 0)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), "default", vin, "Truck", "Model", license,
+		valueobject.StatusActive, location, mustMileage(t, 0), valueobject.FuelLevel{}, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	vehicle.UncommittedEvents()
+	id := vehicle.ID().String()
+
+	vehicleRepo := &stubVehicleRepo{vehicles: map[string]*entity.Vehicle{id: vehicle}}
+	outboxRepo := new(MockOutboxRepo)
+
+	name, plate := "Truck", "ABC-123"
+	h := NewUpdateVehicleDetailsCommandHandler(vehicleRepo, &MockAttributeSchemaRepo{}, outboxRepo)
+	require.NoError(t, h.Handle(context.Background(), &command.UpdateVehicleDetailsCommand{
+		VehicleID:     id,
+		VehicleName:   &name,
+		LicenseNumber: &plate,
+		Actor:         "ops@fleet",
+	}))
+
+	vehicleRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	outboxRepo.AssertNotCalled(t, "SaveOutboxEvent", mock.Anything, mock.Anything, mock.Anything)
+}
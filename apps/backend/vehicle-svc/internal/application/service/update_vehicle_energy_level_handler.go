@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UpdateVehicleEnergyLevelCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewUpdateVehicleEnergyLevelCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	outboxRepo repository.OutboxRepository,
+) *UpdateVehicleEnergyLevelCommandHandler {
+	return &UpdateVehicleEnergyLevelCommandHandler{
+		vehicleRepo: vehicleRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *UpdateVehicleEnergyLevelCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	energyCmd, ok := cmd.(*command.UpdateVehicleEnergyLevelCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateVehicleEnergyLevelCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(energyCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	level, err := valueobject.NewFuelLevel(energyCmd.Level)
+	if err != nil {
+		return err
+	}
+
+	charging, err := valueobject.NewChargingState(energyCmd.ChargingState)
+	if err != nil {
+		return err
+	}
+
+	var estimatedRange *valueobject.Mileage
+	if energyCmd.EstimatedRange != nil {
+		units, err := valueobject.NewUnitSystem(energyCmd.Units)
+		if err != nil {
+			return err
+		}
+		r, err := valueobject.NewMileageIn(*energyCmd.EstimatedRange, units)
+		if err != nil {
+			return fmt.Errorf("invalid estimated range: %w", err)
+		}
+		estimatedRange = &r
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if err := vehicle.UpdateEnergyLevel(level, charging, estimatedRange); err != nil {
+		return fmt.Errorf("failed to update energy level: %w", err)
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
@@ -40,22 +41,31 @@ func (h *UpdateVehicleLocationCommandHandler) Handle(ctx context.Context, cmd co
 		return fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
-	location, err := valueobject.NewLocation(
+	location, err := newReportedLocation(
 		updateCmd.Latitude,
 		updateCmd.Longitude,
 		updateCmd.Altitude,
 		updateCmd.Timestamp,
+		updateCmd.SpeedKmh,
+		updateCmd.Heading,
+		updateCmd.Satellites,
+		updateCmd.HDOP,
 	)
 	if err != nil {
-		return fmt.Errorf("invalid location: %w", err)
+		return err
 	}
 
-	if err := vehicle.UpdateLocation(location); err != nil {
+	outcome, err := vehicle.UpdateLocation(location)
+	if err != nil {
 		return fmt.Errorf("failed to update location: %w", err)
 	}
 
-	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
-		return fmt.Errorf("failed to save vehicle: %w", err)
+	// An archived location leaves the vehicle as it was; only its event is
+	// stored.
+	if outcome != entity.LocationArchived {
+		if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+			return fmt.Errorf("failed to save vehicle: %w", err)
+		}
 	}
 
 	for _, event := range vehicle.UncommittedEvents() {
@@ -64,5 +74,26 @@ func (h *UpdateVehicleLocationCommandHandler) Handle(ctx context.Context, cmd co
 		}
 	}
 
+	updateCmd.Outcome = outcome
 	return nil
 }
+
+// newReportedLocation builds a location with whatever optional fields the
+// device reported.
+func newReportedLocation(
+	latitude, longitude, altitude float64,
+	timestamp int64,
+	speedKmh, heading *float64,
+	satellites *int,
+	hdop *float64,
+) (valueobject.Location, error) {
+	location, err := valueobject.NewLocation(latitude, longitude, altitude, timestamp)
+	if err != nil {
+		return valueobject.Location{}, err
+	}
+	location, err = location.WithMotion(speedKmh, heading)
+	if err != nil {
+		return valueobject.Location{}, err
+	}
+	return location.WithFixQuality(satellites, hdop)
+}
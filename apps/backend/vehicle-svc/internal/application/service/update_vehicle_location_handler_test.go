@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func TestUpdateVehicleLocation_ReportsOutcome(t *testing.T) {
+	vin, _ := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	license, _ := valueobject.NewLicenseNumber("ABC-123")
+	location, _ := valueobject.NewLocation(10, 20, 0, 1000)
+	vehicle, err := entity.NewVehicle(valueobject.GenerateVehicleID(), "default", vin, "Truck", "Model", license,
+		valueobject.StatusActive, location, mustMileage(t, 0), valueobject.FuelLevel{}, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	vehicle.UncommittedEvents()
+	id := vehicle.ID().String()
+
+	vehicleRepo := &stubVehicleRepo{vehicles: map[string]*entity.Vehicle{id: vehicle}}
+	vehicleRepo.On("Save", mock.Anything, vehicle).Return(nil).Once()
+	outboxRepo := new(MockOutboxRepo)
+	var published []interface{}
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, id, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = append(published, args.Get(2))
+		}).Return(nil)
+	h := NewUpdateVehicleLocationCommandHandler(vehicleRepo, outboxRepo)
+
+	newer := &command.UpdateVehicleLocationCommand{VehicleID: id, Latitude: 10.1, Longitude: 20.1, Timestamp: 1500}
+	require.NoError(t, h.Handle(context.Background(), newer))
+	assert.Equal(t, entity.LocationApplied, newer.Outcome)
+
+	older := &command.UpdateVehicleLocationCommand{VehicleID: id, Latitude: 10.2, Longitude: 20.2, Timestamp: 500}
+	require.NoError(t, h.Handle(context.Background(), older))
+	assert.Equal(t, entity.LocationArchived, older.Outcome)
+
+	require.Len(t, published, 2)
+	assert.IsType(t, &event.VehicleLocationUpdatedEvent{}, published[0])
+	assert.IsType(t, &event.VehicleLocationArchivedEvent{}, published[1])
+	assert.Equal(t, int64(1500), vehicle.CurrentLocation().Timestamp())
+	vehicleRepo.AssertExpectations(t)
+}
@@ -35,9 +35,13 @@ func (h *UpdateVehicleMileageCommandHandler) Handle(ctx context.Context, cmd com
 		return fmt.Errorf("invalid vehicle id: %w", err)
 	}
 
-	newMileage, err := valueobject.NewMileage(mileageCmd.Mileage)
+	units, err := valueobject.NewUnitSystem(mileageCmd.Units)
 	if err != nil {
-		return fmt.Errorf("invalid mileage: %w", err)
+		return err
+	}
+	newMileage, err := valueobject.NewMileageIn(mileageCmd.Mileage, units)
+	if err != nil {
+		return err
 	}
 
 	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
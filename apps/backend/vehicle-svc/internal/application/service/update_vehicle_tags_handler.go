@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type UpdateVehicleTagsCommandHandler struct {
+	vehicleRepo repository.VehicleRepository
+	outboxRepo  repository.OutboxRepository
+}
+
+func NewUpdateVehicleTagsCommandHandler(
+	vehicleRepo repository.VehicleRepository,
+	outboxRepo repository.OutboxRepository,
+) *UpdateVehicleTagsCommandHandler {
+	return &UpdateVehicleTagsCommandHandler{
+		vehicleRepo: vehicleRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+func (h *UpdateVehicleTagsCommandHandler) Handle(ctx context.Context, cmd command.Command) error {
+	tagsCmd, ok := cmd.(*command.UpdateVehicleTagsCommand)
+	if !ok {
+		return fmt.Errorf("invalid command type for UpdateVehicleTagsCommandHandler")
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(tagsCmd.VehicleID)
+	if err != nil {
+		return fmt.Errorf("invalid vehicle id: %w", err)
+	}
+
+	add, err := valueobject.NewTags(tagsCmd.Add)
+	if err != nil {
+		return fmt.Errorf("invalid tags: %w", err)
+	}
+	remove, err := valueobject.NewTags(tagsCmd.Remove)
+	if err != nil {
+		return fmt.Errorf("invalid tags: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(tagsCmd.Actor)
+	if err != nil {
+		return fmt.Errorf("invalid actor: %w", err)
+	}
+
+	vehicle, err := h.vehicleRepo.FindByID(ctx, vehicleID)
+	if err != nil {
+		return fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if err := vehicle.UpdateTags(add, remove, actor); err != nil {
+		return fmt.Errorf("failed to update tags: %w", err)
+	}
+
+	if err := h.vehicleRepo.Save(ctx, vehicle); err != nil {
+		return fmt.Errorf("failed to save vehicle: %w", err)
+	}
+
+	for _, event := range vehicle.UncommittedEvents() {
+		if err := h.outboxRepo.SaveOutboxEvent(ctx, vehicleID.String(), event); err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
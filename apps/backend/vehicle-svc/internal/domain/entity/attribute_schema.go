@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// AttributeSchema lists the custom vehicle attributes a tenant's fleet
+// records, such as cost center or payload capacity. There is one per tenant;
+// a tenant that never defined one has an empty schema and its vehicles carry
+// no custom attributes.
+type AttributeSchema struct {
+	tenantID    string
+	definitions []valueobject.AttributeDefinition
+	version     valueobject.Version
+	updatedAt   time.Time
+}
+
+func NewAttributeSchema(tenantID string) (*AttributeSchema, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id cannot be empty")
+	}
+	return &AttributeSchema{
+		tenantID: tenantID,
+		version:  valueobject.Version{},
+	}, nil
+}
+
+func (s *AttributeSchema) TenantID() string {
+	return s.tenantID
+}
+
+func (s *AttributeSchema) Definitions() []valueobject.AttributeDefinition {
+	return append([]valueobject.AttributeDefinition(nil), s.definitions...)
+}
+
+func (s *AttributeSchema) Definition(name string) (valueobject.AttributeDefinition, bool) {
+	for _, def := range s.definitions {
+		if def.Name() == name {
+			return def, true
+		}
+	}
+	return valueobject.AttributeDefinition{}, false
+}
+
+func (s *AttributeSchema) Version() valueobject.Version {
+	return s.version
+}
+
+func (s *AttributeSchema) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Define replaces the whole schema. Vehicles are not revalidated; attributes
+// that no longer fit are dropped or must be fixed on their next update.
+func (s *AttributeSchema) Define(definitions []valueobject.AttributeDefinition) error {
+	seen := make(map[string]bool, len(definitions))
+	for _, def := range definitions {
+		if seen[def.Name()] {
+			return fmt.Errorf("%w: %s is defined twice", valueobject.ErrInvalidAttributeDefinition, def.Name())
+		}
+		seen[def.Name()] = true
+	}
+
+	s.definitions = append([]valueobject.AttributeDefinition(nil), definitions...)
+	s.updatedAt = time.Now().UTC()
+	s.version = s.version.Next()
+	return nil
+}
+
+// Validate checks a complete attribute set against the schema and returns it
+// in canonical form. Every problem is reported, not just the first.
+func (s *AttributeSchema) Validate(attributes map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	result := make(map[string]interface{}, len(attributes))
+
+	for name, value := range attributes {
+		def, ok := s.Definition(name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not defined for this fleet", name))
+			continue
+		}
+		coerced, err := def.Coerce(value)
+		if err != nil {
+			problems = append(problems, strings.TrimPrefix(err.Error(), valueobject.ErrInvalidAttributeValue.Error()+": "))
+			continue
+		}
+		result[name] = coerced
+	}
+	for _, def := range s.definitions {
+		if _, ok := attributes[def.Name()]; def.Required() && !ok {
+			problems = append(problems, fmt.Sprintf("%s is required", def.Name()))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAttributes, strings.Join(problems, "; "))
+	}
+	return result, nil
+}
+
+// Prune drops attributes the schema no longer defines, so a vehicle created
+// under an older schema can still be updated.
+func (s *AttributeSchema) Prune(attributes map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(attributes))
+	for name, value := range attributes {
+		if _, ok := s.Definition(name); ok {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+func LoadAttributeSchemaFromHistory(
+	tenantID string,
+	definitions []valueobject.AttributeDefinition,
+	version valueobject.Version,
+	updatedAt time.Time,
+) *AttributeSchema {
+	return &AttributeSchema{
+		tenantID:    tenantID,
+		definitions: definitions,
+		version:     version,
+		updatedAt:   updatedAt,
+	}
+}
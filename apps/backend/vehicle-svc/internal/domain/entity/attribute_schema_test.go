@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func newTestSchema(t *testing.T) *AttributeSchema {
+	t.Helper()
+	schema, err := NewAttributeSchema("fleet-a")
+	require.NoError(t, err)
+	costCenter, err := valueobject.NewAttributeDefinition("costCenter", valueobject.AttributeString, true, nil, "")
+	require.NoError(t, err)
+	axles, err := valueobject.NewAttributeDefinition("axles", valueobject.AttributeInteger, false, nil, "")
+	require.NoError(t, err)
+	body, err := valueobject.NewAttributeDefinition("body", valueobject.AttributeEnum, false, []string{"box", "flatbed"}, "")
+	require.NoError(t, err)
+	require.NoError(t, schema.Define([]valueobject.AttributeDefinition{costCenter, axles, body}))
+	return schema
+}
+
+func TestAttributeSchema_ValidateCoercesValues(t *testing.T) {
+	schema := newTestSchema(t)
+
+	attrs, err := schema.Validate(map[string]interface{}{"costCenter": "CC-12", "axles": float64(3), "body": "box"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"costCenter": "CC-12", "axles": int64(3), "body": "box"}, attrs)
+	assert.Equal(t, int64(1), schema.Version().Value())
+}
+
+func TestAttributeSchema_ValidateReportsEveryProblem(t *testing.T) {
+	schema := newTestSchema(t)
+
+	_, err := schema.Validate(map[string]interface{}{"axles": 2.5, "body": "tanker", "color": "red"})
+	require.ErrorIs(t, err, ErrInvalidAttributes)
+	assert.Contains(t, err.Error(), "axles must be integer")
+	assert.Contains(t, err.Error(), "body must be one of box, flatbed")
+	assert.Contains(t, err.Error(), "color is not defined")
+	assert.Contains(t, err.Error(), "costCenter is required")
+}
+
+func TestAttributeSchema_DefineRejectsDuplicates(t *testing.T) {
+	schema := newTestSchema(t)
+	axles, _ := valueobject.NewAttributeDefinition("axles", valueobject.AttributeNumber, false, nil, "")
+
+	err := schema.Define([]valueobject.AttributeDefinition{axles, axles})
+	assert.ErrorIs(t, err, valueobject.ErrInvalidAttributeDefinition)
+	assert.Len(t, schema.Definitions(), 3)
+}
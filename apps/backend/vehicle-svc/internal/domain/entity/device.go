@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// DevicePairing is a period during which a device reported for a vehicle. An
+// open pairing has no UnpairedAt.
+type DevicePairing struct {
+	VehicleID  string
+	PairedAt   time.Time
+	UnpairedAt *time.Time
+}
+
+// Covers reports whether t falls in the pairing, counting the pairing instant
+// but not the unpairing one.
+func (p DevicePairing) Covers(t time.Time) bool {
+	if t.Before(p.PairedAt) {
+		return false
+	}
+	return p.UnpairedAt == nil || t.Before(*p.UnpairedAt)
+}
+
+// Device is a telematics unit installed in a vehicle. It keeps every pairing
+// it has had so data it buffered before being moved to another vehicle is
+// still attributed to the vehicle it was in at the time.
+type Device struct {
+	id                valueobject.DeviceID
+	tenantID          string
+	hardwareID        valueobject.HardwareID
+	protocol          valueobject.DeviceProtocol
+	firmware          string
+	sim               string
+	pairings          []DevicePairing
+	version           valueobject.Version
+	createdAt         time.Time
+	updatedAt         time.Time
+	uncommittedEvents []interface{}
+}
+
+func NewDevice(
+	id valueobject.DeviceID,
+	tenantID string,
+	hardwareID valueobject.HardwareID,
+	protocol valueobject.DeviceProtocol,
+	firmware string,
+	sim string,
+) (*Device, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	return &Device{
+		id:         id,
+		tenantID:   tenantID,
+		hardwareID: hardwareID,
+		protocol:   protocol,
+		firmware:   firmware,
+		sim:        sim,
+		version:    valueobject.Version{},
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+func (d *Device) ID() valueobject.DeviceID {
+	return d.id
+}
+
+func (d *Device) TenantID() string {
+	return d.tenantID
+}
+
+func (d *Device) HardwareID() valueobject.HardwareID {
+	return d.hardwareID
+}
+
+func (d *Device) Protocol() valueobject.DeviceProtocol {
+	return d.protocol
+}
+
+func (d *Device) Firmware() string {
+	return d.firmware
+}
+
+func (d *Device) SIM() string {
+	return d.sim
+}
+
+// Pairings returns the pairing history, oldest first.
+func (d *Device) Pairings() []DevicePairing {
+	return append([]DevicePairing(nil), d.pairings...)
+}
+
+// PairedVehicleID returns the vehicle the device is paired with now, or an
+// empty string.
+func (d *Device) PairedVehicleID() string {
+	if n := len(d.pairings); n > 0 && d.pairings[n-1].UnpairedAt == nil {
+		return d.pairings[n-1].VehicleID
+	}
+	return ""
+}
+
+func (d *Device) IsPaired() bool {
+	return d.PairedVehicleID() != ""
+}
+
+// VehicleAt returns the vehicle the device was paired with at t.
+func (d *Device) VehicleAt(t time.Time) (string, bool) {
+	for i := len(d.pairings) - 1; i >= 0; i-- {
+		if d.pairings[i].Covers(t) {
+			return d.pairings[i].VehicleID, true
+		}
+	}
+	return "", false
+}
+
+func (d *Device) Version() valueobject.Version {
+	return d.version
+}
+
+func (d *Device) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Device) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+func (d *Device) UpdateDetails(protocol valueobject.DeviceProtocol, firmware, sim string) {
+	d.protocol = protocol
+	d.firmware = firmware
+	d.sim = sim
+	d.updatedAt = time.Now().UTC()
+	d.version = d.version.Next()
+}
+
+func (d *Device) PairWith(vehicleID valueobject.VehicleID) error {
+	current := d.PairedVehicleID()
+	if current == vehicleID.String() {
+		return nil
+	}
+	if current != "" {
+		return fmt.Errorf("%w: %s", ErrDeviceAlreadyPaired, current)
+	}
+
+	now := time.Now().UTC()
+	d.pairings = append(d.pairings, DevicePairing{VehicleID: vehicleID.String(), PairedAt: now})
+	d.updatedAt = now
+	d.version = d.version.Next()
+
+	d.uncommittedEvents = append(d.uncommittedEvents, &event.DevicePairedEvent{
+		TenantID:   d.tenantID,
+		DeviceID:   d.id.String(),
+		HardwareID: d.hardwareID.String(),
+		Protocol:   d.protocol.String(),
+		VehicleID:  vehicleID.String(),
+		PairedAt:   now.Unix(),
+		Version:    d.version.Value(),
+	})
+
+	return nil
+}
+
+func (d *Device) Unpair() error {
+	if !d.IsPaired() {
+		return ErrDeviceNotPaired
+	}
+
+	now := time.Now().UTC()
+	last := &d.pairings[len(d.pairings)-1]
+	last.UnpairedAt = &now
+	d.updatedAt = now
+	d.version = d.version.Next()
+
+	d.uncommittedEvents = append(d.uncommittedEvents, &event.DeviceUnpairedEvent{
+		TenantID:   d.tenantID,
+		DeviceID:   d.id.String(),
+		HardwareID: d.hardwareID.String(),
+		VehicleID:  last.VehicleID,
+		UnpairedAt: now.Unix(),
+		Version:    d.version.Value(),
+	})
+
+	return nil
+}
+
+func (d *Device) UncommittedEvents() []interface{} {
+	events := d.uncommittedEvents
+	d.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadDeviceFromHistory(
+	id valueobject.DeviceID,
+	tenantID string,
+	hardwareID valueobject.HardwareID,
+	protocol valueobject.DeviceProtocol,
+	firmware string,
+	sim string,
+	pairings []DevicePairing,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *Device {
+	return &Device{
+		id:         id,
+		tenantID:   tenantID,
+		hardwareID: hardwareID,
+		protocol:   protocol,
+		firmware:   firmware,
+		sim:        sim,
+		pairings:   pairings,
+		version:    version,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
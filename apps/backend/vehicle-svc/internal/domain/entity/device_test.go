@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func TestDevice_PairAndUnpairEmitEvents(t *testing.T) {
+	hardwareID, err := valueobject.NewHardwareID("356307042441013")
+	require.NoError(t, err)
+	d, err := NewDevice(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolGT06, "1.2", "")
+	require.NoError(t, err)
+	first, second := valueobject.GenerateVehicleID(), valueobject.GenerateVehicleID()
+
+	require.NoError(t, d.PairWith(first))
+	assert.True(t, errors.Is(d.PairWith(second), ErrDeviceAlreadyPaired))
+	require.NoError(t, d.Unpair())
+	assert.True(t, errors.Is(d.Unpair(), ErrDeviceNotPaired))
+	require.NoError(t, d.PairWith(second))
+
+	assert.Equal(t, second.String(), d.PairedVehicleID())
+	require.Len(t, d.Pairings(), 2)
+
+	events := d.UncommittedEvents()
+	require.Len(t, events, 3)
+	unpaired, ok := events[1].(*event.DeviceUnpairedEvent)
+	require.True(t, ok)
+	assert.Equal(t, first.String(), unpaired.VehicleID)
+	paired, ok := events[2].(*event.DevicePairedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "356307042441013", paired.HardwareID)
+}
+
+func TestDevice_VehicleAtFollowsPairingHistory(t *testing.T) {
+	hardwareID, _ := valueobject.NewHardwareID("sn-0042")
+	base := time.Unix(1_700_000_000, 0)
+	movedAt := base.Add(time.Hour)
+	d := LoadDeviceFromHistory(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolCodec8, "", "",
+		[]DevicePairing{
+			{VehicleID: "old-truck", PairedAt: base, UnpairedAt: &movedAt},
+			{VehicleID: "new-truck", PairedAt: movedAt},
+		},
+		valueobject.Version{}, base, movedAt)
+
+	_, ok := d.VehicleAt(base.Add(-time.Second))
+	assert.False(t, ok)
+	vehicle, _ := d.VehicleAt(movedAt.Add(-time.Second))
+	assert.Equal(t, "old-truck", vehicle)
+	vehicle, _ = d.VehicleAt(movedAt)
+	assert.Equal(t, "new-truck", vehicle)
+	assert.Equal(t, "SN-0042", d.HardwareID().String())
+}
@@ -0,0 +1,187 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type Driver struct {
+	id                valueobject.DriverID
+	tenantID          string
+	name              string
+	licenseNumber     valueobject.LicenseNumber
+	phone             string
+	assignedVehicleID string
+	assignedAt        *time.Time
+	version           valueobject.Version
+	createdAt         time.Time
+	updatedAt         time.Time
+	uncommittedEvents []interface{}
+}
+
+func NewDriver(
+	id valueobject.DriverID,
+	tenantID string,
+	name string,
+	licenseNumber valueobject.LicenseNumber,
+	phone string,
+) (*Driver, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id cannot be empty")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("driver name cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	return &Driver{
+		id:            id,
+		tenantID:      tenantID,
+		name:          name,
+		licenseNumber: licenseNumber,
+		phone:         phone,
+		version:       valueobject.Version{},
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+func (d *Driver) ID() valueobject.DriverID {
+	return d.id
+}
+
+func (d *Driver) TenantID() string {
+	return d.tenantID
+}
+
+func (d *Driver) Name() string {
+	return d.name
+}
+
+func (d *Driver) LicenseNumber() valueobject.LicenseNumber {
+	return d.licenseNumber
+}
+
+func (d *Driver) Phone() string {
+	return d.phone
+}
+
+func (d *Driver) AssignedVehicleID() string {
+	return d.assignedVehicleID
+}
+
+func (d *Driver) AssignedAt() *time.Time {
+	return d.assignedAt
+}
+
+func (d *Driver) IsAssigned() bool {
+	return d.assignedVehicleID != ""
+}
+
+func (d *Driver) Version() valueobject.Version {
+	return d.version
+}
+
+func (d *Driver) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Driver) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+func (d *Driver) UpdateDetails(name string, licenseNumber valueobject.LicenseNumber, phone string) error {
+	if name == "" {
+		return fmt.Errorf("driver name cannot be empty")
+	}
+
+	d.name = name
+	d.licenseNumber = licenseNumber
+	d.phone = phone
+	d.updatedAt = time.Now().UTC()
+	d.version = d.version.Next()
+
+	return nil
+}
+
+func (d *Driver) AssignTo(vehicleID valueobject.VehicleID) error {
+	if d.assignedVehicleID == vehicleID.String() {
+		return nil
+	}
+	if d.IsAssigned() {
+		return fmt.Errorf("%w: %s", ErrDriverAlreadyAssigned, d.assignedVehicleID)
+	}
+
+	now := time.Now().UTC()
+	d.assignedVehicleID = vehicleID.String()
+	d.assignedAt = &now
+	d.updatedAt = now
+	d.version = d.version.Next()
+
+	d.uncommittedEvents = append(d.uncommittedEvents, &event.DriverAssignedEvent{
+		TenantID:   d.tenantID,
+		DriverID:   d.id.String(),
+		DriverName: d.name,
+		VehicleID:  vehicleID.String(),
+		AssignedAt: now.Unix(),
+		Version:    d.version.Value(),
+	})
+
+	return nil
+}
+
+func (d *Driver) Unassign() error {
+	if !d.IsAssigned() {
+		return ErrDriverNotAssigned
+	}
+
+	vehicleID := d.assignedVehicleID
+	d.assignedVehicleID = ""
+	d.assignedAt = nil
+	d.updatedAt = time.Now().UTC()
+	d.version = d.version.Next()
+
+	d.uncommittedEvents = append(d.uncommittedEvents, &event.DriverUnassignedEvent{
+		TenantID:     d.tenantID,
+		DriverID:     d.id.String(),
+		VehicleID:    vehicleID,
+		UnassignedAt: d.updatedAt.Unix(),
+		Version:      d.version.Value(),
+	})
+
+	return nil
+}
+
+func (d *Driver) UncommittedEvents() []interface{} {
+	events := d.uncommittedEvents
+	d.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadDriverFromHistory(
+	id valueobject.DriverID,
+	tenantID string,
+	name string,
+	licenseNumber valueobject.LicenseNumber,
+	phone string,
+	assignedVehicleID string,
+	assignedAt *time.Time,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *Driver {
+	return &Driver{
+		id:                id,
+		tenantID:          tenantID,
+		name:              name,
+		licenseNumber:     licenseNumber,
+		phone:             phone,
+		assignedVehicleID: assignedVehicleID,
+		assignedAt:        assignedAt,
+		version:           version,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func newTestDriver(t *testing.T) *Driver {
+	t.Helper()
+	license, err := valueobject.NewLicenseNumber("D1234567")
+	require.NoError(t, err)
+	d, err := NewDriver(valueobject.GenerateDriverID(), "fleet-a", "Jane Doe", license, "+100000000")
+	require.NoError(t, err)
+	return d
+}
+
+func TestDriver_AssignAndUnassignEmitEvents(t *testing.T) {
+	d := newTestDriver(t)
+	vehicleID := valueobject.GenerateVehicleID()
+
+	require.NoError(t, d.AssignTo(vehicleID))
+	assert.Equal(t, vehicleID.String(), d.AssignedVehicleID())
+	assert.NotNil(t, d.AssignedAt())
+
+	require.NoError(t, d.Unassign())
+	assert.False(t, d.IsAssigned())
+
+	events := d.UncommittedEvents()
+	require.Len(t, events, 2)
+	assigned, ok := events[0].(*event.DriverAssignedEvent)
+	require.True(t, ok)
+	assert.Equal(t, vehicleID.String(), assigned.VehicleID)
+	assert.Equal(t, "Jane Doe", assigned.DriverName)
+	unassigned, ok := events[1].(*event.DriverUnassignedEvent)
+	require.True(t, ok)
+	assert.Equal(t, vehicleID.String(), unassigned.VehicleID)
+}
+
+func TestDriver_AssignToSecondVehicleFails(t *testing.T) {
+	d := newTestDriver(t)
+	require.NoError(t, d.AssignTo(valueobject.GenerateVehicleID()))
+
+	err := d.AssignTo(valueobject.GenerateVehicleID())
+	assert.True(t, errors.Is(err, ErrDriverAlreadyAssigned))
+}
+
+func TestDriver_UnassignWhenNotAssignedFails(t *testing.T) {
+	d := newTestDriver(t)
+	assert.True(t, errors.Is(d.Unassign(), ErrDriverNotAssigned))
+}
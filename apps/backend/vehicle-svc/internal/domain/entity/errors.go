@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+var (
+	ErrInvalidStatusTransition  = errors.New("invalid status transition")
+	ErrVehicleRetired           = errors.New("vehicle is retired")
+	ErrDriverAlreadyAssigned    = errors.New("driver is already assigned to a vehicle")
+	ErrDriverNotAssigned        = errors.New("driver is not assigned to a vehicle")
+	ErrVehicleAlreadyAssigned   = errors.New("vehicle already has an assigned driver")
+	ErrMaintenanceTaskCompleted = errors.New("maintenance task is already completed")
+	ErrMileageDecreased         = errors.New("mileage cannot decrease")
+	ErrCorrectionReasonRequired = errors.New("correction reason cannot be empty")
+	ErrCorrectionUnchanged      = errors.New("correction does not change the reading")
+	ErrVehicleDeleted           = errors.New("vehicle is deleted")
+	ErrVehicleNotDeleted        = errors.New("vehicle is not deleted")
+	ErrDeletionReasonRequired   = errors.New("deletion reason cannot be empty")
+	ErrRestoreWindowExpired     = errors.New("restore window has expired")
+	ErrInvalidVehicleDetails    = errors.New("invalid vehicle details")
+	ErrInvalidAttributes        = errors.New("invalid vehicle attributes")
+	ErrVehicleNotChargeable     = errors.New("vehicle cannot be charged")
+	ErrDeviceAlreadyPaired      = errors.New("device is already paired with a vehicle")
+	ErrDeviceNotPaired          = errors.New("device is not paired with a vehicle")
+	ErrDeviceAlreadyRegistered  = errors.New("device is already registered")
+)
+
+type StatusTransitionError struct {
+	From valueobject.VehicleStatus
+	To   valueobject.VehicleStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	if e.From.IsTerminal() {
+		return fmt.Sprintf("cannot change status from %s to %s: %s is a terminal status", e.From, e.To, e.From)
+	}
+	return fmt.Sprintf("cannot change status from %s to %s", e.From, e.To)
+}
+
+func (e *StatusTransitionError) Is(target error) bool {
+	if target == ErrInvalidStatusTransition {
+		return true
+	}
+	return target == ErrVehicleRetired && e.From == valueobject.StatusRetired
+}
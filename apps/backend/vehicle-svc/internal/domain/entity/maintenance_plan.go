@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+const (
+	// A task escalates from due to overdue once it runs this share of the
+	// mileage interval, or this long, past its due point.
+	overdueMileageRatio = 0.1
+	overdueGracePeriod  = 30 * 24 * time.Hour
+)
+
+// MaintenancePlan describes a recurring service for every vehicle of a model,
+// e.g. "oil change every 10,000 km or 6 months".
+type MaintenancePlan struct {
+	id              valueobject.MaintenancePlanID
+	tenantID        string
+	vehicleModel    string
+	name            string
+	interval        valueobject.MaintenanceInterval
+	autoMaintenance bool
+	version         valueobject.Version
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+func NewMaintenancePlan(
+	id valueobject.MaintenancePlanID,
+	tenantID string,
+	vehicleModel string,
+	name string,
+	interval valueobject.MaintenanceInterval,
+	autoMaintenance bool,
+) (*MaintenancePlan, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id cannot be empty")
+	}
+	if vehicleModel == "" {
+		return nil, fmt.Errorf("vehicle model cannot be empty")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("maintenance plan name cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	return &MaintenancePlan{
+		id:              id,
+		tenantID:        tenantID,
+		vehicleModel:    vehicleModel,
+		name:            name,
+		interval:        interval,
+		autoMaintenance: autoMaintenance,
+		version:         valueobject.Version{},
+		createdAt:       now,
+		updatedAt:       now,
+	}, nil
+}
+
+func (p *MaintenancePlan) ID() valueobject.MaintenancePlanID {
+	return p.id
+}
+
+func (p *MaintenancePlan) TenantID() string {
+	return p.tenantID
+}
+
+func (p *MaintenancePlan) VehicleModel() string {
+	return p.vehicleModel
+}
+
+func (p *MaintenancePlan) Name() string {
+	return p.name
+}
+
+func (p *MaintenancePlan) Interval() valueobject.MaintenanceInterval {
+	return p.interval
+}
+
+// AutoMaintenance reports whether vehicles are moved to maintenance status
+// automatically once a task of this plan comes due.
+func (p *MaintenancePlan) AutoMaintenance() bool {
+	return p.autoMaintenance
+}
+
+func (p *MaintenancePlan) Version() valueobject.Version {
+	return p.version
+}
+
+func (p *MaintenancePlan) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *MaintenancePlan) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ScheduleFor opens the next task for a vehicle, counting the interval from
+// the last service.
+func (p *MaintenancePlan) ScheduleFor(
+	vehicleID valueobject.VehicleID,
+	lastServiceMileage valueobject.Mileage,
+	lastServiceAt time.Time,
+) *MaintenanceTask {
+	var dueMileage, overdueMileage float64
+	if p.interval.HasMileage() {
+		km := p.interval.Kilometers()
+		dueMileage = lastServiceMileage.Kilometers() + km
+		overdueMileage = dueMileage + km*overdueMileageRatio
+	}
+
+	var dueAt, overdueAt *time.Time
+	if p.interval.HasTime() {
+		due := lastServiceAt.AddDate(0, p.interval.Months(), 0)
+		overdue := due.Add(overdueGracePeriod)
+		dueAt = &due
+		overdueAt = &overdue
+	}
+
+	now := time.Now().UTC()
+	return &MaintenanceTask{
+		id:             valueobject.GenerateMaintenanceTaskID(),
+		tenantID:       p.tenantID,
+		planID:         p.id,
+		planName:       p.name,
+		vehicleID:      vehicleID,
+		status:         valueobject.MaintenanceScheduled,
+		dueMileage:     dueMileage,
+		overdueMileage: overdueMileage,
+		dueAt:          dueAt,
+		overdueAt:      overdueAt,
+		version:        valueobject.Version{},
+		createdAt:      now,
+		updatedAt:      now,
+	}
+}
+
+func LoadMaintenancePlanFromHistory(
+	id valueobject.MaintenancePlanID,
+	tenantID string,
+	vehicleModel string,
+	name string,
+	interval valueobject.MaintenanceInterval,
+	autoMaintenance bool,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *MaintenancePlan {
+	return &MaintenancePlan{
+		id:              id,
+		tenantID:        tenantID,
+		vehicleModel:    vehicleModel,
+		name:            name,
+		interval:        interval,
+		autoMaintenance: autoMaintenance,
+		version:         version,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// MaintenanceTask is one occurrence of a maintenance plan for a vehicle. It
+// starts out scheduled and escalates to due and then overdue as the vehicle's
+// mileage or the calendar passes its thresholds. Zero mileage thresholds and
+// nil dates mean the plan has no bound of that kind.
+type MaintenanceTask struct {
+	id                valueobject.MaintenanceTaskID
+	tenantID          string
+	planID            valueobject.MaintenancePlanID
+	planName          string
+	vehicleID         valueobject.VehicleID
+	status            valueobject.MaintenanceTaskStatus
+	dueMileage        float64
+	overdueMileage    float64
+	dueAt             *time.Time
+	overdueAt         *time.Time
+	completedMileage  float64
+	completedAt       *time.Time
+	version           valueobject.Version
+	createdAt         time.Time
+	updatedAt         time.Time
+	uncommittedEvents []interface{}
+}
+
+func (t *MaintenanceTask) ID() valueobject.MaintenanceTaskID {
+	return t.id
+}
+
+func (t *MaintenanceTask) TenantID() string {
+	return t.tenantID
+}
+
+func (t *MaintenanceTask) PlanID() valueobject.MaintenancePlanID {
+	return t.planID
+}
+
+func (t *MaintenanceTask) PlanName() string {
+	return t.planName
+}
+
+func (t *MaintenanceTask) VehicleID() valueobject.VehicleID {
+	return t.vehicleID
+}
+
+func (t *MaintenanceTask) Status() valueobject.MaintenanceTaskStatus {
+	return t.status
+}
+
+func (t *MaintenanceTask) DueMileage() float64 {
+	return t.dueMileage
+}
+
+func (t *MaintenanceTask) OverdueMileage() float64 {
+	return t.overdueMileage
+}
+
+func (t *MaintenanceTask) DueAt() *time.Time {
+	return t.dueAt
+}
+
+func (t *MaintenanceTask) OverdueAt() *time.Time {
+	return t.overdueAt
+}
+
+func (t *MaintenanceTask) CompletedMileage() float64 {
+	return t.completedMileage
+}
+
+func (t *MaintenanceTask) CompletedAt() *time.Time {
+	return t.completedAt
+}
+
+func (t *MaintenanceTask) Version() valueobject.Version {
+	return t.version
+}
+
+func (t *MaintenanceTask) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *MaintenanceTask) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Evaluate escalates the task against the vehicle's current mileage and the
+// given time. Status never moves backwards, so a corrected odometer reading
+// does not silently clear a due task. It reports whether the task escalated.
+func (t *MaintenanceTask) Evaluate(mileage valueobject.Mileage, now time.Time) bool {
+	if !t.status.IsOpen() {
+		return false
+	}
+
+	next := t.statusAt(mileage.Kilometers(), now)
+	if severity(next) <= severity(t.status) {
+		return false
+	}
+
+	t.status = next
+	t.updatedAt = time.Now().UTC()
+	t.version = t.version.Next()
+
+	evt := &event.MaintenanceDueEvent{
+		TenantID:       t.tenantID,
+		TaskID:         t.id.String(),
+		PlanID:         t.planID.String(),
+		PlanName:       t.planName,
+		VehicleID:      t.vehicleID.String(),
+		Status:         string(t.status),
+		DueMileage:     t.dueMileage,
+		CurrentMileage: mileage.Kilometers(),
+		RaisedAt:       t.updatedAt.Unix(),
+		Version:        t.version.Value(),
+	}
+	if t.dueAt != nil {
+		evt.DueAt = t.dueAt.Unix()
+	}
+	t.uncommittedEvents = append(t.uncommittedEvents, evt)
+
+	return true
+}
+
+func (t *MaintenanceTask) Complete(mileage valueobject.Mileage) error {
+	if !t.status.IsOpen() {
+		return ErrMaintenanceTaskCompleted
+	}
+
+	now := time.Now().UTC()
+	t.status = valueobject.MaintenanceCompleted
+	t.completedMileage = mileage.Kilometers()
+	t.completedAt = &now
+	t.updatedAt = now
+	t.version = t.version.Next()
+
+	return nil
+}
+
+func (t *MaintenanceTask) statusAt(mileage float64, now time.Time) valueobject.MaintenanceTaskStatus {
+	switch {
+	case t.overdueMileage > 0 && mileage >= t.overdueMileage,
+		t.overdueAt != nil && !now.Before(*t.overdueAt):
+		return valueobject.MaintenanceOverdue
+	case t.dueMileage > 0 && mileage >= t.dueMileage,
+		t.dueAt != nil && !now.Before(*t.dueAt):
+		return valueobject.MaintenanceDue
+	default:
+		return valueobject.MaintenanceScheduled
+	}
+}
+
+func severity(status valueobject.MaintenanceTaskStatus) int {
+	switch status {
+	case valueobject.MaintenanceDue:
+		return 1
+	case valueobject.MaintenanceOverdue:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func (t *MaintenanceTask) UncommittedEvents() []interface{} {
+	events := t.uncommittedEvents
+	t.uncommittedEvents = []interface{}{}
+	return events
+}
+
+func LoadMaintenanceTaskFromHistory(
+	id valueobject.MaintenanceTaskID,
+	tenantID string,
+	planID valueobject.MaintenancePlanID,
+	planName string,
+	vehicleID valueobject.VehicleID,
+	status valueobject.MaintenanceTaskStatus,
+	dueMileage, overdueMileage float64,
+	dueAt, overdueAt *time.Time,
+	completedMileage float64,
+	completedAt *time.Time,
+	version valueobject.Version,
+	createdAt, updatedAt time.Time,
+) *MaintenanceTask {
+	return &MaintenanceTask{
+		id:               id,
+		tenantID:         tenantID,
+		planID:           planID,
+		planName:         planName,
+		vehicleID:        vehicleID,
+		status:           status,
+		dueMileage:       dueMileage,
+		overdueMileage:   overdueMileage,
+		dueAt:            dueAt,
+		overdueAt:        overdueAt,
+		completedMileage: completedMileage,
+		completedAt:      completedAt,
+		version:          version,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
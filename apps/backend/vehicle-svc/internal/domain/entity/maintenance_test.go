@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func newTestPlan(t *testing.T, km float64, months int) *MaintenancePlan {
+	t.Helper()
+	interval, err := valueobject.NewMaintenanceInterval(km, months)
+	require.NoError(t, err)
+	p, err := NewMaintenancePlan(valueobject.GenerateMaintenancePlanID(), "fleet-a", "Model X", "Oil change", interval, false)
+	require.NoError(t, err)
+	return p
+}
+
+func testMileage(t *testing.T, km float64) valueobject.Mileage {
+	t.Helper()
+	m, err := valueobject.NewMileage(km)
+	require.NoError(t, err)
+	return m
+}
+
+func TestMaintenanceTask_EscalatesOnMileage(t *testing.T) {
+	plan := newTestPlan(t, 10000, 0)
+	now := time.Now().UTC()
+	task := plan.ScheduleFor(valueobject.GenerateVehicleID(), testMileage(t, 20000), now)
+
+	assert.Equal(t, 30000.0, task.DueMileage())
+	assert.Nil(t, task.DueAt())
+	assert.Equal(t, plan.TenantID(), task.TenantID())
+
+	assert.False(t, task.Evaluate(testMileage(t, 29999), now))
+	assert.Equal(t, valueobject.MaintenanceScheduled, task.Status())
+
+	assert.True(t, task.Evaluate(testMileage(t, 30000), now))
+	assert.Equal(t, valueobject.MaintenanceDue, task.Status())
+
+	assert.False(t, task.Evaluate(testMileage(t, 30500), now))
+
+	assert.True(t, task.Evaluate(testMileage(t, 31000), now))
+	assert.Equal(t, valueobject.MaintenanceOverdue, task.Status())
+
+	// A lower odometer reading never de-escalates the task.
+	assert.False(t, task.Evaluate(testMileage(t, 25000), now))
+	assert.Equal(t, valueobject.MaintenanceOverdue, task.Status())
+
+	events := task.UncommittedEvents()
+	require.Len(t, events, 2)
+	due, ok := events[0].(*event.MaintenanceDueEvent)
+	require.True(t, ok)
+	assert.Equal(t, "due", due.Status)
+	overdue, ok := events[1].(*event.MaintenanceDueEvent)
+	require.True(t, ok)
+	assert.Equal(t, "overdue", overdue.Status)
+}
+
+func TestMaintenanceTask_EscalatesOnTime(t *testing.T) {
+	plan := newTestPlan(t, 10000, 6)
+	lastService := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	task := plan.ScheduleFor(valueobject.GenerateVehicleID(), testMileage(t, 0), lastService)
+
+	require.NotNil(t, task.DueAt())
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), *task.DueAt())
+
+	assert.False(t, task.Evaluate(testMileage(t, 100), time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, task.Evaluate(testMileage(t, 100), time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, valueobject.MaintenanceDue, task.Status())
+	assert.True(t, task.Evaluate(testMileage(t, 100), time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, valueobject.MaintenanceOverdue, task.Status())
+}
+
+func TestMaintenanceTask_CompleteOnlyOnce(t *testing.T) {
+	plan := newTestPlan(t, 10000, 0)
+	task := plan.ScheduleFor(valueobject.GenerateVehicleID(), testMileage(t, 0), time.Now().UTC())
+
+	require.NoError(t, task.Complete(testMileage(t, 10100)))
+	assert.Equal(t, valueobject.MaintenanceCompleted, task.Status())
+	assert.Equal(t, 10100.0, task.CompletedMileage())
+	assert.NotNil(t, task.CompletedAt())
+
+	err := task.Complete(testMileage(t, 10200))
+	assert.True(t, errors.Is(err, ErrMaintenanceTaskCompleted))
+	assert.False(t, task.Evaluate(testMileage(t, 50000), time.Now().UTC()))
+}
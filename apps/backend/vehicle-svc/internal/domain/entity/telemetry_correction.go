@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// TelemetryCorrection is the audit record of an operator overriding a mileage
+// or fuel reading. It is immutable once written.
+type TelemetryCorrection struct {
+	id        valueobject.TelemetryCorrectionID
+	tenantID  string
+	vehicleID valueobject.VehicleID
+	field     valueobject.CorrectionField
+	oldValue  float64
+	newValue  float64
+	reason    string
+	actor     valueobject.Actor
+	appliedAt time.Time
+}
+
+func (c *TelemetryCorrection) ID() valueobject.TelemetryCorrectionID {
+	return c.id
+}
+
+func (c *TelemetryCorrection) TenantID() string {
+	return c.tenantID
+}
+
+func (c *TelemetryCorrection) VehicleID() valueobject.VehicleID {
+	return c.vehicleID
+}
+
+func (c *TelemetryCorrection) Field() valueobject.CorrectionField {
+	return c.field
+}
+
+func (c *TelemetryCorrection) OldValue() float64 {
+	return c.oldValue
+}
+
+func (c *TelemetryCorrection) NewValue() float64 {
+	return c.newValue
+}
+
+func (c *TelemetryCorrection) Reason() string {
+	return c.reason
+}
+
+func (c *TelemetryCorrection) Actor() valueobject.Actor {
+	return c.actor
+}
+
+func (c *TelemetryCorrection) AppliedAt() time.Time {
+	return c.appliedAt
+}
+
+func LoadTelemetryCorrectionFromHistory(
+	id valueobject.TelemetryCorrectionID,
+	tenantID string,
+	vehicleID valueobject.VehicleID,
+	field valueobject.CorrectionField,
+	oldValue, newValue float64,
+	reason string,
+	actor valueobject.Actor,
+	appliedAt time.Time,
+) *TelemetryCorrection {
+	return &TelemetryCorrection{
+		id:        id,
+		tenantID:  tenantID,
+		vehicleID: vehicleID,
+		field:     field,
+		oldValue:  oldValue,
+		newValue:  newValue,
+		reason:    reason,
+		actor:     actor,
+		appliedAt: appliedAt,
+	}
+}
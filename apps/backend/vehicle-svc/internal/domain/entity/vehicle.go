@@ -2,6 +2,9 @@ package entity
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
@@ -10,23 +13,33 @@ import (
 
 type Vehicle struct {
 	id                valueobject.VehicleID
-	vin               string
+	tenantID          string
+	vin               valueobject.VIN
 	vehicleName       string
 	vehicleModel      string
 	licenseNumber     valueobject.LicenseNumber
 	status            valueobject.VehicleStatus
 	currentLocation   valueobject.Location
 	mileage           valueobject.Mileage
-	fuelLevel         valueobject.FuelLevel
+	fuelLevel         valueobject.FuelLevel // battery state of charge for a BEV
+	energySource      valueobject.EnergySource
+	chargingState     valueobject.ChargingState
+	estimatedRange    *valueobject.Mileage // nil until the vehicle reports one
 	version           valueobject.Version
 	createdAt         time.Time
 	updatedAt         time.Time
+	deletedAt         *time.Time
+	deletionReason    string
+	group             string   // empty when the vehicle belongs to no group
+	tags              []string // sorted and de-duplicated
+	attributes        map[string]interface{}
 	uncommittedEvents []interface{}
 }
 
 func NewVehicle(
 	id valueobject.VehicleID,
-	vin string,
+	tenantID string,
+	vin valueobject.VIN,
 	vehicleName string,
 	vehicleModel string,
 	licenseNumber valueobject.LicenseNumber,
@@ -34,8 +47,13 @@ func NewVehicle(
 	location valueobject.Location,
 	mileage valueobject.Mileage,
 	fuelLevel valueobject.FuelLevel,
+	energySource valueobject.EnergySource,
+	attributes map[string]interface{},
 ) (*Vehicle, error) {
-	if vin == "" {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id cannot be empty")
+	}
+	if vin.String() == "" {
 		return nil, fmt.Errorf("vin cannot be empty")
 	}
 	if vehicleName == "" {
@@ -48,6 +66,7 @@ func NewVehicle(
 	now := time.Now().UTC()
 	v := &Vehicle{
 		id:              id,
+		tenantID:        tenantID,
 		vin:             vin,
 		vehicleName:     vehicleName,
 		vehicleModel:    vehicleModel,
@@ -56,23 +75,29 @@ func NewVehicle(
 		currentLocation: location,
 		mileage:         mileage,
 		fuelLevel:       fuelLevel,
+		energySource:    energySource,
 		version:         valueobject.Version{},
 		createdAt:       now,
 		updatedAt:       now,
+		attributes:      copyAttributes(attributes),
 	}
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleCreatedEvent{
-		VehicleID:     id.String(),
-		VIN:           vin,
-		VehicleName:   vehicleName,
-		VehicleModel:  vehicleModel,
-		LicenseNumber: licenseNumber.String(),
-		Status:        string(status),
-		Latitude:      location.Latitude(),
-		Longitude:     location.Longitude(),
-		Mileage:       float64(mileage.Kilometers()),
-		FuelLevel:     float64(fuelLevel.Percentage()),
-		Timestamp:     now.Unix(),
+		TenantID:       v.tenantID,
+		VehicleID:      id.String(),
+		VIN:            vin.String(),
+		VehicleName:    vehicleName,
+		VehicleModel:   vehicleModel,
+		LicenseNumber:  licenseNumber.String(),
+		Status:         string(status),
+		Latitude:       location.Latitude(),
+		Longitude:      location.Longitude(),
+		Mileage:        float64(mileage.Kilometers()),
+		FuelLevel:      float64(fuelLevel.Percentage()),
+		EnergyType:     string(energySource.Type()),
+		EnergyCapacity: energySource.Capacity(),
+		Timestamp:      now.Unix(),
+		Attributes:     v.Attributes(),
 	})
 
 	return v, nil
@@ -82,7 +107,11 @@ func (v *Vehicle) ID() valueobject.VehicleID {
 	return v.id
 }
 
-func (v *Vehicle) VIN() string {
+func (v *Vehicle) TenantID() string {
+	return v.tenantID
+}
+
+func (v *Vehicle) VIN() valueobject.VIN {
 	return v.vin
 }
 
@@ -114,6 +143,20 @@ func (v *Vehicle) FuelLevel() valueobject.FuelLevel {
 	return v.fuelLevel
 }
 
+func (v *Vehicle) EnergySource() valueobject.EnergySource {
+	return v.energySource
+}
+
+// ChargingState is empty for an ICE vehicle or when none was reported.
+func (v *Vehicle) ChargingState() valueobject.ChargingState {
+	return v.chargingState
+}
+
+// EstimatedRange is nil until the vehicle reports one.
+func (v *Vehicle) EstimatedRange() *valueobject.Mileage {
+	return v.estimatedRange
+}
+
 func (v *Vehicle) Version() valueobject.Version {
 	return v.version
 }
@@ -126,38 +169,173 @@ func (v *Vehicle) UpdatedAt() time.Time {
 	return v.updatedAt
 }
 
-func (v *Vehicle) UpdateLocation(location valueobject.Location) error {
+// DeletedAt is nil unless the vehicle has been soft-deleted.
+func (v *Vehicle) DeletedAt() *time.Time {
+	return v.deletedAt
+}
+
+func (v *Vehicle) DeletionReason() string {
+	return v.deletionReason
+}
+
+func (v *Vehicle) IsDeleted() bool {
+	return v.deletedAt != nil
+}
+
+func (v *Vehicle) Group() string {
+	return v.group
+}
+
+func (v *Vehicle) Tags() []string {
+	return append([]string(nil), v.tags...)
+}
+
+// Attributes returns the custom attributes defined by the tenant's
+// AttributeSchema, already validated against it.
+func (v *Vehicle) Attributes() map[string]interface{} {
+	return copyAttributes(v.attributes)
+}
+
+func (v *Vehicle) HasTag(tag valueobject.Tag) bool {
+	for _, t := range v.tags {
+		if t == tag.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// LocationOutcome is what became of a reported position.
+type LocationOutcome string
+
+const (
+	// LocationApplied moved the vehicle, or repeated its current position.
+	LocationApplied LocationOutcome = "applied"
+	// LocationArchived is older than the current position. It is kept in the
+	// vehicle's history but does not move the vehicle, so a device replaying
+	// its buffer cannot send the vehicle back in time.
+	LocationArchived LocationOutcome = "archived"
+)
+
+// UpdateLocation moves the vehicle to location unless location is older than
+// the current position, in which case it is archived. Archiving leaves the
+// vehicle and its version unchanged; only the event needs to be stored.
+func (v *Vehicle) UpdateLocation(location valueobject.Location) (LocationOutcome, error) {
+	if v.IsDeleted() {
+		return "", ErrVehicleDeleted
+	}
+	if location.Timestamp() < v.currentLocation.Timestamp() {
+		v.archiveLocation(location)
+		return LocationArchived, nil
+	}
 	if location.Equals(v.currentLocation) {
-		return nil
+		return LocationApplied, nil
 	}
 
 	v.currentLocation = location
 	v.updatedAt = time.Now().UTC()
 	v.version = v.version.Next()
 
-	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleLocationUpdatedEvent{
-		VehicleID: v.id.String(),
-		Latitude:  location.Latitude(),
-		Longitude: location.Longitude(),
-		Altitude:  location.Altitude(),
-		Timestamp: location.Timestamp(),
-		UpdatedAt: v.updatedAt.Unix(),
-		Version:   v.version.Value(),
+	v.uncommittedEvents = append(v.uncommittedEvents, v.locationUpdatedEvent(location))
+
+	return LocationApplied, nil
+}
+
+// ApplyLocationTrack moves the vehicle through a buffered track, which must be
+// in timestamp order. The track is one change, so the version is bumped once,
+// but every point emits its own VehicleLocationUpdatedEvent so consumers see
+// the whole path. Points equal to the location before them are skipped, and
+// points older than the current position are archived as by UpdateLocation.
+// It returns the outcome of each point and whether the vehicle changed and
+// must be saved.
+func (v *Vehicle) ApplyLocationTrack(track []valueobject.Location) ([]LocationOutcome, bool, error) {
+	if v.IsDeleted() {
+		return nil, false, ErrVehicleDeleted
+	}
+
+	outcomes := make([]LocationOutcome, len(track))
+	var changed []valueobject.Location
+	current := v.currentLocation
+	for i, location := range track {
+		if location.Timestamp() < v.currentLocation.Timestamp() {
+			v.archiveLocation(location)
+			outcomes[i] = LocationArchived
+			continue
+		}
+		outcomes[i] = LocationApplied
+		if location.Equals(current) {
+			continue
+		}
+		changed = append(changed, location)
+		current = location
+	}
+	if len(changed) == 0 {
+		return outcomes, false, nil
+	}
+
+	v.currentLocation = current
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	for _, location := range changed {
+		v.uncommittedEvents = append(v.uncommittedEvents, v.locationUpdatedEvent(location))
+	}
+
+	return outcomes, true, nil
+}
+
+func (v *Vehicle) archiveLocation(location valueobject.Location) {
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleLocationArchivedEvent{
+		TenantID:   v.tenantID,
+		VehicleID:  v.id.String(),
+		Latitude:   location.Latitude(),
+		Longitude:  location.Longitude(),
+		Altitude:   location.Altitude(),
+		Timestamp:  location.Timestamp(),
+		SpeedKmh:   location.SpeedKmh(),
+		Heading:    location.Heading(),
+		Satellites: location.Satellites(),
+		HDOP:       location.HDOP(),
+		ArchivedAt: time.Now().UTC().Unix(),
+		Version:    v.version.Value(),
 	})
+}
 
-	return nil
+func (v *Vehicle) locationUpdatedEvent(location valueobject.Location) *event.VehicleLocationUpdatedEvent {
+	return &event.VehicleLocationUpdatedEvent{
+		TenantID:   v.tenantID,
+		VehicleID:  v.id.String(),
+		Latitude:   location.Latitude(),
+		Longitude:  location.Longitude(),
+		Altitude:   location.Altitude(),
+		Timestamp:  location.Timestamp(),
+		SpeedKmh:   location.SpeedKmh(),
+		Heading:    location.Heading(),
+		Satellites: location.Satellites(),
+		HDOP:       location.HDOP(),
+		UpdatedAt:  v.updatedAt.Unix(),
+		Version:    v.version.Value(),
+	}
 }
 
 func (v *Vehicle) UpdateMileage(newMileage valueobject.Mileage) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
 	if newMileage.Equals(v.mileage) {
 		return nil
 	}
+	if newMileage.Kilometers() < v.mileage.Kilometers() {
+		return fmt.Errorf("%w: %.1f km is below the current %.1f km",
+			ErrMileageDecreased, newMileage.Kilometers(), v.mileage.Kilometers())
+	}
 
 	v.mileage = newMileage
 	v.updatedAt = time.Now().UTC()
 	v.version = v.version.Next()
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleMileageUpdatedEvent{
+		TenantID:  v.tenantID,
 		VehicleID: v.id.String(),
 		Mileage:   float64(newMileage.Kilometers()),
 		UpdatedAt: v.updatedAt.Unix(),
@@ -167,40 +345,90 @@ func (v *Vehicle) UpdateMileage(newMileage valueobject.Mileage) error {
 	return nil
 }
 
-func (v *Vehicle) UpdateFuelLevel(fuelLevel valueobject.FuelLevel) error {
-	if fuelLevel.Equals(v.fuelLevel) {
+// UpdateEnergyLevel records a fuel level, or battery state of charge for a
+// BEV, with the charging state and estimated range when the vehicle reports
+// them. An empty charging state or nil range keeps the current one. Only
+// hybrids and BEVs have a charging state.
+func (v *Vehicle) UpdateEnergyLevel(
+	level valueobject.FuelLevel,
+	charging valueobject.ChargingState,
+	estimatedRange *valueobject.Mileage,
+) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
+	if charging != "" && !v.energySource.Type().CanCharge() {
+		return fmt.Errorf("%w: %s vehicle", ErrVehicleNotChargeable, v.energySource.Type())
+	}
+	if charging == "" {
+		charging = v.chargingState
+	}
+	if estimatedRange == nil {
+		estimatedRange = v.estimatedRange
+	}
+	if level.Equals(v.fuelLevel) && charging == v.chargingState && sameRange(estimatedRange, v.estimatedRange) {
 		return nil // No change
 	}
 
-	v.fuelLevel = fuelLevel
+	v.fuelLevel = level
+	v.chargingState = charging
+	v.estimatedRange = estimatedRange
 	v.updatedAt = time.Now().UTC()
 	v.version = v.version.Next()
 
-	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleFuelLevelUpdatedEvent{
-		VehicleID: v.id.String(),
-		FuelLevel: float64(fuelLevel.Percentage()),
-		IsLow:     fuelLevel.IsLow(),
-		UpdatedAt: v.updatedAt.Unix(),
-		Version:   v.version.Value(),
-	})
+	evt := &event.VehicleEnergyLevelUpdatedEvent{
+		TenantID:      v.tenantID,
+		VehicleID:     v.id.String(),
+		EnergyType:    string(v.energySource.Type()),
+		Level:         level.Percentage(),
+		ChargingState: string(charging),
+		UpdatedAt:     v.updatedAt.Unix(),
+		Version:       v.version.Value(),
+	}
+	if estimatedRange != nil {
+		km := estimatedRange.Kilometers()
+		evt.EstimatedRangeKm = &km
+	}
+	v.uncommittedEvents = append(v.uncommittedEvents, evt)
 
 	return nil
 }
 
-func (v *Vehicle) ChangeStatus(newStatus valueobject.VehicleStatus) error {
+func sameRange(a, b *valueobject.Mileage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equals(*b)
+}
+
+func (v *Vehicle) ChangeStatus(
+	newStatus valueobject.VehicleStatus,
+	reason valueobject.StatusChangeReason,
+	actor valueobject.Actor,
+) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
 	if newStatus == v.status {
 		return nil
 	}
 
+	if !v.status.CanTransitionTo(newStatus) {
+		return &StatusTransitionError{From: v.status, To: newStatus}
+	}
+
 	oldStatus := v.status
 	v.status = newStatus
 	v.updatedAt = time.Now().UTC()
 	v.version = v.version.Next()
 
 	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleStatusChangedEvent{
+		TenantID:  v.tenantID,
 		VehicleID: v.id.String(),
 		OldStatus: string(oldStatus),
 		NewStatus: string(newStatus),
+		Reason:    string(reason),
+		Actor:     actor.String(),
 		ChangedAt: v.updatedAt.Unix(),
 		Version:   v.version.Value(),
 	})
@@ -208,6 +436,316 @@ func (v *Vehicle) ChangeStatus(newStatus valueobject.VehicleStatus) error {
 	return nil
 }
 
+// ApplyCorrection overrides a mileage or fuel reading on an operator's say-so.
+// Unlike the telemetry updates it skips the monotonic mileage check, and it
+// returns the audit record of the change.
+func (v *Vehicle) ApplyCorrection(
+	id valueobject.TelemetryCorrectionID,
+	field valueobject.CorrectionField,
+	value float64,
+	reason string,
+	actor valueobject.Actor,
+) (*TelemetryCorrection, error) {
+	if v.IsDeleted() {
+		return nil, ErrVehicleDeleted
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrCorrectionReasonRequired
+	}
+
+	var oldValue float64
+	switch field {
+	case valueobject.CorrectionMileage:
+		mileage, err := valueobject.NewMileage(value)
+		if err != nil {
+			return nil, err
+		}
+		if mileage.Equals(v.mileage) {
+			return nil, ErrCorrectionUnchanged
+		}
+		oldValue = v.mileage.Kilometers()
+		v.mileage = mileage
+	case valueobject.CorrectionFuelLevel:
+		fuelLevel, err := valueobject.NewFuelLevel(value)
+		if err != nil {
+			return nil, err
+		}
+		if fuelLevel.Equals(v.fuelLevel) {
+			return nil, ErrCorrectionUnchanged
+		}
+		oldValue = v.fuelLevel.Percentage()
+		v.fuelLevel = fuelLevel
+	default:
+		return nil, fmt.Errorf("%w: %s", valueobject.ErrInvalidCorrectionField, field)
+	}
+
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	correction := LoadTelemetryCorrectionFromHistory(id, v.tenantID, v.id, field, oldValue, value, reason, actor, v.updatedAt)
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.TrackingCorrectionAppliedEvent{
+		TenantID:     v.tenantID,
+		CorrectionID: id.String(),
+		VehicleID:    v.id.String(),
+		Field:        string(field),
+		OldValue:     strconv.FormatFloat(oldValue, 'f', -1, 64),
+		NewValue:     strconv.FormatFloat(value, 'f', -1, 64),
+		Reason:       reason,
+		Actor:        actor.String(),
+		Timestamp:    v.updatedAt.Unix(),
+		Version:      v.version.Value(),
+	})
+
+	return correction, nil
+}
+
+// UpdateDetails renames, re-models or re-plates the vehicle and replaces its
+// custom attributes with the given, already validated set. The emitted event
+// carries only the fields and attributes that actually changed; it reports
+// whether there were any.
+func (v *Vehicle) UpdateDetails(
+	vehicleName string,
+	vehicleModel string,
+	licenseNumber valueobject.LicenseNumber,
+	attributes map[string]interface{},
+	actor valueobject.Actor,
+) (bool, error) {
+	if v.IsDeleted() {
+		return false, ErrVehicleDeleted
+	}
+	if strings.TrimSpace(vehicleName) == "" {
+		return false, fmt.Errorf("%w: vehicle name cannot be empty", ErrInvalidVehicleDetails)
+	}
+	if strings.TrimSpace(vehicleModel) == "" {
+		return false, fmt.Errorf("%w: vehicle model cannot be empty", ErrInvalidVehicleDetails)
+	}
+
+	oldValues := map[string]string{}
+	newValues := map[string]string{}
+	if vehicleName != v.vehicleName {
+		oldValues["vehicleName"] = v.vehicleName
+		newValues["vehicleName"] = vehicleName
+	}
+	if vehicleModel != v.vehicleModel {
+		oldValues["vehicleModel"] = v.vehicleModel
+		newValues["vehicleModel"] = vehicleModel
+	}
+	if !licenseNumber.Equals(v.licenseNumber) {
+		oldValues["licenseNumber"] = v.licenseNumber.String()
+		newValues["licenseNumber"] = licenseNumber.String()
+	}
+	oldAttributes, newAttributes := diffAttributes(v.attributes, attributes)
+	if len(newValues) == 0 && len(newAttributes) == 0 {
+		return false, nil
+	}
+
+	v.vehicleName = vehicleName
+	v.vehicleModel = vehicleModel
+	v.licenseNumber = licenseNumber
+	v.attributes = copyAttributes(attributes)
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleDetailsUpdatedEvent{
+		TenantID:      v.tenantID,
+		VehicleID:     v.id.String(),
+		OldValues:     oldValues,
+		NewValues:     newValues,
+		OldAttributes: oldAttributes,
+		NewAttributes: newAttributes,
+		Actor:         actor.String(),
+		UpdatedAt:     v.updatedAt.Unix(),
+		Version:       v.version.Value(),
+	})
+
+	return true, nil
+}
+
+// AssignGroup moves the vehicle into group, or out of any group when group is
+// the zero value. Assigning the current group is a no-op.
+func (v *Vehicle) AssignGroup(group valueobject.GroupName, actor valueobject.Actor) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
+	if group.String() == v.group {
+		return nil
+	}
+
+	oldGroup := v.group
+	v.group = group.String()
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleGroupChangedEvent{
+		TenantID:  v.tenantID,
+		VehicleID: v.id.String(),
+		OldGroup:  oldGroup,
+		NewGroup:  v.group,
+		Actor:     actor.String(),
+		ChangedAt: v.updatedAt.Unix(),
+		Version:   v.version.Value(),
+	})
+
+	return nil
+}
+
+// UpdateTags adds and removes tags in one change; a tag in both lists ends up
+// removed. It is a no-op when the tag set does not change.
+func (v *Vehicle) UpdateTags(add, remove []valueobject.Tag, actor valueobject.Actor) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
+
+	set := make(map[string]bool, len(v.tags)+len(add))
+	for _, t := range v.tags {
+		set[t] = true
+	}
+	for _, tag := range add {
+		set[tag.String()] = true
+	}
+	for _, tag := range remove {
+		delete(set, tag.String())
+	}
+	tags := make([]string, 0, len(set))
+	for t := range set {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+
+	added, dropped := diffTags(v.tags, tags)
+	if len(added) == 0 && len(dropped) == 0 {
+		return nil
+	}
+
+	v.tags = tags
+	v.updatedAt = time.Now().UTC()
+	v.version = v.version.Next()
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleTagsChangedEvent{
+		TenantID:  v.tenantID,
+		VehicleID: v.id.String(),
+		Tags:      v.Tags(),
+		Added:     added,
+		Removed:   dropped,
+		Actor:     actor.String(),
+		ChangedAt: v.updatedAt.Unix(),
+		Version:   v.version.Value(),
+	})
+
+	return nil
+}
+
+// diffAttributes returns the changed attributes before and after; an attribute
+// unset on one side maps to nil there. Both maps are nil when nothing changed.
+func diffAttributes(old, next map[string]interface{}) (before, after map[string]interface{}) {
+	for name, value := range next {
+		if oldValue, ok := old[name]; !ok || oldValue != value {
+			if before == nil {
+				before, after = map[string]interface{}{}, map[string]interface{}{}
+			}
+			before[name] = old[name]
+			after[name] = value
+		}
+	}
+	for name, value := range old {
+		if _, ok := next[name]; !ok {
+			if before == nil {
+				before, after = map[string]interface{}{}, map[string]interface{}{}
+			}
+			before[name] = value
+			after[name] = nil
+		}
+	}
+	return before, after
+}
+
+func copyAttributes(attributes map[string]interface{}) map[string]interface{} {
+	if len(attributes) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(attributes))
+	for name, value := range attributes {
+		result[name] = value
+	}
+	return result
+}
+
+func diffTags(old, next []string) (added, removed []string) {
+	inOld := make(map[string]bool, len(old))
+	for _, t := range old {
+		inOld[t] = true
+	}
+	inNext := make(map[string]bool, len(next))
+	for _, t := range next {
+		inNext[t] = true
+		if !inOld[t] {
+			added = append(added, t)
+		}
+	}
+	for _, t := range old {
+		if !inNext[t] {
+			removed = append(removed, t)
+		}
+	}
+	return added, removed
+}
+
+// Delete soft-deletes the vehicle. The record is kept so it can be restored
+// within the grace period, but it no longer accepts telemetry or changes.
+func (v *Vehicle) Delete(reason string, actor valueobject.Actor) error {
+	if v.IsDeleted() {
+		return ErrVehicleDeleted
+	}
+	if strings.TrimSpace(reason) == "" {
+		return ErrDeletionReasonRequired
+	}
+
+	now := time.Now().UTC()
+	v.deletedAt = &now
+	v.deletionReason = reason
+	v.updatedAt = now
+	v.version = v.version.Next()
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleDeletedEvent{
+		TenantID:  v.tenantID,
+		VehicleID: v.id.String(),
+		Reason:    reason,
+		Actor:     actor.String(),
+		DeletedAt: now.Unix(),
+		Version:   v.version.Value(),
+	})
+
+	return nil
+}
+
+// Restore undoes a soft delete made less than gracePeriod ago.
+func (v *Vehicle) Restore(actor valueobject.Actor, gracePeriod time.Duration) error {
+	if !v.IsDeleted() {
+		return ErrVehicleNotDeleted
+	}
+
+	now := time.Now().UTC()
+	if now.Sub(*v.deletedAt) > gracePeriod {
+		return fmt.Errorf("%w: deleted at %s", ErrRestoreWindowExpired, v.deletedAt.Format(time.RFC3339))
+	}
+
+	v.deletedAt = nil
+	v.deletionReason = ""
+	v.updatedAt = now
+	v.version = v.version.Next()
+
+	v.uncommittedEvents = append(v.uncommittedEvents, &event.VehicleRestoredEvent{
+		TenantID:   v.tenantID,
+		VehicleID:  v.id.String(),
+		Actor:      actor.String(),
+		RestoredAt: now.Unix(),
+		Version:    v.version.Value(),
+	})
+
+	return nil
+}
+
 func (v *Vehicle) UncommittedEvents() []interface{} {
 	events := v.uncommittedEvents
 	v.uncommittedEvents = []interface{}{}
@@ -216,7 +754,8 @@ func (v *Vehicle) UncommittedEvents() []interface{} {
 
 func LoadFromHistory(
 	id valueobject.VehicleID,
-	vin string,
+	tenantID string,
+	vin valueobject.VIN,
 	vehicleName string,
 	vehicleModel string,
 	licenseNumber valueobject.LicenseNumber,
@@ -224,11 +763,20 @@ func LoadFromHistory(
 	location valueobject.Location,
 	mileage valueobject.Mileage,
 	fuelLevel valueobject.FuelLevel,
+	energySource valueobject.EnergySource,
+	chargingState valueobject.ChargingState,
+	estimatedRange *valueobject.Mileage,
 	version valueobject.Version,
 	createdAt, updatedAt time.Time,
+	deletedAt *time.Time,
+	deletionReason string,
+	group string,
+	tags []string,
+	attributes map[string]interface{},
 ) *Vehicle {
 	return &Vehicle{
 		id:              id,
+		tenantID:        tenantID,
 		vin:             vin,
 		vehicleName:     vehicleName,
 		vehicleModel:    vehicleModel,
@@ -237,8 +785,16 @@ func LoadFromHistory(
 		currentLocation: location,
 		mileage:         mileage,
 		fuelLevel:       fuelLevel,
+		energySource:    energySource,
+		chargingState:   chargingState,
+		estimatedRange:  estimatedRange,
 		version:         version,
 		createdAt:       createdAt,
 		updatedAt:       updatedAt,
+		deletedAt:       deletedAt,
+		deletionReason:  deletionReason,
+		group:           group,
+		tags:            tags,
+		attributes:      attributes,
 	}
 }
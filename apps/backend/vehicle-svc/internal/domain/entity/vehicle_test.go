@@ -0,0 +1,338 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/event"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+func newTestVehicle(t *testing.T, status valueobject.VehicleStatus) *Vehicle {
+	t.Helper()
+	license, err := valueobject.NewLicenseNumber("ABC-123")
+	require.NoError(t, err)
+	location, err := valueobject.NewLocation(10, 20, 0, 0)
+	require.NoError(t, err)
+	mileage, err := valueobject.NewMileage(1000)
+	require.NoError(t, err)
+	fuel, err := valueobject.NewFuelLevel(50)
+	require.NoError(t, err)
+
+	vin, err := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	require.NoError(t, err)
+
+	v, err := NewVehicle(valueobject.GenerateVehicleID(), "fleet-a", vin, "Truck", "Model", license, status, location, mileage, fuel, valueobject.EnergySource{}, nil)
+	require.NoError(t, err)
+	v.UncommittedEvents()
+	return v
+}
+
+func TestChangeStatus_AllowedTransitionEmitsReasonAndActor(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+
+	err := v.ChangeStatus(valueobject.StatusMaintenance, valueobject.ReasonScheduledService, actor)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.StatusMaintenance, v.Status())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	evt, ok := events[0].(*event.VehicleStatusChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "active", evt.OldStatus)
+	assert.Equal(t, "maintenance", evt.NewStatus)
+	assert.Equal(t, "scheduled_service", evt.Reason)
+	assert.Equal(t, "ops@fleet", evt.Actor)
+	assert.Equal(t, "fleet-a", evt.TenantID)
+}
+
+func TestChangeStatus_RetiredIsTerminal(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusRetired)
+	actor, _ := valueobject.NewActor("ops@fleet")
+
+	for _, next := range []valueobject.VehicleStatus{
+		valueobject.StatusActive,
+		valueobject.StatusInactive,
+		valueobject.StatusMaintenance,
+	} {
+		err := v.ChangeStatus(next, valueobject.ReasonReactivated, actor)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidStatusTransition))
+		assert.True(t, errors.Is(err, ErrVehicleRetired))
+	}
+
+	assert.Equal(t, valueobject.StatusRetired, v.Status())
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestChangeStatus_SameStatusIsNoop(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusInactive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+
+	require.NoError(t, v.ChangeStatus(valueobject.StatusInactive, valueobject.ReasonIdle, actor))
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestUpdateMileage_RejectsDecrease(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	lower, _ := valueobject.NewMileage(900)
+
+	err := v.UpdateMileage(lower)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrMileageDecreased))
+	assert.Equal(t, 1000.0, v.Mileage().Kilometers())
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestUpdateLocation_ArchivesOlderPosition(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	current, _ := valueobject.NewLocation(11, 21, 0, 2000)
+	outcome, err := v.UpdateLocation(current)
+	require.NoError(t, err)
+	require.Equal(t, LocationApplied, outcome)
+	v.UncommittedEvents()
+	version := v.Version().Value()
+
+	older, _ := valueobject.NewLocation(12, 22, 0, 1000)
+	outcome, err = v.UpdateLocation(older)
+	require.NoError(t, err)
+	assert.Equal(t, LocationArchived, outcome)
+	assert.Equal(t, current, v.CurrentLocation())
+	assert.Equal(t, version, v.Version().Value())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	archived, ok := events[0].(*event.VehicleLocationArchivedEvent)
+	require.True(t, ok)
+	assert.Equal(t, int64(1000), archived.Timestamp)
+	assert.Equal(t, 12.0, archived.Latitude)
+}
+
+func TestApplyCorrection_BypassesMonotonicCheck(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	id := valueobject.GenerateTelemetryCorrectionID()
+
+	correction, err := v.ApplyCorrection(id, valueobject.CorrectionMileage, 900, "odometer glitch", actor)
+	require.NoError(t, err)
+	assert.Equal(t, 900.0, v.Mileage().Kilometers())
+	assert.Equal(t, 1000.0, correction.OldValue())
+	assert.Equal(t, 900.0, correction.NewValue())
+	assert.Equal(t, "ops@fleet", correction.Actor().String())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	evt, ok := events[0].(*event.TrackingCorrectionAppliedEvent)
+	require.True(t, ok)
+	assert.Equal(t, id.String(), evt.CorrectionID)
+	assert.Equal(t, "mileage", evt.Field)
+	assert.Equal(t, "1000", evt.OldValue)
+	assert.Equal(t, "900", evt.NewValue)
+	assert.Equal(t, "odometer glitch", evt.Reason)
+}
+
+func TestApplyCorrection_RequiresReasonAndChange(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+
+	_, err := v.ApplyCorrection(valueobject.GenerateTelemetryCorrectionID(), valueobject.CorrectionFuelLevel, 40, " ", actor)
+	assert.ErrorIs(t, err, ErrCorrectionReasonRequired)
+
+	_, err = v.ApplyCorrection(valueobject.GenerateTelemetryCorrectionID(), valueobject.CorrectionFuelLevel, 50, "sensor drift", actor)
+	assert.ErrorIs(t, err, ErrCorrectionUnchanged)
+
+	assert.Equal(t, 50.0, v.FuelLevel().Percentage())
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestDelete_BlocksChangesUntilRestored(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+
+	assert.ErrorIs(t, v.Delete(" ", actor), ErrDeletionReasonRequired)
+
+	require.NoError(t, v.Delete("sold", actor))
+	assert.True(t, v.IsDeleted())
+	assert.Equal(t, "sold", v.DeletionReason())
+	assert.ErrorIs(t, v.Delete("sold", actor), ErrVehicleDeleted)
+
+	mileage, _ := valueobject.NewMileage(1200)
+	assert.ErrorIs(t, v.UpdateMileage(mileage), ErrVehicleDeleted)
+
+	require.NoError(t, v.Restore(actor, time.Hour))
+	assert.False(t, v.IsDeleted())
+	assert.Empty(t, v.DeletionReason())
+	assert.ErrorIs(t, v.Restore(actor, time.Hour), ErrVehicleNotDeleted)
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 2)
+	deleted, ok := events[0].(*event.VehicleDeletedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "sold", deleted.Reason)
+	assert.Equal(t, "ops@fleet", deleted.Actor)
+	_, ok = events[1].(*event.VehicleRestoredEvent)
+	assert.True(t, ok)
+}
+
+func TestRestore_RejectsAfterGracePeriod(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	require.NoError(t, v.Delete("written off", actor))
+
+	err := v.Restore(actor, -time.Second)
+	assert.ErrorIs(t, err, ErrRestoreWindowExpired)
+	assert.True(t, v.IsDeleted())
+}
+
+func TestUpdateDetails_EmitsOnlyChangedFields(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	plate, _ := valueobject.NewLicenseNumber("XYZ-789")
+
+	changed, err := v.UpdateDetails("Truck", "Model", plate, nil, actor)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "XYZ-789", v.LicenseNumber().String())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	evt, ok := events[0].(*event.VehicleDetailsUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"licenseNumber": "ABC-123"}, evt.OldValues)
+	assert.Equal(t, map[string]string{"licenseNumber": "XYZ-789"}, evt.NewValues)
+	assert.Equal(t, "ops@fleet", evt.Actor)
+
+	version := v.Version()
+	changed, err = v.UpdateDetails("Truck", "Model", plate, nil, actor)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, version, v.Version())
+	_, err = v.UpdateDetails(" ", "Model", plate, nil, actor)
+	assert.ErrorIs(t, err, ErrInvalidVehicleDetails)
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestUpdateDetails_EmitsAttributeDiff(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	plate := v.LicenseNumber()
+
+	_, err := v.UpdateDetails("Truck", "Model", plate, map[string]interface{}{"costCenter": "CC-1", "axles": int64(2)}, actor)
+	require.NoError(t, err)
+	v.UncommittedEvents()
+
+	_, err = v.UpdateDetails("Truck", "Model", plate, map[string]interface{}{"axles": int64(3)}, actor)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"axles": int64(3)}, v.Attributes())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	evt := events[0].(*event.VehicleDetailsUpdatedEvent)
+	assert.Empty(t, evt.NewValues)
+	assert.Equal(t, map[string]interface{}{"costCenter": "CC-1", "axles": int64(2)}, evt.OldAttributes)
+	assert.Equal(t, map[string]interface{}{"costCenter": nil, "axles": int64(3)}, evt.NewAttributes)
+
+	changed, err := v.UpdateDetails("Truck", "Model", plate, map[string]interface{}{"axles": int64(3)}, actor)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, v.UncommittedEvents())
+}
+
+func TestAssignGroup_EmitsOldAndNewGroup(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	north, err := valueobject.NewGroupName(" North Depot ")
+	require.NoError(t, err)
+
+	require.NoError(t, v.AssignGroup(north, actor))
+	require.NoError(t, v.AssignGroup(north, actor))
+	assert.Equal(t, "North Depot", v.Group())
+
+	require.NoError(t, v.AssignGroup(valueobject.GroupName{}, actor))
+	assert.Empty(t, v.Group())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 2)
+	joined, ok := events[0].(*event.VehicleGroupChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "", joined.OldGroup)
+	assert.Equal(t, "North Depot", joined.NewGroup)
+	assert.Equal(t, "fleet-a", joined.TenantID)
+	left, ok := events[1].(*event.VehicleGroupChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "North Depot", left.OldGroup)
+	assert.Equal(t, "", left.NewGroup)
+}
+
+func TestUpdateTags_NormalizesAndDiffs(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	actor, _ := valueobject.NewActor("ops@fleet")
+	add, err := valueobject.NewTags([]string{"Reefer", "hazmat", "reefer"})
+	require.NoError(t, err)
+
+	require.NoError(t, v.UpdateTags(add, nil, actor))
+	assert.Equal(t, []string{"hazmat", "reefer"}, v.Tags())
+
+	remove, _ := valueobject.NewTags([]string{"hazmat", "unknown"})
+	require.NoError(t, v.UpdateTags(nil, remove, actor))
+	require.NoError(t, v.UpdateTags(nil, remove, actor))
+	assert.Equal(t, []string{"reefer"}, v.Tags())
+
+	events := v.UncommittedEvents()
+	require.Len(t, events, 2)
+	removed, ok := events[1].(*event.VehicleTagsChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, []string{"reefer"}, removed.Tags)
+	assert.Empty(t, removed.Added)
+	assert.Equal(t, []string{"hazmat"}, removed.Removed)
+
+	_, err = valueobject.NewTags([]string{"cold chain"})
+	assert.ErrorIs(t, err, valueobject.ErrInvalidTag)
+}
+
+func TestUpdateEnergyLevel_ChargingStateNeedsPlugIn(t *testing.T) {
+	v := newTestVehicle(t, valueobject.StatusActive)
+	level, _ := valueobject.NewFuelLevel(80)
+
+	err := v.UpdateEnergyLevel(level, valueobject.ChargingInProgress, nil)
+	assert.ErrorIs(t, err, ErrVehicleNotChargeable)
+
+	rangeKm, _ := valueobject.NewMileage(320)
+	require.NoError(t, v.UpdateEnergyLevel(level, "", &rangeKm))
+	events := v.UncommittedEvents()
+	require.Len(t, events, 1)
+	evt, ok := events[0].(*event.VehicleEnergyLevelUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "ice", evt.EnergyType)
+	assert.Equal(t, 80.0, evt.Level)
+	require.NotNil(t, evt.EstimatedRangeKm)
+	assert.Equal(t, 320.0, *evt.EstimatedRangeKm)
+}
+
+func TestUpdateEnergyLevel_KeepsUnreportedFields(t *testing.T) {
+	license, _ := valueobject.NewLicenseNumber("EV-001")
+	location, _ := valueobject.NewLocation(10, 20, 0, 0)
+	mileage, _ := valueobject.NewMileage(100)
+	soc, _ := valueobject.NewFuelLevel(40)
+	vin, _ := valueobject.NewVIN("1HGBH41JXMN109186", valueobject.VINValidationStrict)
+	battery, err := valueobject.NewEnergySource(valueobject.EnergyBEV, 75)
+	require.NoError(t, err)
+	v, err := NewVehicle(valueobject.GenerateVehicleID(), "fleet-a", vin, "Van", "e-Model", license,
+		valueobject.StatusActive, location, mileage, soc, battery, nil)
+	require.NoError(t, err)
+	v.UncommittedEvents()
+
+	require.NoError(t, v.UpdateEnergyLevel(soc, valueobject.ChargingInProgress, nil))
+	higher, _ := valueobject.NewFuelLevel(55)
+	require.NoError(t, v.UpdateEnergyLevel(higher, "", nil))
+	assert.Equal(t, valueobject.ChargingInProgress, v.ChargingState())
+	assert.Len(t, v.UncommittedEvents(), 2)
+
+	require.NoError(t, v.UpdateEnergyLevel(higher, "", nil))
+	assert.Empty(t, v.UncommittedEvents())
+}
@@ -0,0 +1,25 @@
+package event
+
+type DevicePairedEvent struct {
+	BaseDomainEvent
+	TenantID   string `json:"tenantId"`
+	DeviceID   string `json:"deviceId"`
+	HardwareID string `json:"hardwareId"`
+	Protocol   string `json:"protocol"`
+	VehicleID  string `json:"vehicleId"`
+	PairedAt   int64  `json:"pairedAt"`
+	Version    int64  `json:"version"`
+}
+
+func NewDevicePairedEvent(tenantID, deviceID, hardwareID, protocol, vehicleID string, pairedAt, version int64) *DevicePairedEvent {
+	return &DevicePairedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("device.paired", deviceID),
+		TenantID:        tenantID,
+		DeviceID:        deviceID,
+		HardwareID:      hardwareID,
+		Protocol:        protocol,
+		VehicleID:       vehicleID,
+		PairedAt:        pairedAt,
+		Version:         version,
+	}
+}
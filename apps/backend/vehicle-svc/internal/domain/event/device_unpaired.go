@@ -0,0 +1,23 @@
+package event
+
+type DeviceUnpairedEvent struct {
+	BaseDomainEvent
+	TenantID   string `json:"tenantId"`
+	DeviceID   string `json:"deviceId"`
+	HardwareID string `json:"hardwareId"`
+	VehicleID  string `json:"vehicleId"`
+	UnpairedAt int64  `json:"unpairedAt"`
+	Version    int64  `json:"version"`
+}
+
+func NewDeviceUnpairedEvent(tenantID, deviceID, hardwareID, vehicleID string, unpairedAt, version int64) *DeviceUnpairedEvent {
+	return &DeviceUnpairedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("device.unpaired", deviceID),
+		TenantID:        tenantID,
+		DeviceID:        deviceID,
+		HardwareID:      hardwareID,
+		VehicleID:       vehicleID,
+		UnpairedAt:      unpairedAt,
+		Version:         version,
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+type DriverAssignedEvent struct {
+	BaseDomainEvent
+	TenantID   string `json:"tenantId"`
+	DriverID   string `json:"driverId"`
+	DriverName string `json:"driverName"`
+	VehicleID  string `json:"vehicleId"`
+	AssignedAt int64  `json:"assignedAt"`
+	Version    int64  `json:"version"`
+}
+
+func NewDriverAssignedEvent(tenantID, driverID, driverName, vehicleID string, assignedAt, version int64) *DriverAssignedEvent {
+	return &DriverAssignedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("driver.assigned", driverID),
+		TenantID:        tenantID,
+		DriverID:        driverID,
+		DriverName:      driverName,
+		VehicleID:       vehicleID,
+		AssignedAt:      assignedAt,
+		Version:         version,
+	}
+}
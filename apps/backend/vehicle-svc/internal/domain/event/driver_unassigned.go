@@ -0,0 +1,21 @@
+package event
+
+type DriverUnassignedEvent struct {
+	BaseDomainEvent
+	TenantID     string `json:"tenantId"`
+	DriverID     string `json:"driverId"`
+	VehicleID    string `json:"vehicleId"`
+	UnassignedAt int64  `json:"unassignedAt"`
+	Version      int64  `json:"version"`
+}
+
+func NewDriverUnassignedEvent(tenantID, driverID, vehicleID string, unassignedAt, version int64) *DriverUnassignedEvent {
+	return &DriverUnassignedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("driver.unassigned", driverID),
+		TenantID:        tenantID,
+		DriverID:        driverID,
+		VehicleID:       vehicleID,
+		UnassignedAt:    unassignedAt,
+		Version:         version,
+	}
+}
@@ -0,0 +1,33 @@
+package event
+
+type MaintenanceDueEvent struct {
+	BaseDomainEvent
+	TenantID       string  `json:"tenantId"`
+	TaskID         string  `json:"taskId"`
+	PlanID         string  `json:"planId"`
+	PlanName       string  `json:"planName"`
+	VehicleID      string  `json:"vehicleId"`
+	Status         string  `json:"status"` // due, overdue
+	DueMileage     float64 `json:"dueMileage,omitempty"`
+	DueAt          int64   `json:"dueAt,omitempty"`
+	CurrentMileage float64 `json:"currentMileage"`
+	RaisedAt       int64   `json:"raisedAt"`
+	Version        int64   `json:"version"`
+}
+
+func NewMaintenanceDueEvent(tenantID, taskID, planID, planName, vehicleID, status string, dueMileage float64, dueAt int64, currentMileage float64, raisedAt, version int64) *MaintenanceDueEvent {
+	return &MaintenanceDueEvent{
+		BaseDomainEvent: InitBaseDomainEvent("maintenance.due", taskID),
+		TenantID:        tenantID,
+		TaskID:          taskID,
+		PlanID:          planID,
+		PlanName:        planName,
+		VehicleID:       vehicleID,
+		Status:          status,
+		DueMileage:      dueMileage,
+		DueAt:           dueAt,
+		CurrentMileage:  currentMileage,
+		RaisedAt:        raisedAt,
+		Version:         version,
+	}
+}
@@ -0,0 +1,33 @@
+package event
+
+// TrackingCorrectionAppliedEvent keeps the snake_case field names that
+// tracking-svc already declares for this event.
+type TrackingCorrectionAppliedEvent struct {
+	BaseDomainEvent
+	TenantID     string `json:"tenantId"`
+	CorrectionID string `json:"correction_id"`
+	VehicleID    string `json:"vehicle_id"`
+	Field        string `json:"field"` // mileage, fuel_level
+	OldValue     string `json:"old_value"`
+	NewValue     string `json:"new_value"`
+	Reason       string `json:"reason"`
+	Actor        string `json:"actor"`
+	Timestamp    int64  `json:"timestamp"`
+	Version      int64  `json:"version"`
+}
+
+func NewTrackingCorrectionAppliedEvent(tenantID, correctionID, vehicleID, field, oldValue, newValue, reason, actor string, timestamp, version int64) *TrackingCorrectionAppliedEvent {
+	return &TrackingCorrectionAppliedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("tracking.correction.applied", vehicleID),
+		TenantID:        tenantID,
+		CorrectionID:    correctionID,
+		VehicleID:       vehicleID,
+		Field:           field,
+		OldValue:        oldValue,
+		NewValue:        newValue,
+		Reason:          reason,
+		Actor:           actor,
+		Timestamp:       timestamp,
+		Version:         version,
+	}
+}
@@ -2,22 +2,27 @@ package event
 
 type VehicleCreatedEvent struct {
 	BaseDomainEvent
-	VehicleID     string  `json:"vehicleId"`
-	VIN           string  `json:"vin"`
-	VehicleName   string  `json:"vehicleName"`
-	VehicleModel  string  `json:"vehicleModel"`
-	LicenseNumber string  `json:"licenseNumber"`
-	Status        string  `json:"status"`
-	Latitude      float64 `json:"latitude"`
-	Longitude     float64 `json:"longitude"`
-	Mileage       float64 `json:"mileage"`
-	FuelLevel     float64 `json:"fuelLevel"`
-	Timestamp     int64   `json:"timestamp"`
+	TenantID       string                 `json:"tenantId"`
+	VehicleID      string                 `json:"vehicleId"`
+	VIN            string                 `json:"vin"`
+	VehicleName    string                 `json:"vehicleName"`
+	VehicleModel   string                 `json:"vehicleModel"`
+	LicenseNumber  string                 `json:"licenseNumber"`
+	Status         string                 `json:"status"`
+	Latitude       float64                `json:"latitude"`
+	Longitude      float64                `json:"longitude"`
+	Mileage        float64                `json:"mileage"`
+	FuelLevel      float64                `json:"fuelLevel"`
+	EnergyType     string                 `json:"energyType"`
+	EnergyCapacity float64                `json:"energyCapacity,omitempty"` // liters, or kWh for a BEV
+	Timestamp      int64                  `json:"timestamp"`
+	Attributes     map[string]interface{} `json:"attributes,omitempty"` // custom attributes of the tenant's schema
 }
 
-func NewVehicleCreatedEvent(vehicleID, vin, vehicleName, vehicleModel, licenseNumber, status string, latitude, longitude float64, mileage, fuelLevel float64, timestamp int64) *VehicleCreatedEvent {
+func NewVehicleCreatedEvent(tenantID, vehicleID, vin, vehicleName, vehicleModel, licenseNumber, status string, latitude, longitude float64, mileage, fuelLevel float64, energyType string, energyCapacity float64, timestamp int64, attributes map[string]interface{}) *VehicleCreatedEvent {
 	return &VehicleCreatedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.created", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		VIN:             vin,
 		VehicleName:     vehicleName,
@@ -28,6 +33,9 @@ func NewVehicleCreatedEvent(vehicleID, vin, vehicleName, vehicleModel, licenseNu
 		Longitude:       longitude,
 		Mileage:         mileage,
 		FuelLevel:       fuelLevel,
+		EnergyType:      energyType,
+		EnergyCapacity:  energyCapacity,
 		Timestamp:       timestamp,
+		Attributes:      attributes,
 	}
 }
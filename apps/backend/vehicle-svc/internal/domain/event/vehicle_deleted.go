@@ -0,0 +1,23 @@
+package event
+
+type VehicleDeletedEvent struct {
+	BaseDomainEvent
+	TenantID  string `json:"tenantId"`
+	VehicleID string `json:"vehicleId"`
+	Reason    string `json:"reason"`
+	Actor     string `json:"actor"`
+	DeletedAt int64  `json:"deletedAt"`
+	Version   int64  `json:"version"`
+}
+
+func NewVehicleDeletedEvent(tenantID, vehicleID, reason, actor string, deletedAt, version int64) *VehicleDeletedEvent {
+	return &VehicleDeletedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.deleted", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		Reason:          reason,
+		Actor:           actor,
+		DeletedAt:       deletedAt,
+		Version:         version,
+	}
+}
@@ -0,0 +1,33 @@
+package event
+
+// VehicleDetailsUpdatedEvent carries a field-level diff keyed by the JSON field
+// name (vehicleName, vehicleModel, licenseNumber). Unchanged fields are absent.
+// Custom attributes are diffed the same way in OldAttributes and NewAttributes;
+// a nil value means the attribute is unset on that side.
+type VehicleDetailsUpdatedEvent struct {
+	BaseDomainEvent
+	TenantID      string                 `json:"tenantId"`
+	VehicleID     string                 `json:"vehicleId"`
+	OldValues     map[string]string      `json:"oldValues"`
+	NewValues     map[string]string      `json:"newValues"`
+	OldAttributes map[string]interface{} `json:"oldAttributes,omitempty"`
+	NewAttributes map[string]interface{} `json:"newAttributes,omitempty"`
+	Actor         string                 `json:"actor"`
+	UpdatedAt     int64                  `json:"updatedAt"`
+	Version       int64                  `json:"version"`
+}
+
+func NewVehicleDetailsUpdatedEvent(tenantID, vehicleID string, oldValues, newValues map[string]string, oldAttributes, newAttributes map[string]interface{}, actor string, updatedAt, version int64) *VehicleDetailsUpdatedEvent {
+	return &VehicleDetailsUpdatedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.details.updated", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		OldValues:       oldValues,
+		NewValues:       newValues,
+		OldAttributes:   oldAttributes,
+		NewAttributes:   newAttributes,
+		Actor:           actor,
+		UpdatedAt:       updatedAt,
+		Version:         version,
+	}
+}
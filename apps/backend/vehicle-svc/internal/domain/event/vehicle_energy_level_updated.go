@@ -0,0 +1,29 @@
+package event
+
+// VehicleEnergyLevelUpdatedEvent replaces the fuel level event. Level is the
+// fuel percentage, or the battery state of charge when EnergyType is bev.
+type VehicleEnergyLevelUpdatedEvent struct {
+	BaseDomainEvent
+	TenantID         string   `json:"tenantId"`
+	VehicleID        string   `json:"vehicleId"`
+	EnergyType       string   `json:"energyType"`
+	Level            float64  `json:"level"`
+	ChargingState    string   `json:"chargingState,omitempty"`
+	EstimatedRangeKm *float64 `json:"estimatedRangeKm,omitempty"`
+	UpdatedAt        int64    `json:"updatedAt"`
+	Version          int64    `json:"version"`
+}
+
+func NewVehicleEnergyLevelUpdatedEvent(tenantID, vehicleID, energyType string, level float64, chargingState string, estimatedRangeKm *float64, updatedAt, version int64) *VehicleEnergyLevelUpdatedEvent {
+	return &VehicleEnergyLevelUpdatedEvent{
+		BaseDomainEvent:  InitBaseDomainEvent("vehicle.energy.updated", vehicleID),
+		TenantID:         tenantID,
+		VehicleID:        vehicleID,
+		EnergyType:       energyType,
+		Level:            level,
+		ChargingState:    chargingState,
+		EstimatedRangeKm: estimatedRangeKm,
+		UpdatedAt:        updatedAt,
+		Version:          version,
+	}
+}
@@ -0,0 +1,27 @@
+package event
+
+// VehicleGroupChangedEvent moves a vehicle between groups. An empty OldGroup
+// or NewGroup means the vehicle was or is ungrouped.
+type VehicleGroupChangedEvent struct {
+	BaseDomainEvent
+	TenantID  string `json:"tenantId"`
+	VehicleID string `json:"vehicleId"`
+	OldGroup  string `json:"oldGroup"`
+	NewGroup  string `json:"newGroup"`
+	Actor     string `json:"actor"`
+	ChangedAt int64  `json:"changedAt"`
+	Version   int64  `json:"version"`
+}
+
+func NewVehicleGroupChangedEvent(tenantID, vehicleID, oldGroup, newGroup, actor string, changedAt, version int64) *VehicleGroupChangedEvent {
+	return &VehicleGroupChangedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.group.changed", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		OldGroup:        oldGroup,
+		NewGroup:        newGroup,
+		Actor:           actor,
+		ChangedAt:       changedAt,
+		Version:         version,
+	}
+}
@@ -0,0 +1,33 @@
+package event
+
+// VehicleLocationArchivedEvent carries a position older than the vehicle's
+// current one. It belongs in the vehicle's history but did not move it.
+type VehicleLocationArchivedEvent struct {
+	BaseDomainEvent
+	TenantID   string   `json:"tenantId"`
+	VehicleID  string   `json:"vehicleId"`
+	Latitude   float64  `json:"latitude"`
+	Longitude  float64  `json:"longitude"`
+	Altitude   float64  `json:"altitude"`
+	Timestamp  int64    `json:"timestamp"`
+	SpeedKmh   *float64 `json:"speedKmh,omitempty"`
+	Heading    *float64 `json:"heading,omitempty"`
+	Satellites *int     `json:"satellites,omitempty"`
+	HDOP       *float64 `json:"hdop,omitempty"`
+	ArchivedAt int64    `json:"archivedAt"`
+	Version    int64    `json:"version"`
+}
+
+func NewVehicleLocationArchivedEvent(tenantID, vehicleID string, latitude, longitude, altitude float64, timestamp, archivedAt, version int64) *VehicleLocationArchivedEvent {
+	return &VehicleLocationArchivedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.location.archived", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		Altitude:        altitude,
+		Timestamp:       timestamp,
+		ArchivedAt:      archivedAt,
+		Version:         version,
+	}
+}
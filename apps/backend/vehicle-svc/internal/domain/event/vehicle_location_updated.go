@@ -2,18 +2,24 @@ package event
 
 type VehicleLocationUpdatedEvent struct {
 	BaseDomainEvent
-	VehicleID string  `json:"vehicleId"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Altitude  float64 `json:"altitude"`
-	Timestamp int64   `json:"timestamp"`
-	UpdatedAt int64   `json:"updatedAt"`
-	Version   int64   `json:"version"`
+	TenantID   string   `json:"tenantId"`
+	VehicleID  string   `json:"vehicleId"`
+	Latitude   float64  `json:"latitude"`
+	Longitude  float64  `json:"longitude"`
+	Altitude   float64  `json:"altitude"`
+	Timestamp  int64    `json:"timestamp"`
+	SpeedKmh   *float64 `json:"speedKmh,omitempty"`
+	Heading    *float64 `json:"heading,omitempty"`
+	Satellites *int     `json:"satellites,omitempty"`
+	HDOP       *float64 `json:"hdop,omitempty"`
+	UpdatedAt  int64    `json:"updatedAt"`
+	Version    int64    `json:"version"`
 }
 
-func NewVehicleLocationUpdatedEvent(vehicleID string, latitude, longitude, altitude float64, timestamp, updatedAt, version int64) *VehicleLocationUpdatedEvent {
+func NewVehicleLocationUpdatedEvent(tenantID, vehicleID string, latitude, longitude, altitude float64, timestamp, updatedAt, version int64) *VehicleLocationUpdatedEvent {
 	return &VehicleLocationUpdatedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.location.updated", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		Latitude:        latitude,
 		Longitude:       longitude,
@@ -2,15 +2,17 @@ package event
 
 type VehicleMileageUpdatedEvent struct {
 	BaseDomainEvent
+	TenantID  string  `json:"tenantId"`
 	VehicleID string  `json:"vehicleId"`
 	Mileage   float64 `json:"mileage"`
 	UpdatedAt int64   `json:"updatedAt"`
 	Version   int64   `json:"version"`
 }
 
-func NewVehicleMileageUpdatedEvent(vehicleID string, mileage float64, updatedAt, version int64) *VehicleMileageUpdatedEvent {
+func NewVehicleMileageUpdatedEvent(tenantID, vehicleID string, mileage float64, updatedAt, version int64) *VehicleMileageUpdatedEvent {
 	return &VehicleMileageUpdatedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.mileage.updated", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		Mileage:         mileage,
 		UpdatedAt:       updatedAt,
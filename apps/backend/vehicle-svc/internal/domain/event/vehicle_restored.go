@@ -0,0 +1,21 @@
+package event
+
+type VehicleRestoredEvent struct {
+	BaseDomainEvent
+	TenantID   string `json:"tenantId"`
+	VehicleID  string `json:"vehicleId"`
+	Actor      string `json:"actor"`
+	RestoredAt int64  `json:"restoredAt"`
+	Version    int64  `json:"version"`
+}
+
+func NewVehicleRestoredEvent(tenantID, vehicleID, actor string, restoredAt, version int64) *VehicleRestoredEvent {
+	return &VehicleRestoredEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.restored", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		Actor:           actor,
+		RestoredAt:      restoredAt,
+		Version:         version,
+	}
+}
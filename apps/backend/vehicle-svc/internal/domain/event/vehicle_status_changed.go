@@ -2,19 +2,25 @@ package event
 
 type VehicleStatusChangedEvent struct {
 	BaseDomainEvent
+	TenantID  string `json:"tenantId"`
 	VehicleID string `json:"vehicleId"`
 	OldStatus string `json:"oldStatus"`
 	NewStatus string `json:"newStatus"`
+	Reason    string `json:"reason"`
+	Actor     string `json:"actor"`
 	ChangedAt int64  `json:"changedAt"`
 	Version   int64  `json:"version"`
 }
 
-func NewVehicleStatusChangedEvent(vehicleID, oldStatus, newStatus string, changedAt, version int64) *VehicleStatusChangedEvent {
+func NewVehicleStatusChangedEvent(tenantID, vehicleID, oldStatus, newStatus, reason, actor string, changedAt, version int64) *VehicleStatusChangedEvent {
 	return &VehicleStatusChangedEvent{
 		BaseDomainEvent: InitBaseDomainEvent("vehicle.status.changed", vehicleID),
+		TenantID:        tenantID,
 		VehicleID:       vehicleID,
 		OldStatus:       oldStatus,
 		NewStatus:       newStatus,
+		Reason:          reason,
+		Actor:           actor,
 		ChangedAt:       changedAt,
 		Version:         version,
 	}
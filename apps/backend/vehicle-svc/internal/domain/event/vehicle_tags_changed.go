@@ -0,0 +1,29 @@
+package event
+
+// VehicleTagsChangedEvent carries the full tag set after the change alongside
+// the tags added and removed by it.
+type VehicleTagsChangedEvent struct {
+	BaseDomainEvent
+	TenantID  string   `json:"tenantId"`
+	VehicleID string   `json:"vehicleId"`
+	Tags      []string `json:"tags"`
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Actor     string   `json:"actor"`
+	ChangedAt int64    `json:"changedAt"`
+	Version   int64    `json:"version"`
+}
+
+func NewVehicleTagsChangedEvent(tenantID, vehicleID string, tags, added, removed []string, actor string, changedAt, version int64) *VehicleTagsChangedEvent {
+	return &VehicleTagsChangedEvent{
+		BaseDomainEvent: InitBaseDomainEvent("vehicle.tags.changed", vehicleID),
+		TenantID:        tenantID,
+		VehicleID:       vehicleID,
+		Tags:            tags,
+		Added:           added,
+		Removed:         removed,
+		Actor:           actor,
+		ChangedAt:       changedAt,
+		Version:         version,
+	}
+}
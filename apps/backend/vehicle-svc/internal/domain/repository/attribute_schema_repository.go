@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+)
+
+type AttributeSchemaRepository interface {
+	Save(ctx context.Context, schema *entity.AttributeSchema) error
+
+	// Find returns the schema of the context tenant, or an empty one if the
+	// tenant has not defined any attributes.
+	Find(ctx context.Context) (*entity.AttributeSchema, error)
+}
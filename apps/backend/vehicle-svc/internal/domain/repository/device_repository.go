@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DeviceRepository interface {
+	Save(ctx context.Context, device *entity.Device) error
+
+	FindByID(ctx context.Context, id valueobject.DeviceID) (*entity.Device, error)
+
+	// FindByHardwareID returns nil without error when no device reports with
+	// the id.
+	FindByHardwareID(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error)
+
+	// FindByHardwareIDInAnyTenant ignores the tenant of ctx. Hardware ids are
+	// unique across tenants, and a gateway only learns a unit's tenant from
+	// the device it logs in as. Returns nil without error when none matches.
+	FindByHardwareIDInAnyTenant(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error)
+
+	FindAll(ctx context.Context, limit int, offset int) ([]*entity.Device, error)
+}
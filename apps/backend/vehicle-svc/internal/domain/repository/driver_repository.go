@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type DriverRepository interface {
+	Save(ctx context.Context, driver *entity.Driver) error
+
+	FindByID(ctx context.Context, id valueobject.DriverID) (*entity.Driver, error)
+
+	FindAll(ctx context.Context, limit int, offset int) ([]*entity.Driver, error)
+
+	// FindByAssignedVehicle returns nil without error when no driver is assigned.
+	FindByAssignedVehicle(ctx context.Context, vehicleID valueobject.VehicleID) (*entity.Driver, error)
+
+	Delete(ctx context.Context, id valueobject.DriverID) error
+
+	ExistsByLicenseNumber(ctx context.Context, licenseNumber string) (bool, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MaintenancePlanRepository interface {
+	Save(ctx context.Context, plan *entity.MaintenancePlan) error
+
+	FindByID(ctx context.Context, id valueobject.MaintenancePlanID) (*entity.MaintenancePlan, error)
+
+	FindAll(ctx context.Context, limit int, offset int) ([]*entity.MaintenancePlan, error)
+
+	FindByVehicleModel(ctx context.Context, vehicleModel string) ([]*entity.MaintenancePlan, error)
+
+	Delete(ctx context.Context, id valueobject.MaintenancePlanID) error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MaintenanceTaskRepository interface {
+	Save(ctx context.Context, task *entity.MaintenanceTask) error
+
+	FindByID(ctx context.Context, id valueobject.MaintenanceTaskID) (*entity.MaintenanceTask, error)
+
+	// FindOpen returns every task that is not completed yet, across the fleet.
+	FindOpen(ctx context.Context) ([]*entity.MaintenanceTask, error)
+
+	// FindDateDueInAnyTenant ignores the tenant of ctx and returns the open
+	// tasks whose due or overdue date has passed by now without their status
+	// showing it yet.
+	FindDateDueInAnyTenant(ctx context.Context, now time.Time) ([]*entity.MaintenanceTask, error)
+
+	// FindOpenByVehicleAndPlan returns nil without error when there is no open task.
+	FindOpenByVehicleAndPlan(ctx context.Context, vehicleID valueobject.VehicleID, planID valueobject.MaintenancePlanID) (*entity.MaintenanceTask, error)
+
+	// FindLastCompletedByVehicleAndPlan returns nil without error when the plan
+	// was never completed for the vehicle.
+	FindLastCompletedByVehicleAndPlan(ctx context.Context, vehicleID valueobject.VehicleID, planID valueobject.MaintenancePlanID) (*entity.MaintenanceTask, error)
+
+	DeleteOpenByPlan(ctx context.Context, planID valueobject.MaintenancePlanID) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type TelemetryCorrectionRepository interface {
+	Save(ctx context.Context, correction *entity.TelemetryCorrection) error
+
+	// FindByVehicleID returns the vehicle's corrections, newest first.
+	FindByVehicleID(ctx context.Context, vehicleID valueobject.VehicleID, limit int, offset int) ([]*entity.TelemetryCorrection, error)
+}
@@ -7,12 +7,27 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
+// VehicleFilter narrows FindAll. Zero values match everything.
+type VehicleFilter struct {
+	Deleted      bool   // soft-deleted vehicles instead of live ones
+	Manufacturer string // make decoded from the VIN, case-insensitive
+	ModelYear    int    // model year decoded from the VIN
+	Group        string // exact group name
+	Tag          string // normalized tag the vehicle must carry
+
+	// Attributes matches custom attributes exactly. Values must already be in
+	// the canonical form of the tenant's AttributeSchema.
+	Attributes map[string]interface{}
+}
+
 type VehicleRepository interface {
 	Save(ctx context.Context, vehicle *entity.Vehicle) error
 
 	FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error)
 
-	FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error)
+	// FindAll lists live vehicles unless filter.Deleted is set; FindByID
+	// returns either.
+	FindAll(ctx context.Context, filter VehicleFilter, limit int, offset int) ([]*entity.Vehicle, error)
 
 	Delete(ctx context.Context, id valueobject.VehicleID) error
 
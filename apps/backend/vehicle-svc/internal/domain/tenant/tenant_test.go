@@ -0,0 +1,19 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestID_DefaultsWhenUnscoped(t *testing.T) {
+	assert.Equal(t, DefaultID, ID(context.Background()))
+	assert.Equal(t, DefaultID, ID(WithID(context.Background(), " ")))
+}
+
+func TestWithID_ScopesContext(t *testing.T) {
+	ctx := WithID(context.Background(), "fleet-a")
+	assert.Equal(t, "fleet-a", ID(ctx))
+	assert.Equal(t, "fleet-b", ID(WithID(ctx, "fleet-b")))
+}
@@ -0,0 +1,179 @@
+package valueobject
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidAttributeDefinition = errors.New("invalid attribute definition")
+	ErrInvalidAttributeValue      = errors.New("invalid attribute value")
+)
+
+// AttributeType is the type of a custom vehicle attribute.
+type AttributeType string
+
+const (
+	AttributeString  AttributeType = "string"
+	AttributeNumber  AttributeType = "number"
+	AttributeInteger AttributeType = "integer"
+	AttributeBoolean AttributeType = "boolean"
+	AttributeEnum    AttributeType = "enum"
+)
+
+func NewAttributeType(attrType string) (AttributeType, error) {
+	t := AttributeType(attrType)
+	switch t {
+	case AttributeString, AttributeNumber, AttributeInteger, AttributeBoolean, AttributeEnum:
+		return t, nil
+	default:
+		return "", fmt.Errorf("%w: unknown type %q", ErrInvalidAttributeDefinition, attrType)
+	}
+}
+
+// attributeNamePattern keeps names usable as a document field path: no dots,
+// no leading dollar sign.
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,39}$`)
+
+// AttributeDefinition describes one custom vehicle attribute of a fleet.
+// Enum attributes hold one of a fixed set of strings.
+type AttributeDefinition struct {
+	name        string
+	attrType    AttributeType
+	required    bool
+	enum        []string
+	description string
+}
+
+func NewAttributeDefinition(name string, attrType AttributeType, required bool, enum []string, description string) (AttributeDefinition, error) {
+	if !attributeNamePattern.MatchString(name) {
+		return AttributeDefinition{}, fmt.Errorf("%w: name %q must start with a letter and contain only letters, digits and underscores", ErrInvalidAttributeDefinition, name)
+	}
+	if _, err := NewAttributeType(string(attrType)); err != nil {
+		return AttributeDefinition{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	if attrType != AttributeEnum {
+		if len(enum) > 0 {
+			return AttributeDefinition{}, fmt.Errorf("%w: %s is %s and cannot have enum values", ErrInvalidAttributeDefinition, name, attrType)
+		}
+	} else {
+		if len(enum) == 0 {
+			return AttributeDefinition{}, fmt.Errorf("%w: %s needs at least one enum value", ErrInvalidAttributeDefinition, name)
+		}
+		seen := make(map[string]bool, len(enum))
+		for _, value := range enum {
+			if strings.TrimSpace(value) == "" || seen[value] {
+				return AttributeDefinition{}, fmt.Errorf("%w: %s has an empty or duplicate enum value %q", ErrInvalidAttributeDefinition, name, value)
+			}
+			seen[value] = true
+		}
+	}
+
+	return AttributeDefinition{
+		name:        name,
+		attrType:    attrType,
+		required:    required,
+		enum:        append([]string(nil), enum...),
+		description: description,
+	}, nil
+}
+
+func (d AttributeDefinition) Name() string {
+	return d.name
+}
+
+func (d AttributeDefinition) Type() AttributeType {
+	return d.attrType
+}
+
+func (d AttributeDefinition) Required() bool {
+	return d.required
+}
+
+func (d AttributeDefinition) Enum() []string {
+	return append([]string(nil), d.enum...)
+}
+
+func (d AttributeDefinition) Description() string {
+	return d.description
+}
+
+// Coerce checks a decoded JSON or stored value against the definition and
+// returns it in canonical form: string, float64, int64 or bool.
+func (d AttributeDefinition) Coerce(value interface{}) (interface{}, error) {
+	switch d.attrType {
+	case AttributeString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case AttributeEnum:
+		if s, ok := value.(string); ok {
+			for _, allowed := range d.enum {
+				if s == allowed {
+					return s, nil
+				}
+			}
+			return nil, fmt.Errorf("%w: %s must be one of %s", ErrInvalidAttributeValue, d.name, strings.Join(d.enum, ", "))
+		}
+	case AttributeBoolean:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case AttributeNumber:
+		if f, ok := toFloat(value); ok {
+			return f, nil
+		}
+	case AttributeInteger:
+		if f, ok := toFloat(value); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+			return int64(f), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s must be %s", ErrInvalidAttributeValue, d.name, d.attrType)
+}
+
+// ParseFilter converts a query string value into the canonical form stored on
+// vehicles so it can be matched exactly.
+func (d AttributeDefinition) ParseFilter(raw string) (interface{}, error) {
+	switch d.attrType {
+	case AttributeNumber, AttributeInteger:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be %s", ErrInvalidAttributeValue, d.name, d.attrType)
+		}
+		return d.Coerce(f)
+	case AttributeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be boolean", ErrInvalidAttributeValue, d.name)
+		}
+		return b, nil
+	default:
+		return d.Coerce(raw)
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
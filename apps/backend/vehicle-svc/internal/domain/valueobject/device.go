@@ -0,0 +1,90 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidHardwareID     = errors.New("invalid hardware id")
+	ErrInvalidDeviceProtocol = errors.New("invalid device protocol")
+)
+
+type DeviceID struct {
+	value string
+}
+
+func NewDeviceID(id string) (DeviceID, error) {
+	if id == "" {
+		return DeviceID{}, fmt.Errorf("device id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return DeviceID{}, fmt.Errorf("invalid device id format: %w", err)
+	}
+	return DeviceID{value: id}, nil
+}
+
+func GenerateDeviceID() DeviceID {
+	return DeviceID{value: uuid.New().String()}
+}
+
+func (d DeviceID) String() string {
+	return d.value
+}
+
+func (d DeviceID) Equals(other DeviceID) bool {
+	return d.value == other.value
+}
+
+// hardwareIDPattern accepts a 15-digit IMEI as well as vendor serial numbers.
+var hardwareIDPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{3,31}$`)
+
+// HardwareID is the identifier a telematics unit reports itself with, usually
+// its IMEI or a vendor serial number. Serials are compared case-insensitively.
+type HardwareID struct {
+	value string
+}
+
+func NewHardwareID(id string) (HardwareID, error) {
+	hw := strings.ToUpper(strings.TrimSpace(id))
+	if !hardwareIDPattern.MatchString(hw) {
+		return HardwareID{}, fmt.Errorf("%w: %q must be 4 to 32 letters, digits, dashes or underscores", ErrInvalidHardwareID, id)
+	}
+	return HardwareID{value: hw}, nil
+}
+
+func (h HardwareID) String() string {
+	return h.value
+}
+
+func (h HardwareID) Equals(other HardwareID) bool {
+	return h.value == other.value
+}
+
+// DeviceProtocol is the wire protocol a telematics unit reports positions with.
+type DeviceProtocol string
+
+const (
+	ProtocolGT06   DeviceProtocol = "gt06"
+	ProtocolCodec8 DeviceProtocol = "codec8"
+	ProtocolMQTT   DeviceProtocol = "mqtt"
+	ProtocolHTTP   DeviceProtocol = "http"
+)
+
+func NewDeviceProtocol(protocol string) (DeviceProtocol, error) {
+	p := DeviceProtocol(strings.ToLower(strings.TrimSpace(protocol)))
+	switch p {
+	case ProtocolGT06, ProtocolCodec8, ProtocolMQTT, ProtocolHTTP:
+		return p, nil
+	default:
+		return "", fmt.Errorf("%w: %q must be one of gt06, codec8, mqtt, http", ErrInvalidDeviceProtocol, protocol)
+	}
+}
+
+func (p DeviceProtocol) String() string {
+	return string(p)
+}
@@ -0,0 +1,121 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidEnergyType    = errors.New("invalid energy type")
+	ErrInvalidChargingState = errors.New("invalid charging state")
+)
+
+// EnergyType is what propels a vehicle. For a BEV the fuel level is the
+// battery state of charge.
+type EnergyType string
+
+const (
+	EnergyICE    EnergyType = "ice" // internal combustion
+	EnergyHybrid EnergyType = "hybrid"
+	EnergyBEV    EnergyType = "bev" // battery electric
+)
+
+// NewEnergyType parses an energy type case-insensitively. An empty type means
+// ICE, which is what every vehicle was before energy types existed.
+func NewEnergyType(energyType string) (EnergyType, error) {
+	switch t := EnergyType(strings.ToLower(strings.TrimSpace(energyType))); t {
+	case "":
+		return EnergyICE, nil
+	case EnergyICE, EnergyHybrid, EnergyBEV:
+		return t, nil
+	default:
+		return "", fmt.Errorf("%w: %q (use ice, hybrid or bev)", ErrInvalidEnergyType, energyType)
+	}
+}
+
+// CanCharge reports whether the vehicle plugs in and so has a charging state.
+func (t EnergyType) CanCharge() bool {
+	return t == EnergyHybrid || t == EnergyBEV
+}
+
+// CapacityUnit is liters of fuel, or kWh of battery for a BEV.
+func (t EnergyType) CapacityUnit() string {
+	if t == EnergyBEV {
+		return "kWh"
+	}
+	return "L"
+}
+
+// EnergySource is a vehicle's energy type and the capacity of its tank or
+// battery, in CapacityUnit. A zero capacity means unknown.
+type EnergySource struct {
+	energyType EnergyType
+	capacity   float64
+}
+
+func NewEnergySource(energyType EnergyType, capacity float64) (EnergySource, error) {
+	if _, err := NewEnergyType(string(energyType)); err != nil {
+		return EnergySource{}, err
+	}
+	if capacity < 0 {
+		return EnergySource{}, fmt.Errorf("energy capacity cannot be negative: %f", capacity)
+	}
+	return EnergySource{energyType: energyType, capacity: capacity}, nil
+}
+
+// Type is ICE for the zero value.
+func (s EnergySource) Type() EnergyType {
+	if s.energyType == "" {
+		return EnergyICE
+	}
+	return s.energyType
+}
+
+func (s EnergySource) Capacity() float64 {
+	return s.capacity
+}
+
+// NewEnergySourceIn reads a capacity given in the client's unit system. Battery
+// capacity is always kWh.
+func NewEnergySourceIn(energyType EnergyType, capacity float64, units UnitSystem) (EnergySource, error) {
+	if energyType != EnergyBEV {
+		capacity = units.ToLiters(capacity)
+	}
+	return NewEnergySource(energyType, capacity)
+}
+
+// CapacityIn returns the capacity in the client's unit system.
+func (s EnergySource) CapacityIn(units UnitSystem) float64 {
+	if s.Type() == EnergyBEV {
+		return s.capacity
+	}
+	return units.FromLiters(s.capacity)
+}
+
+// CapacityUnitIn is the unit of CapacityIn.
+func (s EnergySource) CapacityUnitIn(units UnitSystem) string {
+	if s.Type() == EnergyBEV {
+		return s.Type().CapacityUnit()
+	}
+	return units.VolumeUnit()
+}
+
+// ChargingState is whether a plug-in vehicle is charging. The zero value means
+// not reported.
+type ChargingState string
+
+const (
+	ChargingDisconnected ChargingState = "disconnected"
+	ChargingInProgress   ChargingState = "charging"
+	ChargingComplete     ChargingState = "complete"
+)
+
+func NewChargingState(state string) (ChargingState, error) {
+	switch s := ChargingState(strings.ToLower(strings.TrimSpace(state))); s {
+	case "", ChargingDisconnected, ChargingInProgress, ChargingComplete:
+		return s, nil
+	default:
+		return "", fmt.Errorf("%w: %q (use disconnected, charging or complete)", ErrInvalidChargingState, state)
+	}
+}
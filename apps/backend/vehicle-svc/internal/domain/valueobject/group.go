@@ -0,0 +1,90 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidGroupName = errors.New("invalid group name")
+	ErrInvalidTag       = errors.New("invalid tag")
+)
+
+const (
+	maxGroupNameLength = 64
+	maxTagLength       = 32
+)
+
+// GroupName names a set of vehicles operated together, such as a depot. The
+// zero value means the vehicle belongs to no group.
+type GroupName struct {
+	value string
+}
+
+func NewGroupName(name string) (GroupName, error) {
+	group := strings.TrimSpace(name)
+	if group == "" {
+		return GroupName{}, fmt.Errorf("%w: group name cannot be empty", ErrInvalidGroupName)
+	}
+	if len(group) > maxGroupNameLength {
+		return GroupName{}, fmt.Errorf("%w: %q exceeds %d characters", ErrInvalidGroupName, group, maxGroupNameLength)
+	}
+	for _, r := range group {
+		if unicode.IsControl(r) || r == '/' {
+			return GroupName{}, fmt.Errorf("%w: %q has invalid character %q", ErrInvalidGroupName, group, r)
+		}
+	}
+	return GroupName{value: group}, nil
+}
+
+func (g GroupName) String() string {
+	return g.value
+}
+
+func (g GroupName) IsZero() bool {
+	return g.value == ""
+}
+
+func (g GroupName) Equals(other GroupName) bool {
+	return g.value == other.value
+}
+
+// Tag is a free-form lowercase label. Tags are compared case-insensitively, so
+// "Reefer" and "reefer" are the same tag.
+type Tag struct {
+	value string
+}
+
+func NewTag(tag string) (Tag, error) {
+	t := strings.ToLower(strings.TrimSpace(tag))
+	if t == "" {
+		return Tag{}, fmt.Errorf("%w: tag cannot be empty", ErrInvalidTag)
+	}
+	if len(t) > maxTagLength {
+		return Tag{}, fmt.Errorf("%w: %q exceeds %d characters", ErrInvalidTag, t, maxTagLength)
+	}
+	for _, r := range t {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' || r == ':') {
+			return Tag{}, fmt.Errorf("%w: %q has invalid character %q", ErrInvalidTag, t, r)
+		}
+	}
+	return Tag{value: t}, nil
+}
+
+func NewTags(tags []string) ([]Tag, error) {
+	result := make([]Tag, 0, len(tags))
+	for _, raw := range tags {
+		tag, err := NewTag(raw)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tag)
+	}
+	return result, nil
+}
+
+func (t Tag) String() string {
+	return t.value
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnitSystem(t *testing.T) {
+	units, err := NewUnitSystem(" Imperial ")
+	require.NoError(t, err)
+	assert.Equal(t, UnitsImperial, units)
+
+	units, err = NewUnitSystem("")
+	require.NoError(t, err)
+	assert.Equal(t, UnitsMetric, units)
+
+	_, err = NewUnitSystem("nautical")
+	assert.ErrorIs(t, err, ErrInvalidUnitSystem)
+}
+
+func TestMileage_StoresKilometersWhateverTheInputUnits(t *testing.T) {
+	mileage, err := NewMileageIn(100, UnitsImperial)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 160.9344, mileage.Kilometers(), 1e-9)
+	assert.Equal(t, 100.0, mileage.In(UnitsImperial))
+	assert.Equal(t, mileage.Kilometers(), mileage.In(UnitsMetric))
+
+	_, err = NewMileageIn(-1, UnitsImperial)
+	assert.Error(t, err)
+	assert.Equal(t, 10.0, UnitsImperial.FromLiters(UnitsImperial.ToLiters(10)))
+}
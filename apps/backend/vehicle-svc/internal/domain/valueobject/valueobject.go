@@ -1,7 +1,9 @@
 package valueobject
 
 import (
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/google/uuid"
 )
@@ -32,6 +34,32 @@ func (v VehicleID) Equals(other VehicleID) bool {
 	return v.value == other.value
 }
 
+type DriverID struct {
+	value string
+}
+
+func NewDriverID(id string) (DriverID, error) {
+	if id == "" {
+		return DriverID{}, fmt.Errorf("driver id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return DriverID{}, fmt.Errorf("invalid driver id format: %w", err)
+	}
+	return DriverID{value: id}, nil
+}
+
+func GenerateDriverID() DriverID {
+	return DriverID{value: uuid.New().String()}
+}
+
+func (d DriverID) String() string {
+	return d.value
+}
+
+func (d DriverID) Equals(other DriverID) bool {
+	return d.value == other.value
+}
+
 type VehicleStatus string
 
 const (
@@ -51,22 +79,255 @@ func NewVehicleStatus(status string) (VehicleStatus, error) {
 	}
 }
 
+// statusTransitions declares the allowed status graph. Retired is terminal.
+var statusTransitions = map[VehicleStatus][]VehicleStatus{
+	StatusActive:      {StatusInactive, StatusMaintenance, StatusRetired},
+	StatusInactive:    {StatusActive, StatusMaintenance, StatusRetired},
+	StatusMaintenance: {StatusActive, StatusInactive, StatusRetired},
+	StatusRetired:     {},
+}
+
+func (s VehicleStatus) CanTransitionTo(next VehicleStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s VehicleStatus) IsTerminal() bool {
+	return len(statusTransitions[s]) == 0
+}
+
+var (
+	ErrInvalidStatusChangeReason = errors.New("invalid status change reason")
+	ErrActorRequired             = errors.New("actor cannot be empty")
+)
+
+type StatusChangeReason string
+
+const (
+	ReasonScheduledService StatusChangeReason = "scheduled_service"
+	ReasonBreakdown        StatusChangeReason = "breakdown"
+	ReasonAccident         StatusChangeReason = "accident"
+	ReasonRepairCompleted  StatusChangeReason = "repair_completed"
+	ReasonIdle             StatusChangeReason = "idle"
+	ReasonReactivated      StatusChangeReason = "reactivated"
+	ReasonEndOfLife        StatusChangeReason = "end_of_life"
+	ReasonSold             StatusChangeReason = "sold"
+	ReasonOther            StatusChangeReason = "other"
+)
+
+func NewStatusChangeReason(reason string) (StatusChangeReason, error) {
+	r := StatusChangeReason(reason)
+	switch r {
+	case ReasonScheduledService, ReasonBreakdown, ReasonAccident, ReasonRepairCompleted,
+		ReasonIdle, ReasonReactivated, ReasonEndOfLife, ReasonSold, ReasonOther:
+		return r, nil
+	case "":
+		return "", fmt.Errorf("%w: reason cannot be empty", ErrInvalidStatusChangeReason)
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidStatusChangeReason, reason)
+	}
+}
+
+type Actor struct {
+	value string
+}
+
+func NewActor(actor string) (Actor, error) {
+	if actor == "" {
+		return Actor{}, ErrActorRequired
+	}
+	return Actor{value: actor}, nil
+}
+
+func (a Actor) String() string {
+	return a.value
+}
+
+type MaintenancePlanID struct {
+	value string
+}
+
+func NewMaintenancePlanID(id string) (MaintenancePlanID, error) {
+	if id == "" {
+		return MaintenancePlanID{}, fmt.Errorf("maintenance plan id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return MaintenancePlanID{}, fmt.Errorf("invalid maintenance plan id format: %w", err)
+	}
+	return MaintenancePlanID{value: id}, nil
+}
+
+func GenerateMaintenancePlanID() MaintenancePlanID {
+	return MaintenancePlanID{value: uuid.New().String()}
+}
+
+func (m MaintenancePlanID) String() string {
+	return m.value
+}
+
+func (m MaintenancePlanID) Equals(other MaintenancePlanID) bool {
+	return m.value == other.value
+}
+
+type MaintenanceTaskID struct {
+	value string
+}
+
+func NewMaintenanceTaskID(id string) (MaintenanceTaskID, error) {
+	if id == "" {
+		return MaintenanceTaskID{}, fmt.Errorf("maintenance task id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return MaintenanceTaskID{}, fmt.Errorf("invalid maintenance task id format: %w", err)
+	}
+	return MaintenanceTaskID{value: id}, nil
+}
+
+func GenerateMaintenanceTaskID() MaintenanceTaskID {
+	return MaintenanceTaskID{value: uuid.New().String()}
+}
+
+func (m MaintenanceTaskID) String() string {
+	return m.value
+}
+
+func (m MaintenanceTaskID) Equals(other MaintenanceTaskID) bool {
+	return m.value == other.value
+}
+
+// MaintenanceInterval is "every N km or M months, whichever comes first".
+// Either bound may be zero, but not both.
+type MaintenanceInterval struct {
+	kilometers float64
+	months     int
+}
+
+func NewMaintenanceInterval(kilometers float64, months int) (MaintenanceInterval, error) {
+	if kilometers < 0 {
+		return MaintenanceInterval{}, fmt.Errorf("maintenance interval kilometers cannot be negative: %f", kilometers)
+	}
+	if months < 0 {
+		return MaintenanceInterval{}, fmt.Errorf("maintenance interval months cannot be negative: %d", months)
+	}
+	if kilometers == 0 && months == 0 {
+		return MaintenanceInterval{}, fmt.Errorf("maintenance interval needs kilometers or months")
+	}
+	return MaintenanceInterval{kilometers: kilometers, months: months}, nil
+}
+
+func (m MaintenanceInterval) Kilometers() float64 {
+	return m.kilometers
+}
+
+func (m MaintenanceInterval) Months() int {
+	return m.months
+}
+
+func (m MaintenanceInterval) HasMileage() bool {
+	return m.kilometers > 0
+}
+
+func (m MaintenanceInterval) HasTime() bool {
+	return m.months > 0
+}
+
+type MaintenanceTaskStatus string
+
+const (
+	MaintenanceScheduled MaintenanceTaskStatus = "scheduled"
+	MaintenanceDue       MaintenanceTaskStatus = "due"
+	MaintenanceOverdue   MaintenanceTaskStatus = "overdue"
+	MaintenanceCompleted MaintenanceTaskStatus = "completed"
+)
+
+func NewMaintenanceTaskStatus(status string) (MaintenanceTaskStatus, error) {
+	s := MaintenanceTaskStatus(status)
+	switch s {
+	case MaintenanceScheduled, MaintenanceDue, MaintenanceOverdue, MaintenanceCompleted:
+		return s, nil
+	default:
+		return "", fmt.Errorf("invalid maintenance task status: %s", status)
+	}
+}
+
+func (s MaintenanceTaskStatus) IsOpen() bool {
+	return s != MaintenanceCompleted
+}
+
+type TelemetryCorrectionID struct {
+	value string
+}
+
+func NewTelemetryCorrectionID(id string) (TelemetryCorrectionID, error) {
+	if id == "" {
+		return TelemetryCorrectionID{}, fmt.Errorf("telemetry correction id cannot be empty")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return TelemetryCorrectionID{}, fmt.Errorf("invalid telemetry correction id format: %w", err)
+	}
+	return TelemetryCorrectionID{value: id}, nil
+}
+
+func GenerateTelemetryCorrectionID() TelemetryCorrectionID {
+	return TelemetryCorrectionID{value: uuid.New().String()}
+}
+
+func (t TelemetryCorrectionID) String() string {
+	return t.value
+}
+
+func (t TelemetryCorrectionID) Equals(other TelemetryCorrectionID) bool {
+	return t.value == other.value
+}
+
+var ErrInvalidCorrectionField = errors.New("invalid correction field")
+
+// CorrectionField names the telemetry reading an operator may override.
+type CorrectionField string
+
+const (
+	CorrectionMileage   CorrectionField = "mileage"
+	CorrectionFuelLevel CorrectionField = "fuel_level"
+)
+
+func NewCorrectionField(field string) (CorrectionField, error) {
+	f := CorrectionField(field)
+	switch f {
+	case CorrectionMileage, CorrectionFuelLevel:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidCorrectionField, field)
+	}
+}
+
+var ErrInvalidLocation = errors.New("invalid location")
+
+// Location is a position fix. Speed, heading and the GNSS quality fields are
+// optional and nil when the device did not report them.
 type Location struct {
-	latitude  float64
-	longitude float64
-	altitude  float64
-	timestamp int64
+	latitude   float64
+	longitude  float64
+	altitude   float64
+	timestamp  int64
+	speedKmh   *float64
+	heading    *float64 // degrees clockwise from north
+	satellites *int
+	hdop       *float64 // horizontal dilution of precision; lower is better
 }
 
 func NewLocation(latitude, longitude, altitude float64, timestamp int64) (Location, error) {
 	if latitude < -90 || latitude > 90 {
-		return Location{}, fmt.Errorf("invalid latitude: %f", latitude)
+		return Location{}, fmt.Errorf("%w: latitude %f", ErrInvalidLocation, latitude)
 	}
 	if longitude < -180 || longitude > 180 {
-		return Location{}, fmt.Errorf("invalid longitude: %f", longitude)
+		return Location{}, fmt.Errorf("%w: longitude %f", ErrInvalidLocation, longitude)
 	}
 	if timestamp < 0 {
-		return Location{}, fmt.Errorf("invalid timestamp: %d", timestamp)
+		return Location{}, fmt.Errorf("%w: timestamp %d", ErrInvalidLocation, timestamp)
 	}
 	return Location{
 		latitude:  latitude,
@@ -76,6 +337,44 @@ func NewLocation(latitude, longitude, altitude float64, timestamp int64) (Locati
 	}, nil
 }
 
+// WithMotion returns the location with the speed and heading the device
+// reported. A heading of 360 is normalized to 0.
+func (l Location) WithMotion(speedKmh, heading *float64) (Location, error) {
+	if speedKmh != nil && (*speedKmh < 0 || math.IsNaN(*speedKmh)) {
+		return Location{}, fmt.Errorf("%w: speed %f", ErrInvalidLocation, *speedKmh)
+	}
+	if heading != nil {
+		if *heading < 0 || *heading > 360 || math.IsNaN(*heading) {
+			return Location{}, fmt.Errorf("%w: heading %f", ErrInvalidLocation, *heading)
+		}
+		if *heading == 360 {
+			zero := 0.0
+			heading = &zero
+		}
+	}
+	l.speedKmh = copyFloat(speedKmh)
+	l.heading = copyFloat(heading)
+	return l, nil
+}
+
+// WithFixQuality returns the location with the satellite count and HDOP of
+// the fix.
+func (l Location) WithFixQuality(satellites *int, hdop *float64) (Location, error) {
+	if satellites != nil && *satellites < 0 {
+		return Location{}, fmt.Errorf("%w: satellite count %d", ErrInvalidLocation, *satellites)
+	}
+	if hdop != nil && (*hdop < 0 || math.IsNaN(*hdop)) {
+		return Location{}, fmt.Errorf("%w: hdop %f", ErrInvalidLocation, *hdop)
+	}
+	if satellites != nil {
+		n := *satellites
+		satellites = &n
+	}
+	l.satellites = satellites
+	l.hdop = copyFloat(hdop)
+	return l, nil
+}
+
 func (l Location) Latitude() float64 {
 	return l.latitude
 }
@@ -92,11 +391,51 @@ func (l Location) Timestamp() int64 {
 	return l.timestamp
 }
 
+func (l Location) SpeedKmh() *float64 {
+	return copyFloat(l.speedKmh)
+}
+
+func (l Location) Heading() *float64 {
+	return copyFloat(l.heading)
+}
+
+func (l Location) Satellites() *int {
+	if l.satellites == nil {
+		return nil
+	}
+	n := *l.satellites
+	return &n
+}
+
+func (l Location) HDOP() *float64 {
+	return copyFloat(l.hdop)
+}
+
 func (l Location) Equals(other Location) bool {
 	return l.latitude == other.latitude &&
 		l.longitude == other.longitude &&
 		l.altitude == other.altitude &&
-		l.timestamp == other.timestamp
+		l.timestamp == other.timestamp &&
+		equalFloat(l.speedKmh, other.speedKmh) &&
+		equalFloat(l.heading, other.heading) &&
+		equalFloat(l.hdop, other.hdop) &&
+		((l.satellites == nil && other.satellites == nil) ||
+			(l.satellites != nil && other.satellites != nil && *l.satellites == *other.satellites))
+}
+
+func copyFloat(f *float64) *float64 {
+	if f == nil {
+		return nil
+	}
+	v := *f
+	return &v
+}
+
+func equalFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
 }
 
 type Version struct {
@@ -122,13 +461,15 @@ func (v Version) Equals(other Version) bool {
 	return v.value == other.value
 }
 
+var ErrInvalidMileage = errors.New("invalid mileage")
+
 type Mileage struct {
 	kilometers float64
 }
 
 func NewMileage(kilometers float64) (Mileage, error) {
 	if kilometers < 0 {
-		return Mileage{}, fmt.Errorf("mileage cannot be negative: %f", kilometers)
+		return Mileage{}, fmt.Errorf("%w: %f km is negative", ErrInvalidMileage, kilometers)
 	}
 	return Mileage{kilometers: kilometers}, nil
 }
@@ -137,6 +478,16 @@ func (m Mileage) Kilometers() float64 {
 	return m.kilometers
 }
 
+// NewMileageIn reads a mileage given in the client's unit system.
+func NewMileageIn(distance float64, units UnitSystem) (Mileage, error) {
+	return NewMileage(units.ToKilometers(distance))
+}
+
+// In returns the mileage in the client's unit system.
+func (m Mileage) In(units UnitSystem) float64 {
+	return units.FromKilometers(m.kilometers)
+}
+
 func (m Mileage) AddKilometers(km float64) (Mileage, error) {
 	if km < 0 {
 		return Mileage{}, fmt.Errorf("cannot add negative kilometers")
@@ -149,13 +500,15 @@ func (m Mileage) Equals(other Mileage) bool {
 	return (m.kilometers-other.kilometers) < epsilon && (other.kilometers-m.kilometers) < epsilon
 }
 
+var ErrInvalidFuelLevel = errors.New("invalid fuel level")
+
 type FuelLevel struct {
 	percentage float64
 }
 
 func NewFuelLevel(percentage float64) (FuelLevel, error) {
 	if percentage < 0 || percentage > 100 {
-		return FuelLevel{}, fmt.Errorf("fuel level must be between 0 and 100: %f", percentage)
+		return FuelLevel{}, fmt.Errorf("%w: %f is not between 0 and 100", ErrInvalidFuelLevel, percentage)
 	}
 	return FuelLevel{percentage: percentage}, nil
 }
@@ -164,10 +517,6 @@ func (f FuelLevel) Percentage() float64 {
 	return f.percentage
 }
 
-func (f FuelLevel) IsLow() bool {
-	return f.percentage < 15
-}
-
 func (f FuelLevel) Equals(other FuelLevel) bool {
 	const epsilon = 0.001
 	return (f.percentage-other.percentage) < epsilon && (other.percentage-f.percentage) < epsilon
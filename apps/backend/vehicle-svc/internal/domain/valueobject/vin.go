@@ -0,0 +1,187 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidVIN           = errors.New("invalid vin")
+	ErrInvalidVINValidation = errors.New("invalid vin validation mode")
+)
+
+// VINValidation selects how strictly NewVIN checks a VIN. Both modes enforce
+// the ISO 3779 format; only strict mode also enforces the North American
+// check digit, which vehicles built for other markets may not carry.
+type VINValidation string
+
+const (
+	VINValidationStrict  VINValidation = "strict"
+	VINValidationLenient VINValidation = "lenient"
+)
+
+func NewVINValidation(mode string) (VINValidation, error) {
+	v := VINValidation(mode)
+	switch v {
+	case VINValidationStrict, VINValidationLenient:
+		return v, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidVINValidation, mode)
+	}
+}
+
+const vinLength = 17
+
+// vinWeights are the per-position weights of the check digit sum; position 9
+// holds the check digit itself and weighs nothing.
+var vinWeights = [vinLength]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinYearCodes lists the model year codes of one 30-year cycle starting 1980.
+const vinYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// vinManufacturers maps common world manufacturer identifiers to a make.
+// Unknown WMIs decode to an empty manufacturer.
+var vinManufacturers = map[string]string{
+	"1FA": "Ford", "1FM": "Ford", "1FT": "Ford", "1FD": "Ford", "3FA": "Ford", "WF0": "Ford",
+	"1G1": "Chevrolet", "1GC": "Chevrolet", "1GN": "Chevrolet", "1GT": "GMC", "3GC": "Chevrolet",
+	"1HG": "Honda", "2HG": "Honda", "5FN": "Honda", "JHM": "Honda",
+	"1N4": "Nissan", "1N6": "Nissan", "JN1": "Nissan", "JN8": "Nissan",
+	"1C4": "Jeep", "1C6": "Ram", "2C3": "Chrysler", "3C6": "Ram",
+	"2T1": "Toyota", "4T1": "Toyota", "5TD": "Toyota", "5TF": "Toyota", "JTD": "Toyota", "JTE": "Toyota", "JTM": "Toyota",
+	"3VW": "Volkswagen", "WVW": "Volkswagen", "WV1": "Volkswagen", "WV2": "Volkswagen",
+	"5YJ": "Tesla", "7SA": "Tesla",
+	"KMH": "Hyundai", "5NP": "Hyundai", "KNA": "Kia", "KND": "Kia",
+	"WAU": "Audi", "WBA": "BMW", "WBS": "BMW", "WDB": "Mercedes-Benz", "WDD": "Mercedes-Benz", "W1K": "Mercedes-Benz",
+	"WDF": "Mercedes-Benz", "WP0": "Porsche", "YV1": "Volvo", "SAL": "Land Rover", "VF1": "Renault", "ZFA": "Fiat",
+	"1FU": "Freightliner", "1FV": "Freightliner", "3AK": "Freightliner", "1XK": "Kenworth", "1XP": "Peterbilt",
+	"4V4": "Volvo Trucks", "1HT": "International", "3HA": "International", "1M1": "Mack", "YS2": "Scania",
+}
+
+// VIN is a 17-character ISO 3779 vehicle identification number. It decodes
+// the manufacturer, model year and plant code on demand.
+type VIN struct {
+	value string
+}
+
+func NewVIN(value string, validation VINValidation) (VIN, error) {
+	vin := strings.ToUpper(strings.TrimSpace(value))
+	if vin == "" {
+		return VIN{}, fmt.Errorf("%w: vin cannot be empty", ErrInvalidVIN)
+	}
+	if len(vin) != vinLength {
+		return VIN{}, fmt.Errorf("%w: %s must be %d characters", ErrInvalidVIN, vin, vinLength)
+	}
+	for i := 0; i < vinLength; i++ {
+		if _, ok := vinTransliteration(vin[i]); !ok {
+			return VIN{}, fmt.Errorf("%w: %s has invalid character %q at position %d", ErrInvalidVIN, vin, vin[i], i+1)
+		}
+	}
+	if validation == VINValidationStrict {
+		if expected := vinCheckDigit(vin); vin[8] != expected {
+			return VIN{}, fmt.Errorf("%w: %s has check digit %c, expected %c", ErrInvalidVIN, vin, vin[8], expected)
+		}
+	}
+	return VIN{value: vin}, nil
+}
+
+// RestoreVIN rebuilds a stored VIN without validating it, so vehicles saved
+// before VINs were checked still load. A stored value that is not a
+// well-formed VIN decodes to nothing.
+func RestoreVIN(value string) VIN {
+	return VIN{value: value}
+}
+
+func (v VIN) String() string {
+	return v.value
+}
+
+func (v VIN) Equals(other VIN) bool {
+	return v.value == other.value
+}
+
+// WMI is the world manufacturer identifier, the first three characters.
+func (v VIN) WMI() string {
+	if !v.wellFormed() {
+		return ""
+	}
+	return v.value[:3]
+}
+
+func (v VIN) Manufacturer() string {
+	return vinManufacturers[v.WMI()]
+}
+
+// ModelYear decodes position 10. The year code repeats every 30 years, so a
+// letter in position 7 places the vehicle in the 2010 cycle, as the North
+// American standard prescribes. It returns 0 when the code is not a year.
+func (v VIN) ModelYear() int {
+	if !v.wellFormed() {
+		return 0
+	}
+	idx := strings.IndexByte(vinYearCodes, v.value[9])
+	if idx < 0 {
+		return 0
+	}
+	year := 1980 + idx
+	if c := v.value[6]; c < '0' || c > '9' {
+		year += 30
+	}
+	return year
+}
+
+// PlantCode is the assembly plant character at position 11.
+func (v VIN) PlantCode() string {
+	if !v.wellFormed() {
+		return ""
+	}
+	return v.value[10:11]
+}
+
+// wellFormed reports whether the VIN has the ISO 3779 length and alphabet.
+// Only a restored VIN can fail this.
+func (v VIN) wellFormed() bool {
+	if len(v.value) != vinLength {
+		return false
+	}
+	for i := 0; i < vinLength; i++ {
+		if _, ok := vinTransliteration(v.value[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func vinCheckDigit(vin string) byte {
+	sum := 0
+	for i := 0; i < vinLength; i++ {
+		value, _ := vinTransliteration(vin[i])
+		sum += value * vinWeights[i]
+	}
+	rem := sum % 11
+	if rem == 10 {
+		return 'X'
+	}
+	return byte('0' + rem)
+}
+
+// vinTransliteration returns the numeric value of a VIN character. I, O and Q
+// are never valid because they are too easily confused with 1 and 0.
+func vinTransliteration(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1, true
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1, true
+	case c == 'P':
+		return 7, true
+	case c == 'R':
+		return 9, true
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,53 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVIN_DecodesAttributes(t *testing.T) {
+	vin, err := NewVIN(" 1hgbh41jxmn109186 ", VINValidationStrict)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1HGBH41JXMN109186", vin.String())
+	assert.Equal(t, "1HG", vin.WMI())
+	assert.Equal(t, "Honda", vin.Manufacturer())
+	assert.Equal(t, 1991, vin.ModelYear())
+	assert.Equal(t, "N", vin.PlantCode())
+
+	tesla, err := NewVIN("5YJ3E1EA2KF317000", VINValidationStrict)
+	require.NoError(t, err)
+	assert.Equal(t, "Tesla", tesla.Manufacturer())
+	assert.Equal(t, 2019, tesla.ModelYear())
+}
+
+func TestNewVIN_Validation(t *testing.T) {
+	for _, value := range []string{"", "1HGBH41JXMN10918", "1HGBH41JXMN1O9186"} {
+		_, err := NewVIN(value, VINValidationLenient)
+		assert.ErrorIs(t, err, ErrInvalidVIN, value)
+	}
+
+	_, err := NewVIN("1HGBH41J5MN109186", VINValidationStrict)
+	assert.ErrorIs(t, err, ErrInvalidVIN)
+
+	_, err = NewVIN("1HGBH41J5MN109186", VINValidationLenient)
+	assert.NoError(t, err)
+}
+
+func TestRestoreVIN_KeepsLegacyValues(t *testing.T) {
+	legacy := RestoreVIN("VIN-0042")
+	assert.Equal(t, "VIN-0042", legacy.String())
+	assert.Empty(t, legacy.WMI())
+	assert.Empty(t, legacy.Manufacturer())
+	assert.Zero(t, legacy.ModelYear())
+	assert.Empty(t, legacy.PlantCode())
+
+	// Seventeen characters are not enough; I, O and Q never occur.
+	assert.Empty(t, RestoreVIN("1HGBH41JXMN1O9186").Manufacturer())
+
+	stored := RestoreVIN("1HGBH41JXMN109186")
+	assert.Equal(t, "Honda", stored.Manufacturer())
+	assert.Equal(t, 1991, stored.ModelYear())
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/query"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/service"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/messaging"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/infrastructure/persistence"
 )
@@ -23,15 +24,21 @@ type Container struct {
 	MongoClient *mongo.Client
 	Logger      *zap.Logger
 
-	VehicleRepository repository.VehicleRepository
-	OutboxRepository  repository.OutboxRepository
+	VehicleRepository             repository.VehicleRepository
+	DriverRepository              repository.DriverRepository
+	DeviceRepository              repository.DeviceRepository
+	MaintenancePlanRepository     repository.MaintenancePlanRepository
+	MaintenanceTaskRepository     repository.MaintenanceTaskRepository
+	TelemetryCorrectionRepository repository.TelemetryCorrectionRepository
+	OutboxRepository              repository.OutboxRepository
 
 	CommandBus     command.CommandBus
 	QueryBus       query.QueryBus
 	EventPublisher messaging.EventPublisher
 
 	// Event handlers for consuming external events
-	UserAuthorizedEventHandler *handler.UserAuthorizedEventHandler
+	UserAuthorizedEventHandler        *handler.UserAuthorizedEventHandler
+	VehicleMileageUpdatedEventHandler *handler.VehicleMileageUpdatedEventHandler
 }
 
 func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger) (*Container, error) {
@@ -44,10 +51,39 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 		return nil, err
 	}
 
+	vinValidation, err := valueobject.NewVINValidation(config.Vehicle.VINValidation)
+	if err != nil {
+		return nil, err
+	}
+
 	db := mongoClient.Database(config.Mongo.Database)
 
 	vehicleCollection := db.Collection("vehicles")
 	vehicleRepo := persistence.NewMongoVehicleRepository(vehicleCollection)
+	if err := vehicleRepo.EnsureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	driverCollection := db.Collection("drivers")
+	driverRepo := persistence.NewMongoDriverRepository(driverCollection)
+	if err := driverRepo.EnsureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	deviceCollection := db.Collection("devices")
+	deviceRepo := persistence.NewMongoDeviceRepository(deviceCollection)
+
+	maintenancePlanCollection := db.Collection("maintenance_plans")
+	maintenancePlanRepo := persistence.NewMongoMaintenancePlanRepository(maintenancePlanCollection)
+
+	maintenanceTaskCollection := db.Collection("maintenance_tasks")
+	maintenanceTaskRepo := persistence.NewMongoMaintenanceTaskRepository(maintenanceTaskCollection)
+
+	telemetryCorrectionCollection := db.Collection("telemetry_corrections")
+	telemetryCorrectionRepo := persistence.NewMongoTelemetryCorrectionRepository(telemetryCorrectionCollection)
+
+	attributeSchemaCollection := db.Collection("attribute_schemas")
+	attributeSchemaRepo := persistence.NewMongoAttributeSchemaRepository(attributeSchemaCollection)
 
 	outboxCollection := db.Collection("outbox")
 	outboxRepo := persistence.NewMongoOutboxRepository(outboxCollection)
@@ -56,23 +92,115 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 
 	commandBus.Register(
 		"CreateVehicle",
-		service.NewCreateVehicleCommandHandler(vehicleRepo, outboxRepo),
+		service.NewCreateVehicleCommandHandler(vehicleRepo, attributeSchemaRepo, outboxRepo, vinValidation),
 	)
 	commandBus.Register(
 		"UpdateVehicleLocation",
 		service.NewUpdateVehicleLocationCommandHandler(vehicleRepo, outboxRepo),
 	)
+	commandBus.Register(
+		"IngestLocationBatch",
+		service.NewIngestLocationBatchCommandHandler(vehicleRepo, deviceRepo, outboxRepo),
+	)
 	commandBus.Register(
 		"ChangeVehicleStatus",
 		service.NewChangeVehicleStatusCommandHandler(vehicleRepo, outboxRepo),
 	)
+	commandBus.Register(
+		"UpdateVehicleDetails",
+		service.NewUpdateVehicleDetailsCommandHandler(vehicleRepo, attributeSchemaRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"AssignVehicleGroup",
+		service.NewAssignVehicleGroupCommandHandler(vehicleRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"UpdateVehicleTags",
+		service.NewUpdateVehicleTagsCommandHandler(vehicleRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"ChangeGroupStatus",
+		service.NewChangeGroupStatusCommandHandler(vehicleRepo, commandBus),
+	)
 	commandBus.Register(
 		"UpdateVehicleMileage",
 		service.NewUpdateVehicleMileageCommandHandler(vehicleRepo, outboxRepo),
 	)
 	commandBus.Register(
-		"UpdateVehicleFuelLevel",
-		service.NewUpdateVehicleFuelLevelCommandHandler(vehicleRepo, outboxRepo),
+		"UpdateVehicleEnergyLevel",
+		service.NewUpdateVehicleEnergyLevelCommandHandler(vehicleRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"ApplyTelemetryCorrection",
+		service.NewApplyTelemetryCorrectionCommandHandler(vehicleRepo, telemetryCorrectionRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"DeleteVehicle",
+		service.NewDeleteVehicleCommandHandler(vehicleRepo, driverRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"RestoreVehicle",
+		service.NewRestoreVehicleCommandHandler(vehicleRepo, outboxRepo, config.Vehicle.RestoreGracePeriod),
+	)
+	commandBus.Register(
+		"CreateDriver",
+		service.NewCreateDriverCommandHandler(driverRepo),
+	)
+	commandBus.Register(
+		"UpdateDriver",
+		service.NewUpdateDriverCommandHandler(driverRepo),
+	)
+	commandBus.Register(
+		"DeleteDriver",
+		service.NewDeleteDriverCommandHandler(driverRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"AssignDriver",
+		service.NewAssignDriverCommandHandler(driverRepo, vehicleRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"UnassignDriver",
+		service.NewUnassignDriverCommandHandler(driverRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"RegisterDevice",
+		service.NewRegisterDeviceCommandHandler(deviceRepo),
+	)
+	commandBus.Register(
+		"UpdateDevice",
+		service.NewUpdateDeviceCommandHandler(deviceRepo),
+	)
+	commandBus.Register(
+		"PairDevice",
+		service.NewPairDeviceCommandHandler(deviceRepo, vehicleRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"UnpairDevice",
+		service.NewUnpairDeviceCommandHandler(deviceRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"CreateMaintenancePlan",
+		service.NewCreateMaintenancePlanCommandHandler(maintenancePlanRepo),
+	)
+	commandBus.Register(
+		"DeleteMaintenancePlan",
+		service.NewDeleteMaintenancePlanCommandHandler(maintenancePlanRepo, maintenanceTaskRepo),
+	)
+	commandBus.Register(
+		"EvaluateMaintenance",
+		service.NewEvaluateMaintenanceCommandHandler(vehicleRepo, maintenancePlanRepo, maintenanceTaskRepo, outboxRepo),
+	)
+	commandBus.Register(
+		"EvaluateDueMaintenance",
+		service.NewEvaluateDueMaintenanceCommandHandler(maintenanceTaskRepo, commandBus),
+	)
+	commandBus.Register(
+		"DefineAttributeSchema",
+		service.NewDefineAttributeSchemaCommandHandler(attributeSchemaRepo),
+	)
+	commandBus.Register(
+		"CompleteMaintenanceTask",
+		service.NewCompleteMaintenanceTaskCommandHandler(vehicleRepo, maintenancePlanRepo, maintenanceTaskRepo),
 	)
 
 	queryBus := messaging.NewInMemoryQueryBus()
@@ -83,7 +211,43 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 	)
 	queryBus.Register(
 		"GetAllVehicles",
-		service.NewGetAllVehiclesQueryHandler(vehicleRepo),
+		service.NewGetAllVehiclesQueryHandler(vehicleRepo, attributeSchemaRepo),
+	)
+	queryBus.Register(
+		"GetAttributeSchema",
+		service.NewGetAttributeSchemaQueryHandler(attributeSchemaRepo),
+	)
+	queryBus.Register(
+		"GetVehicleCorrections",
+		service.NewGetVehicleCorrectionsQueryHandler(telemetryCorrectionRepo),
+	)
+	queryBus.Register(
+		"GetDriver",
+		service.NewGetDriverQueryHandler(driverRepo),
+	)
+	queryBus.Register(
+		"GetAllDrivers",
+		service.NewGetAllDriversQueryHandler(driverRepo),
+	)
+	queryBus.Register(
+		"GetDevice",
+		service.NewGetDeviceQueryHandler(deviceRepo),
+	)
+	queryBus.Register(
+		"GetAllDevices",
+		service.NewGetAllDevicesQueryHandler(deviceRepo),
+	)
+	queryBus.Register(
+		"GetMaintenancePlan",
+		service.NewGetMaintenancePlanQueryHandler(maintenancePlanRepo),
+	)
+	queryBus.Register(
+		"GetAllMaintenancePlans",
+		service.NewGetAllMaintenancePlansQueryHandler(maintenancePlanRepo),
+	)
+	queryBus.Register(
+		"GetUpcomingMaintenance",
+		service.NewGetUpcomingMaintenanceQueryHandler(maintenanceTaskRepo, vehicleRepo),
 	)
 
 	// Wire Kafka publisher
@@ -99,16 +263,23 @@ func NewContainer(ctx context.Context, config config.Config, logger *zap.Logger)
 
 	// Wire event handlers for consuming external events
 	userAuthHandler := handler.NewUserAuthorizedEventHandler(logger)
+	vehicleMileageUpdatedHandler := handler.NewVehicleMileageUpdatedEventHandler(commandBus, logger)
 
 	return &Container{
-		MongoClient:                mongoClient,
-		Logger:                     logger,
-		VehicleRepository:          vehicleRepo,
-		OutboxRepository:           outboxRepo,
-		CommandBus:                 commandBus,
-		QueryBus:                   queryBus,
-		EventPublisher:             eventPublisher,
-		UserAuthorizedEventHandler: userAuthHandler,
+		MongoClient:                       mongoClient,
+		Logger:                            logger,
+		VehicleRepository:                 vehicleRepo,
+		DriverRepository:                  driverRepo,
+		DeviceRepository:                  deviceRepo,
+		MaintenancePlanRepository:         maintenancePlanRepo,
+		MaintenanceTaskRepository:         maintenanceTaskRepo,
+		TelemetryCorrectionRepository:     telemetryCorrectionRepo,
+		OutboxRepository:                  outboxRepo,
+		CommandBus:                        commandBus,
+		QueryBus:                          queryBus,
+		EventPublisher:                    eventPublisher,
+		UserAuthorizedEventHandler:        userAuthHandler,
+		VehicleMileageUpdatedEventHandler: vehicleMileageUpdatedHandler,
 	}, nil
 }
 
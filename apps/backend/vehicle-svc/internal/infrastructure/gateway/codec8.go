@@ -0,0 +1,170 @@
+package gateway
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	codec8ID = 0x08
+	// codec8MaxData bounds the data field; devices send at most a few KB.
+	codec8MaxData = 64 * 1024
+)
+
+// Codec8 decodes Teltonika Codec 8 over TCP. A connection opens with the
+// IMEI as a length-prefixed string, answered with 0x01 to accept or 0x00 to
+// refuse. AVL packets follow, framed as
+//
+//	preamble(4, zero) length(4) codec(1) count(1) records count(1) crc(4)
+//
+// and answered with the number of records as a 4-byte integer. A single 0xFF
+// byte is a keep-alive ping and is not answered.
+type Codec8 struct{}
+
+func (Codec8) Name() string {
+	return "codec8"
+}
+
+func (Codec8) ReadMessage(r *bufio.Reader) (Message, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return Message{}, err
+	}
+	if first[0] == 0xFF {
+		r.ReadByte()
+		return Message{Type: MessageHeartbeat}, nil
+	}
+
+	var prefix [4]byte
+	if _, err := io.ReadFull(r, prefix[:2]); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+	if prefix[0] != 0 || prefix[1] != 0 {
+		length := int(binary.BigEndian.Uint16(prefix[:2]))
+		imei := make([]byte, length)
+		if _, err := io.ReadFull(r, imei); err != nil {
+			return Message{}, unexpectedEOF(err)
+		}
+		return Message{Type: MessageLogin, HardwareID: string(imei)}, nil
+	}
+
+	if _, err := io.ReadFull(r, prefix[2:]); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+	if prefix != [4]byte{} {
+		return Message{}, fmt.Errorf("%w: bad preamble %x", ErrMalformedPacket, prefix)
+	}
+
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+	length := binary.BigEndian.Uint32(l[:])
+	if length < 3 || length > codec8MaxData {
+		return Message{}, fmt.Errorf("%w: data length %d", ErrMalformedPacket, length)
+	}
+
+	data := make([]byte, length+4)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+	if uint32(crc16IBM(data[:length])) != binary.BigEndian.Uint32(data[length:]) {
+		return Message{}, ErrChecksum
+	}
+	data = data[:length]
+
+	if data[0] != codec8ID {
+		return Message{}, fmt.Errorf("%w: codec %#02x is not supported", ErrMalformedPacket, data[0])
+	}
+	count := int(data[1])
+	if int(data[length-1]) != count {
+		return Message{}, fmt.Errorf("%w: record counts %d and %d differ", ErrMalformedPacket, count, data[length-1])
+	}
+
+	fixes, err := decodeCodec8Records(data[2:length-1], count)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: MessageLocation, Fixes: fixes}, nil
+}
+
+// decodeCodec8Records reads AVL records, each
+//
+//	timestamp(8, ms) priority(1) longitude(4) latitude(4) altitude(2)
+//	angle(2) satellites(1) speed(2) io elements
+//
+// IO elements are skipped.
+func decodeCodec8Records(data []byte, count int) ([]Fix, error) {
+	fixes := make([]Fix, 0, count)
+	for i := 0; i < count; i++ {
+		if len(data) < 24 {
+			return nil, fmt.Errorf("%w: record %d is truncated", ErrMalformedPacket, i)
+		}
+		satellites := data[21]
+		fixes = append(fixes, Fix{
+			Timestamp:  int64(binary.BigEndian.Uint64(data[0:8]) / 1000),
+			Longitude:  float64(int32(binary.BigEndian.Uint32(data[9:13]))) / 1e7,
+			Latitude:   float64(int32(binary.BigEndian.Uint32(data[13:17]))) / 1e7,
+			Altitude:   float64(int16(binary.BigEndian.Uint16(data[17:19]))),
+			Heading:    float64(binary.BigEndian.Uint16(data[19:21])),
+			Satellites: int(satellites),
+			Speed:      float64(binary.BigEndian.Uint16(data[22:24])),
+			Valid:      satellites > 0,
+		})
+
+		// Event IO id(1) and total count(1), then groups of 1, 2, 4 and
+		// 8-byte values, each a count(1) and id(1)/value pairs.
+		rest := data[24:]
+		if len(rest) < 2 {
+			return nil, fmt.Errorf("%w: record %d is truncated", ErrMalformedPacket, i)
+		}
+		rest = rest[2:]
+		for _, size := range []int{1, 2, 4, 8} {
+			if len(rest) < 1 {
+				return nil, fmt.Errorf("%w: record %d is truncated", ErrMalformedPacket, i)
+			}
+			n := int(rest[0]) * (1 + size)
+			if len(rest) < 1+n {
+				return nil, fmt.Errorf("%w: record %d is truncated", ErrMalformedPacket, i)
+			}
+			rest = rest[1+n:]
+		}
+		data = rest
+	}
+	if len(data) != 0 {
+		return nil, fmt.Errorf("%w: %d bytes after the last record", ErrMalformedPacket, len(data))
+	}
+	return fixes, nil
+}
+
+func (Codec8) Ack(msg Message, accepted bool) []byte {
+	switch msg.Type {
+	case MessageLogin:
+		if accepted {
+			return []byte{0x01}
+		}
+		return []byte{0x00}
+	case MessageLocation:
+		return binary.BigEndian.AppendUint32(nil, uint32(len(msg.Fixes)))
+	default:
+		return nil
+	}
+}
+
+// crc16IBM is CRC-16/ARC, the checksum of Codec 8 data fields.
+func crc16IBM(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+)
+
+// GT06 protocol numbers the gateway understands.
+const (
+	gt06Login     byte = 0x01
+	gt06Location  byte = 0x12
+	gt06Heartbeat byte = 0x13
+	gt06Alarm     byte = 0x16
+	gt06LocationN byte = 0x22 // GT06N and later Concox units
+)
+
+// GT06 decodes the Concox GT06 protocol. Every packet is framed as
+//
+//	start(2) length(1|2) protocol(1) information serial(2) crc(2) stop(2)
+//
+// with start 0x7878 and a one-byte length, or 0x7979 and a two-byte length.
+// The server answers logins, heartbeats and alarms; plain location packets
+// are not acknowledged.
+type GT06 struct{}
+
+func (GT06) Name() string {
+	return "gt06"
+}
+
+func (GT06) ReadMessage(r *bufio.Reader) (Message, error) {
+	var start [2]byte
+	if _, err := io.ReadFull(r, start[:]); err != nil {
+		return Message{}, err
+	}
+
+	var header []byte
+	var length int
+	switch start {
+	case [2]byte{0x78, 0x78}:
+		b, err := r.ReadByte()
+		if err != nil {
+			return Message{}, unexpectedEOF(err)
+		}
+		header, length = []byte{b}, int(b)
+	case [2]byte{0x79, 0x79}:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return Message{}, unexpectedEOF(err)
+		}
+		header, length = l[:], int(binary.BigEndian.Uint16(l[:]))
+	default:
+		return Message{}, fmt.Errorf("%w: unknown start bits %x", ErrMalformedPacket, start)
+	}
+	if length < 5 {
+		return Message{}, fmt.Errorf("%w: length %d is too short", ErrMalformedPacket, length)
+	}
+
+	body := make([]byte, length+2)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+	if body[length] != 0x0D || body[length+1] != 0x0A {
+		return Message{}, fmt.Errorf("%w: missing stop bits", ErrMalformedPacket)
+	}
+	body = body[:length]
+
+	checked := append(header, body[:length-2]...)
+	if crcITU(checked) != binary.BigEndian.Uint16(body[length-2:]) {
+		return Message{}, ErrChecksum
+	}
+
+	msg := Message{
+		Type:   MessageOther,
+		code:   body[0],
+		serial: binary.BigEndian.Uint16(body[length-4 : length-2]),
+	}
+	info := body[1 : length-4]
+
+	switch msg.code {
+	case gt06Login:
+		if len(info) < 8 {
+			return Message{}, fmt.Errorf("%w: login without terminal id", ErrMalformedPacket)
+		}
+		// The terminal id is the IMEI in BCD, padded with a leading zero.
+		msg.Type = MessageLogin
+		msg.HardwareID = hex.EncodeToString(info[:8])[1:]
+	case gt06Heartbeat:
+		msg.Type = MessageHeartbeat
+	case gt06Location, gt06LocationN, gt06Alarm:
+		fix, err := decodeGT06Fix(info)
+		if err != nil {
+			return Message{}, err
+		}
+		msg.Type = MessageLocation
+		msg.Fixes = []Fix{fix}
+	}
+
+	return msg, nil
+}
+
+// decodeGT06Fix reads the GPS block that opens every location packet:
+// date and time(6) satellites(1) latitude(4) longitude(4) speed(1) course(2).
+// The low nibble of the satellites byte is the number in use.
+func decodeGT06Fix(info []byte) (Fix, error) {
+	if len(info) < 18 {
+		return Fix{}, fmt.Errorf("%w: location block is %d bytes", ErrMalformedPacket, len(info))
+	}
+
+	at := time.Date(2000+int(info[0]), time.Month(info[1]), int(info[2]),
+		int(info[3]), int(info[4]), int(info[5]), 0, time.UTC)
+	latitude := float64(binary.BigEndian.Uint32(info[7:11])) / 1800000
+	longitude := float64(binary.BigEndian.Uint32(info[11:15])) / 1800000
+	flags := binary.BigEndian.Uint16(info[16:18])
+
+	if flags&(1<<10) == 0 {
+		latitude = -latitude
+	}
+	if flags&(1<<11) != 0 {
+		longitude = -longitude
+	}
+
+	return Fix{
+		Latitude:   latitude,
+		Longitude:  longitude,
+		Speed:      float64(info[15]),
+		Heading:    float64(flags & 0x3FF),
+		Satellites: int(info[6] & 0x0F),
+		Timestamp:  at.Unix(),
+		Valid:      flags&(1<<12) != 0,
+	}, nil
+}
+
+func (GT06) Ack(msg Message, accepted bool) []byte {
+	switch {
+	case msg.Type == MessageLogin && !accepted:
+		return nil
+	case msg.Type == MessageLogin, msg.Type == MessageHeartbeat, msg.code == gt06Alarm:
+	default:
+		return nil
+	}
+
+	packet := []byte{0x78, 0x78, 0x05, msg.code, byte(msg.serial >> 8), byte(msg.serial)}
+	packet = binary.BigEndian.AppendUint16(packet, crcITU(packet[2:]))
+	return append(packet, 0x0D, 0x0A)
+}
+
+// crcITU is CRC-16/X-25, the checksum GT06 packets carry.
+func crcITU(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return ^crc
+}
+
+// unexpectedEOF reports a connection closed in the middle of a packet.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+// Package gateway accepts TCP connections from hardware trackers that speak a
+// binary protocol and turns their position reports into location updates.
+package gateway
+
+import (
+	"bufio"
+	"errors"
+)
+
+var (
+	ErrMalformedPacket = errors.New("malformed packet")
+	ErrChecksum        = errors.New("packet checksum mismatch")
+)
+
+type MessageType int
+
+const (
+	MessageLogin MessageType = iota + 1
+	MessageHeartbeat
+	MessageLocation
+	// MessageOther is a well-formed packet the gateway has no use for.
+	MessageOther
+)
+
+// Message is one decoded packet.
+type Message struct {
+	Type       MessageType
+	HardwareID string // login only
+	Fixes      []Fix  // location only, in the order the device sent them
+
+	code   byte   // GT06 protocol number
+	serial uint16 // GT06 information serial number
+}
+
+// Fix is a position as reported by the device. Devices without satellite lock
+// still report, with Valid unset and a stale or zero position.
+type Fix struct {
+	Latitude   float64
+	Longitude  float64
+	Altitude   float64 // meters, 0 when the protocol has none
+	Speed      float64 // km/h
+	Heading    float64 // degrees clockwise from north
+	Satellites int
+	Timestamp  int64 // device time, unix seconds
+	Valid      bool
+}
+
+// Protocol frames and decodes the packets of one tracker protocol.
+// Implementations are stateless and shared by all connections of a listener.
+type Protocol interface {
+	Name() string
+
+	// ReadMessage reads the next packet. A malformed packet leaves the
+	// stream at an unknown position, so the connection must be dropped.
+	ReadMessage(r *bufio.Reader) (Message, error)
+
+	// Ack returns the reply the device expects for msg, or nil if it expects
+	// none. accepted is false for a login from an unknown device.
+	Ack(msg Message, accepted bool) []byte
+}
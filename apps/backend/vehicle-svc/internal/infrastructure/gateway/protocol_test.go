@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixture reads a recorded packet from testdata, stored as hex.
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join("testdata", name+".hex"))
+	require.NoError(t, err)
+	packet, err := hex.DecodeString(strings.TrimSpace(string(raw)))
+	require.NoError(t, err)
+	return packet
+}
+
+func TestGT06_DecodesRecordedPackets(t *testing.T) {
+	stream := bytes.Join([][]byte{
+		fixture(t, "gt06_login"), fixture(t, "gt06_heartbeat"), fixture(t, "gt06_location"),
+	}, nil)
+	r := bufio.NewReader(bytes.NewReader(stream))
+	p := GT06{}
+
+	login, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	assert.Equal(t, MessageLogin, login.Type)
+	assert.Equal(t, "123456789012345", login.HardwareID)
+	assert.Equal(t, "787805010001d9dc0d0a", hex.EncodeToString(p.Ack(login, true)))
+	assert.Nil(t, p.Ack(login, false))
+
+	heartbeat, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	assert.Equal(t, MessageHeartbeat, heartbeat.Type)
+	assert.NotNil(t, p.Ack(heartbeat, true))
+
+	location, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	require.Equal(t, MessageLocation, location.Type)
+	require.Len(t, location.Fixes, 1)
+	fix := location.Fixes[0]
+	assert.InDelta(t, 23.111668, fix.Latitude, 1e-6)
+	assert.InDelta(t, 114.409285, fix.Longitude, 1e-6)
+	assert.Equal(t, 143.0, fix.Heading)
+	assert.True(t, fix.Valid)
+	assert.Equal(t, time.Date(2011, 8, 29, 17, 46, 16, 0, time.UTC).Unix(), fix.Timestamp)
+	assert.Nil(t, p.Ack(location, true))
+}
+
+func TestGT06_RejectsCorruptChecksum(t *testing.T) {
+	packet := fixture(t, "gt06_location")
+	packet[10] ^= 0xFF
+
+	_, err := GT06{}.ReadMessage(bufio.NewReader(bytes.NewReader(packet)))
+	assert.ErrorIs(t, err, ErrChecksum)
+}
+
+func TestCodec8_DecodesRecordedPackets(t *testing.T) {
+	stream := bytes.Join([][]byte{
+		fixture(t, "codec8_imei"), {0xFF}, fixture(t, "codec8_avl"),
+	}, nil)
+	r := bufio.NewReader(bytes.NewReader(stream))
+	p := Codec8{}
+
+	login, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	assert.Equal(t, "356307042441013", login.HardwareID)
+	assert.Equal(t, []byte{0x01}, p.Ack(login, true))
+	assert.Equal(t, []byte{0x00}, p.Ack(login, false))
+
+	ping, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	assert.Equal(t, MessageHeartbeat, ping.Type)
+	assert.Nil(t, p.Ack(ping, true))
+
+	avl, err := p.ReadMessage(r)
+	require.NoError(t, err)
+	require.Len(t, avl.Fixes, 1)
+	assert.Equal(t, int64(1560161086), avl.Fixes[0].Timestamp)
+	assert.False(t, avl.Fixes[0].Valid, "record has no satellites")
+	assert.Equal(t, []byte{0, 0, 0, 1}, p.Ack(avl, true))
+}
@@ -0,0 +1,220 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+const writeTimeout = 10 * time.Second
+
+// Server serves one tracker protocol on a listener. A connection must log in
+// as a registered device before its positions are accepted; each position is
+// then applied to the vehicle the device was paired with at the position's
+// timestamp.
+type Server struct {
+	protocol    Protocol
+	deviceRepo  repository.DeviceRepository
+	commandBus  command.CommandBus
+	logger      *zap.Logger
+	idleTimeout time.Duration
+}
+
+func NewServer(
+	protocol Protocol,
+	deviceRepo repository.DeviceRepository,
+	commandBus command.CommandBus,
+	logger *zap.Logger,
+	idleTimeout time.Duration,
+) *Server {
+	return &Server{
+		protocol:    protocol,
+		deviceRepo:  deviceRepo,
+		commandBus:  commandBus,
+		logger:      logger.With(zap.String("protocol", protocol.Name())),
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Serve accepts connections until ctx is cancelled, then closes them and
+// returns once they are done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.handle(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	logger := s.logger.With(zap.String("remote", conn.RemoteAddr().String()))
+	reader := bufio.NewReader(conn)
+
+	var hardwareID valueobject.HardwareID
+	var deviceCtx context.Context
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(s.idleTimeout))
+		msg, err := s.protocol.ReadMessage(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				logger.Warn("dropping connection", zap.Error(err))
+			}
+			return
+		}
+
+		switch msg.Type {
+		case MessageLogin:
+			device, err := s.login(ctx, msg.HardwareID)
+			if err != nil {
+				logger.Error("failed to look up device", zap.String("hardwareId", msg.HardwareID), zap.Error(err))
+				return
+			}
+			if !s.reply(conn, msg, device != nil, logger) {
+				return
+			}
+			if device == nil {
+				logger.Warn("refused login from unknown device", zap.String("hardwareId", msg.HardwareID))
+				return
+			}
+			hardwareID = device.HardwareID()
+			deviceCtx = tenant.WithID(ctx, device.TenantID())
+			logger = logger.With(zap.String("hardwareId", hardwareID.String()))
+			logger.Info("device logged in")
+
+		case MessageLocation:
+			if deviceCtx == nil {
+				logger.Warn("dropping connection: location before login")
+				return
+			}
+			// A failed lookup or store is left unacknowledged so the
+			// device keeps the positions and sends them again.
+			if err := s.deliver(deviceCtx, hardwareID, msg.Fixes, logger); err != nil {
+				logger.Error("failed to deliver positions", zap.Error(err))
+				return
+			}
+			if !s.reply(conn, msg, true, logger) {
+				return
+			}
+
+		default:
+			if !s.reply(conn, msg, true, logger) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) login(ctx context.Context, raw string) (*entity.Device, error) {
+	hardwareID, err := valueobject.NewHardwareID(raw)
+	if err != nil {
+		return nil, nil
+	}
+	return s.deviceRepo.FindByHardwareIDInAnyTenant(ctx, hardwareID)
+}
+
+// reply writes the protocol's acknowledgement, if any, and reports whether
+// the connection is still usable.
+func (s *Server) reply(conn net.Conn, msg Message, accepted bool, logger *zap.Logger) bool {
+	ack := s.protocol.Ack(msg, accepted)
+	if ack == nil {
+		return true
+	}
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := conn.Write(ack); err != nil {
+		logger.Warn("failed to acknowledge packet", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// deliver applies positions in timestamp order. The device is reloaded so a
+// pairing change made while it is connected takes effect at once. Positions
+// without satellite lock, outside any pairing or rejected by the vehicle are
+// logged and dropped; positions older than the vehicle's are archived. Any
+// other failure is returned so the packet goes unacknowledged.
+func (s *Server) deliver(ctx context.Context, hardwareID valueobject.HardwareID, fixes []Fix, logger *zap.Logger) error {
+	device, err := s.deviceRepo.FindByHardwareID(ctx, hardwareID)
+	if err != nil {
+		return err
+	}
+	if device == nil {
+		return fmt.Errorf("device %s is no longer registered", hardwareID.String())
+	}
+
+	sorted := append([]Fix(nil), fixes...)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].Timestamp < sorted[b].Timestamp
+	})
+
+	for _, fix := range sorted {
+		if !fix.Valid {
+			logger.Debug("skipping position without satellite lock", zap.Int64("timestamp", fix.Timestamp))
+			continue
+		}
+		vehicleID, ok := device.VehicleAt(time.Unix(fix.Timestamp, 0))
+		if !ok {
+			logger.Warn("skipping position outside any pairing", zap.Int64("timestamp", fix.Timestamp))
+			continue
+		}
+
+		cmd := &command.UpdateVehicleLocationCommand{
+			VehicleID:  vehicleID,
+			Latitude:   fix.Latitude,
+			Longitude:  fix.Longitude,
+			Altitude:   fix.Altitude,
+			Timestamp:  fix.Timestamp,
+			SpeedKmh:   &fix.Speed,
+			Heading:    &fix.Heading,
+			Satellites: &fix.Satellites,
+		}
+		if err := s.commandBus.Dispatch(ctx, cmd); err != nil {
+			if !command.IsRejection(err) {
+				return fmt.Errorf("failed to apply position at %d: %w", fix.Timestamp, err)
+			}
+			logger.Warn("position rejected",
+				zap.String("vehicleId", vehicleID),
+				zap.Int64("timestamp", fix.Timestamp),
+				zap.Error(err))
+			continue
+		}
+		if cmd.Outcome == entity.LocationArchived {
+			logger.Debug("archived out-of-order position", zap.String("vehicleId", vehicleID), zap.Int64("timestamp", fix.Timestamp))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// stubDeviceRepo serves a fixed set of devices regardless of tenant.
+type stubDeviceRepo struct {
+	devices map[string]*entity.Device
+}
+
+func (r *stubDeviceRepo) Save(ctx context.Context, device *entity.Device) error {
+	return nil
+}
+
+func (r *stubDeviceRepo) FindByID(ctx context.Context, id valueobject.DeviceID) (*entity.Device, error) {
+	return nil, io.EOF
+}
+
+func (r *stubDeviceRepo) FindByHardwareID(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	return r.devices[hardwareID.String()], nil
+}
+
+func (r *stubDeviceRepo) FindByHardwareIDInAnyTenant(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	return r.devices[hardwareID.String()], nil
+}
+
+func (r *stubDeviceRepo) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Device, error) {
+	return nil, nil
+}
+
+// recordingBus records dispatched commands with the tenant they ran under.
+type recordingBus struct {
+	mu       sync.Mutex
+	commands []command.Command
+	tenants  []string
+}
+
+func (b *recordingBus) Dispatch(ctx context.Context, cmd command.Command) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.commands = append(b.commands, cmd)
+	b.tenants = append(b.tenants, tenant.ID(ctx))
+	return nil
+}
+
+func (b *recordingBus) Register(commandName string, handler command.CommandHandler) {}
+
+// startServer serves protocol on a local port and returns its address.
+func startServer(t *testing.T, protocol Protocol, repo *stubDeviceRepo, bus command.CommandBus) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		NewServer(protocol, repo, bus, zap.NewNop(), 5*time.Second).Serve(ctx, listener)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return listener.Addr().String()
+}
+
+func TestServer_GT06DeviceReportsForPairedVehicle(t *testing.T) {
+	hardwareID, _ := valueobject.NewHardwareID("123456789012345")
+	vehicleID := valueobject.GenerateVehicleID().String()
+	pairedAt := time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC)
+	device := entity.LoadDeviceFromHistory(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolGT06,
+		"", "", []entity.DevicePairing{{VehicleID: vehicleID, PairedAt: pairedAt}}, valueobject.Version{}, pairedAt, pairedAt)
+	bus := &recordingBus{}
+	addr := startServer(t, GT06{}, &stubDeviceRepo{devices: map[string]*entity.Device{hardwareID.String(): device}}, bus)
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	ack := make([]byte, 10)
+	_, err = conn.Write(fixture(t, "gt06_login"))
+	require.NoError(t, err)
+	_, err = io.ReadFull(conn, ack)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x01), ack[3])
+
+	// Location packets are not acknowledged; the heartbeat reply shows the
+	// location before it has been handled.
+	_, err = conn.Write(append(fixture(t, "gt06_location"), fixture(t, "gt06_heartbeat")...))
+	require.NoError(t, err)
+	_, err = io.ReadFull(conn, ack)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x13), ack[3])
+
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	require.Len(t, bus.commands, 1)
+	update, ok := bus.commands[0].(*command.UpdateVehicleLocationCommand)
+	require.True(t, ok)
+	assert.Equal(t, vehicleID, update.VehicleID)
+	assert.InDelta(t, 23.111668, update.Latitude, 1e-6)
+	assert.Equal(t, "fleet-a", bus.tenants[0])
+}
+
+// failingBus fails every command with err.
+type failingBus struct {
+	err error
+}
+
+func (b *failingBus) Dispatch(ctx context.Context, cmd command.Command) error {
+	return b.err
+}
+
+func (b *failingBus) Register(commandName string, handler command.CommandHandler) {}
+
+func TestServer_DropsConnectionWhenPositionsAreNotStored(t *testing.T) {
+	hardwareID, _ := valueobject.NewHardwareID("123456789012345")
+	pairedAt := time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC)
+	device := entity.LoadDeviceFromHistory(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolGT06,
+		"", "", []entity.DevicePairing{{VehicleID: valueobject.GenerateVehicleID().String(), PairedAt: pairedAt}}, valueobject.Version{}, pairedAt, pairedAt)
+	repo := &stubDeviceRepo{devices: map[string]*entity.Device{hardwareID.String(): device}}
+
+	tests := []struct {
+		name      string
+		err       error
+		connected bool
+	}{
+		// The device keeps positions that were never acknowledged and
+		// sends them again after reconnecting.
+		{"bus failure", errors.New("connection refused"), false},
+		{"rejected position", fmt.Errorf("failed to update location: %w", entity.ErrVehicleDeleted), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := startServer(t, GT06{}, repo, &failingBus{err: tt.err})
+
+			conn, err := net.Dial("tcp", addr)
+			require.NoError(t, err)
+			defer conn.Close()
+			conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+			ack := make([]byte, 10)
+			_, err = conn.Write(fixture(t, "gt06_login"))
+			require.NoError(t, err)
+			_, err = io.ReadFull(conn, ack)
+			require.NoError(t, err)
+
+			_, err = conn.Write(append(fixture(t, "gt06_location"), fixture(t, "gt06_heartbeat")...))
+			require.NoError(t, err)
+			_, err = io.ReadFull(conn, ack)
+			if tt.connected {
+				require.NoError(t, err)
+				assert.Equal(t, byte(0x13), ack[3])
+			} else {
+				assert.ErrorIs(t, err, io.EOF)
+			}
+		})
+	}
+}
+
+func TestServer_Codec8RefusesUnknownDevice(t *testing.T) {
+	addr := startServer(t, Codec8{}, &stubDeviceRepo{}, &recordingBus{})
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	_, err = conn.Write(fixture(t, "codec8_imei"))
+	require.NoError(t, err)
+	reply, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00}, reply)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
 )
 
 type EventHandler func(ctx context.Context, payload []byte) error
@@ -66,7 +68,7 @@ func (c *KafkaConsumer) Start(ctx context.Context) error {
 			continue
 		}
 
-		if err := handler(ctx, msg.Value); err != nil {
+		if err := handler(tenantContext(ctx, msg.Value), msg.Value); err != nil {
 			c.logger.Error("handler failed, will retry",
 				zap.String("topic", msg.Topic),
 				zap.Int64("offset", msg.Offset),
@@ -87,6 +89,17 @@ func (c *KafkaConsumer) Start(ctx context.Context) error {
 	}
 }
 
+// tenantContext scopes a message to the tenant named in its payload so the
+// handler only touches that tenant's data. Messages without one belong to the
+// default tenant.
+func tenantContext(ctx context.Context, payload []byte) context.Context {
+	var envelope struct {
+		TenantID string `json:"tenantId"`
+	}
+	_ = json.Unmarshal(payload, &envelope)
+	return tenant.WithID(ctx, envelope.TenantID)
+}
+
 func (c *KafkaConsumer) Close() error {
 	return c.reader.Close()
 }
@@ -20,9 +20,22 @@ func InitializeTopics(brokers []string, logger *zap.Logger) error {
 	topics := []TopicConfig{
 		{Name: "vehicle.created", NumPartitions: 3, ReplicationFactor: 1},
 		{Name: "vehicle.location.updated", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.location.archived", NumPartitions: 3, ReplicationFactor: 1},
 		{Name: "vehicle.status.changed", NumPartitions: 3, ReplicationFactor: 1},
 		{Name: "vehicle.mileage.updated", NumPartitions: 3, ReplicationFactor: 1},
 		{Name: "vehicle.fuel.updated", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.energy.updated", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "driver.assigned", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "driver.unassigned", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "device.paired", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "device.unpaired", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "maintenance.due", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "tracking.correction.applied", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.deleted", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.restored", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.details.updated", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.group.changed", NumPartitions: 3, ReplicationFactor: 1},
+		{Name: "vehicle.tags.changed", NumPartitions: 3, ReplicationFactor: 1},
 	}
 
 	conn, err := kafka.Dial("tcp", brokers[0])
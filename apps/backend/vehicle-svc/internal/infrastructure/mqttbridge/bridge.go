@@ -0,0 +1,188 @@
+// Package mqttbridge subscribes to telemetry that devices publish on an MQTT
+// broker and turns it into vehicle commands.
+package mqttbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/cmd/config"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+const (
+	// qos 1 has the broker keep each message until the bridge acknowledges
+	// it, so nothing is lost across a disconnect.
+	qos = 1
+	// disconnectQuiesce is how long, in milliseconds, in-flight work gets on
+	// shutdown.
+	disconnectQuiesce = 250
+)
+
+// Bridge holds a persistent session with the broker. Messages are
+// acknowledged only once handled; a message whose lookup or command fails
+// stays with the broker and is delivered again when the bridge reconnects.
+// Messages that can never succeed, such as malformed payloads, unknown
+// devices or readings the vehicle rejects, are logged and acknowledged.
+type Bridge struct {
+	client     mqtt.Client
+	topics     []string
+	deviceRepo repository.DeviceRepository
+	commandBus command.CommandBus
+	logger     *zap.Logger
+}
+
+func NewBridge(
+	cfg config.MQTTConfig,
+	deviceRepo repository.DeviceRepository,
+	commandBus command.CommandBus,
+	logger *zap.Logger,
+) *Bridge {
+	b := &Bridge{
+		topics:     cfg.Topics,
+		deviceRepo: deviceRepo,
+		commandBus: commandBus,
+		logger:     logger.With(zap.String("broker", cfg.BrokerURL)),
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(cfg.BrokerURL).
+		SetClientID(cfg.ClientID).
+		SetUsername(cfg.Username).
+		SetPassword(cfg.Password).
+		SetCleanSession(false).
+		SetAutoAckDisabled(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(5 * time.Second).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(time.Minute).
+		// A resumed session can deliver messages before the subscriptions
+		// below are in place.
+		SetDefaultPublishHandler(b.handle).
+		SetOnConnectHandler(b.subscribe).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			b.logger.Warn("lost connection to mqtt broker, reconnecting", zap.Error(err))
+		})
+	b.client = mqtt.NewClient(opts)
+
+	return b
+}
+
+// Start connects to the broker and handles telemetry until ctx is cancelled.
+// An unreachable broker is retried rather than reported.
+func (b *Bridge) Start(ctx context.Context) error {
+	token := b.client.Connect()
+	select {
+	case <-token.Done():
+		if err := token.Error(); err != nil {
+			return fmt.Errorf("failed to connect to mqtt broker: %w", err)
+		}
+	case <-ctx.Done():
+	}
+
+	<-ctx.Done()
+	b.client.Disconnect(disconnectQuiesce)
+	return nil
+}
+
+// subscribe runs on every connect. The broker usually keeps the subscriptions
+// of a resumed session, but subscribing again is harmless.
+func (b *Bridge) subscribe(client mqtt.Client) {
+	filters := make(map[string]byte, len(b.topics))
+	for _, topic := range b.topics {
+		filters[topic] = qos
+	}
+
+	token := client.SubscribeMultiple(filters, b.handle)
+	token.Wait()
+	if err := token.Error(); err != nil {
+		b.logger.Error("failed to subscribe", zap.Strings("topics", b.topics), zap.Error(err))
+		return
+	}
+	b.logger.Info("subscribed to telemetry", zap.Strings("topics", b.topics))
+}
+
+func (b *Bridge) handle(_ mqtt.Client, msg mqtt.Message) {
+	if err := b.process(context.Background(), msg.Topic(), msg.Payload(), time.Now()); err != nil {
+		b.logger.Error("failed to handle telemetry", zap.String("topic", msg.Topic()), zap.Error(err))
+		return
+	}
+	msg.Ack()
+}
+
+// process dispatches the commands of one message. It returns an error only
+// when the message should be delivered again.
+func (b *Bridge) process(ctx context.Context, topic string, payload []byte, received time.Time) error {
+	logger := b.logger.With(zap.String("topic", topic))
+
+	var telemetry Telemetry
+	if err := json.Unmarshal(payload, &telemetry); err != nil {
+		logger.Warn("dropping malformed telemetry", zap.Error(err))
+		return nil
+	}
+	if telemetry.Timestamp == 0 {
+		telemetry.Timestamp = received.Unix()
+	}
+
+	raw := telemetry.DeviceID
+	if raw == "" {
+		raw = b.deviceFromTopic(topic)
+	}
+	hardwareID, err := valueobject.NewHardwareID(raw)
+	if err != nil {
+		logger.Warn("dropping telemetry without a valid device id", zap.String("deviceId", raw))
+		return nil
+	}
+
+	device, err := b.deviceRepo.FindByHardwareIDInAnyTenant(ctx, hardwareID)
+	if err != nil {
+		return err
+	}
+	if device == nil {
+		logger.Warn("dropping telemetry from unknown device", zap.String("hardwareId", hardwareID.String()))
+		return nil
+	}
+	vehicleID, ok := device.VehicleAt(time.Unix(telemetry.Timestamp, 0))
+	if !ok {
+		logger.Warn("dropping telemetry outside any pairing",
+			zap.String("hardwareId", hardwareID.String()),
+			zap.Int64("timestamp", telemetry.Timestamp))
+		return nil
+	}
+
+	ctx = tenant.WithID(ctx, device.TenantID())
+	for _, cmd := range telemetry.Commands(vehicleID) {
+		if err := b.commandBus.Dispatch(ctx, cmd); err != nil {
+			if !command.IsRejection(err) {
+				return fmt.Errorf("failed to apply %s: %w", cmd.CommandName(), err)
+			}
+			logger.Warn("telemetry rejected",
+				zap.String("vehicleId", vehicleID),
+				zap.String("command", cmd.CommandName()),
+				zap.Error(err))
+			continue
+		}
+		if location, ok := cmd.(*command.UpdateVehicleLocationCommand); ok && location.Outcome == entity.LocationArchived {
+			logger.Debug("archived out-of-order location", zap.String("vehicleId", vehicleID))
+		}
+	}
+	return nil
+}
+
+func (b *Bridge) deviceFromTopic(topic string) string {
+	for _, filter := range b.topics {
+		if device, ok := deviceFromTopic(filter, topic); ok {
+			return device
+		}
+	}
+	return ""
+}
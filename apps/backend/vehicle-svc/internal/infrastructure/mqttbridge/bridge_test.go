@@ -0,0 +1,230 @@
+package mqttbridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	broker "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/cmd/config"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// stubDeviceRepo serves a fixed set of devices regardless of tenant.
+type stubDeviceRepo struct {
+	devices map[string]*entity.Device
+}
+
+func (r *stubDeviceRepo) Save(ctx context.Context, device *entity.Device) error {
+	return nil
+}
+
+func (r *stubDeviceRepo) FindByID(ctx context.Context, id valueobject.DeviceID) (*entity.Device, error) {
+	return nil, io.EOF
+}
+
+func (r *stubDeviceRepo) FindByHardwareID(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	return r.devices[hardwareID.String()], nil
+}
+
+func (r *stubDeviceRepo) FindByHardwareIDInAnyTenant(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	return r.devices[hardwareID.String()], nil
+}
+
+func (r *stubDeviceRepo) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Device, error) {
+	return nil, nil
+}
+
+// recordingBus records dispatched commands with the tenant they ran under.
+type recordingBus struct {
+	mu       sync.Mutex
+	commands []command.Command
+	tenants  []string
+}
+
+func (b *recordingBus) Dispatch(ctx context.Context, cmd command.Command) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.commands = append(b.commands, cmd)
+	b.tenants = append(b.tenants, tenant.ID(ctx))
+	return nil
+}
+
+func (b *recordingBus) Register(commandName string, handler command.CommandHandler) {}
+
+func (b *recordingBus) recorded() ([]command.Command, []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]command.Command(nil), b.commands...), append([]string(nil), b.tenants...)
+}
+
+// subscribedHook closes subscribed once the bridge has subscribed.
+type subscribedHook struct {
+	broker.HookBase
+	once       sync.Once
+	subscribed chan struct{}
+}
+
+func (h *subscribedHook) ID() string {
+	return "subscribed"
+}
+
+func (h *subscribedHook) Provides(b byte) bool {
+	return b == broker.OnSubscribed
+}
+
+func (h *subscribedHook) OnSubscribed(cl *broker.Client, pk packets.Packet, reasonCodes []byte) {
+	h.once.Do(func() { close(h.subscribed) })
+}
+
+// startBroker runs an embedded broker on a local port and returns it with
+// its address.
+func startBroker(t *testing.T) (*broker.Server, string, <-chan struct{}) {
+	t.Helper()
+	server := broker.New(&broker.Options{
+		InlineClient: true,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	require.NoError(t, server.AddHook(new(auth.AllowHook), nil))
+	hook := &subscribedHook{subscribed: make(chan struct{})}
+	require.NoError(t, server.AddHook(hook, nil))
+
+	tcp := listeners.NewTCP(listeners.Config{ID: "test", Address: "127.0.0.1:0"})
+	require.NoError(t, server.AddListener(tcp))
+	require.NoError(t, server.Serve())
+	t.Cleanup(func() { server.Close() })
+
+	return server, tcp.Address(), hook.subscribed
+}
+
+func TestBridge_MapsTelemetryToCommands(t *testing.T) {
+	server, addr, subscribed := startBroker(t)
+
+	hardwareID, _ := valueobject.NewHardwareID("MQTT-0001")
+	vehicleID := valueobject.GenerateVehicleID().String()
+	pairedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	device := entity.LoadDeviceFromHistory(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolMQTT,
+		"", "", []entity.DevicePairing{{VehicleID: vehicleID, PairedAt: pairedAt}}, valueobject.Version{}, pairedAt, pairedAt)
+	bus := &recordingBus{}
+
+	bridge := NewBridge(config.MQTTConfig{
+		BrokerURL: "tcp://" + addr,
+		ClientID:  "vehicle-svc-test",
+		Topics:    []string{"fleet/+/telemetry"},
+	}, &stubDeviceRepo{devices: map[string]*entity.Device{hardwareID.String(): device}}, bus, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- bridge.Start(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	select {
+	case <-subscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("bridge did not subscribe")
+	}
+
+	// Unknown devices are dropped; the device of the second message is
+	// named by the topic.
+	require.NoError(t, server.Publish("fleet/UNKNOWN-01/telemetry", []byte(`{"mileage":10}`), false, qos))
+	require.NoError(t, server.Publish("fleet/MQTT-0001/telemetry", []byte(`{
+		"timestamp": 1718000000,
+		"location": {"latitude": 52.52, "longitude": 13.405},
+		"mileage": 12000.5,
+		"fuelLevel": 64
+	}`), false, qos))
+
+	require.Eventually(t, func() bool {
+		commands, _ := bus.recorded()
+		return len(commands) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	commands, tenants := bus.recorded()
+	location, ok := commands[0].(*command.UpdateVehicleLocationCommand)
+	require.True(t, ok)
+	assert.Equal(t, vehicleID, location.VehicleID)
+	assert.Equal(t, 52.52, location.Latitude)
+	assert.Equal(t, int64(1718000000), location.Timestamp)
+
+	mileage, ok := commands[1].(*command.UpdateVehicleMileageCommand)
+	require.True(t, ok)
+	assert.Equal(t, 12000.5, mileage.Mileage)
+
+	energy, ok := commands[2].(*command.UpdateVehicleEnergyLevelCommand)
+	require.True(t, ok)
+	assert.Equal(t, 64.0, energy.Level)
+
+	assert.Equal(t, []string{"fleet-a", "fleet-a", "fleet-a"}, tenants)
+}
+
+// failingBus fails every command with err.
+type failingBus struct {
+	err error
+}
+
+func (b *failingBus) Dispatch(ctx context.Context, cmd command.Command) error {
+	return b.err
+}
+
+func (b *failingBus) Register(commandName string, handler command.CommandHandler) {}
+
+func TestBridge_LeavesTelemetryUnackedWhenCommandFails(t *testing.T) {
+	hardwareID, _ := valueobject.NewHardwareID("MQTT-0001")
+	pairedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	device := entity.LoadDeviceFromHistory(valueobject.GenerateDeviceID(), "fleet-a", hardwareID, valueobject.ProtocolMQTT,
+		"", "", []entity.DevicePairing{{VehicleID: valueobject.GenerateVehicleID().String(), PairedAt: pairedAt}}, valueobject.Version{}, pairedAt, pairedAt)
+	devices := &stubDeviceRepo{devices: map[string]*entity.Device{hardwareID.String(): device}}
+	cfg := config.MQTTConfig{BrokerURL: "tcp://127.0.0.1:1", ClientID: "vehicle-svc-test", Topics: []string{"fleet/+/telemetry"}}
+	payload := []byte(`{"timestamp": 1718000000, "mileage": 12000.5}`)
+	received := time.Unix(1718000000, 0)
+
+	// A bus failure must reach handle so the message is not acknowledged.
+	busDown := errors.New("connection refused")
+	bridge := NewBridge(cfg, devices, &failingBus{err: busDown}, zap.NewNop())
+	err := bridge.process(context.Background(), "fleet/MQTT-0001/telemetry", payload, received)
+	assert.ErrorIs(t, err, busDown)
+
+	// A reading the vehicle refuses would fail again on redelivery.
+	bridge = NewBridge(cfg, devices, &failingBus{err: fmt.Errorf("failed to update mileage: %w", entity.ErrMileageDecreased)}, zap.NewNop())
+	err = bridge.process(context.Background(), "fleet/MQTT-0001/telemetry", payload, received)
+	assert.NoError(t, err)
+}
+
+func TestDeviceFromTopic(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		device string
+		match  bool
+	}{
+		{"fleet/+/telemetry", "fleet/ABC123/telemetry", "ABC123", true},
+		{"fleet/+/telemetry", "fleet/ABC123/status", "", false},
+		{"fleet/+/telemetry", "fleet/ABC123", "", false},
+		{"tenants/+/devices/+/#", "tenants/acme/devices/ABC123/gps/raw", "acme", true},
+		{"devices/#", "devices/ABC123", "", true},
+	}
+
+	for _, tt := range tests {
+		device, match := deviceFromTopic(tt.filter, tt.topic)
+		assert.Equal(t, tt.device, device, tt.topic)
+		assert.Equal(t, tt.match, match, tt.topic)
+	}
+}
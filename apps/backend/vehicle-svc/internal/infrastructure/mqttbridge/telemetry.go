@@ -0,0 +1,91 @@
+package mqttbridge
+
+import (
+	"strings"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+)
+
+// Telemetry is the JSON document a device publishes. Devices send only what
+// they measure; each part present becomes its own command.
+type Telemetry struct {
+	DeviceID       string    `json:"deviceId"`  // hardware id; taken from the topic when empty
+	Timestamp      int64     `json:"timestamp"` // device time, unix seconds; receive time when zero
+	Location       *Location `json:"location"`
+	Mileage        *float64  `json:"mileage"`
+	FuelLevel      *float64  `json:"fuelLevel"` // percent, or state of charge for electric vehicles
+	ChargingState  string    `json:"chargingState"`
+	EstimatedRange *float64  `json:"estimatedRange"`
+	Units          string    `json:"units"` // unit system of mileage and estimatedRange; empty means metric
+}
+
+type Location struct {
+	Latitude   float64  `json:"latitude"`
+	Longitude  float64  `json:"longitude"`
+	Altitude   float64  `json:"altitude"`
+	SpeedKmh   *float64 `json:"speedKmh"`
+	Heading    *float64 `json:"heading"`
+	Satellites *int     `json:"satellites"`
+	HDOP       *float64 `json:"hdop"`
+}
+
+// Commands maps the telemetry onto commands for vehicleID, location first.
+func (t Telemetry) Commands(vehicleID string) []command.Command {
+	var commands []command.Command
+	if t.Location != nil {
+		commands = append(commands, &command.UpdateVehicleLocationCommand{
+			VehicleID:  vehicleID,
+			Latitude:   t.Location.Latitude,
+			Longitude:  t.Location.Longitude,
+			Altitude:   t.Location.Altitude,
+			Timestamp:  t.Timestamp,
+			SpeedKmh:   t.Location.SpeedKmh,
+			Heading:    t.Location.Heading,
+			Satellites: t.Location.Satellites,
+			HDOP:       t.Location.HDOP,
+		})
+	}
+	if t.Mileage != nil {
+		commands = append(commands, &command.UpdateVehicleMileageCommand{
+			VehicleID: vehicleID,
+			Mileage:   *t.Mileage,
+			Units:     t.Units,
+		})
+	}
+	if t.FuelLevel != nil {
+		commands = append(commands, &command.UpdateVehicleEnergyLevelCommand{
+			VehicleID:      vehicleID,
+			Level:          *t.FuelLevel,
+			ChargingState:  t.ChargingState,
+			EstimatedRange: t.EstimatedRange,
+			Units:          t.Units,
+		})
+	}
+	return commands
+}
+
+// deviceFromTopic returns the topic level matched by the first + wildcard of
+// filter, and whether topic matches filter at all.
+func deviceFromTopic(filter, topic string) (string, bool) {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+
+	device := ""
+	for i, level := range filterLevels {
+		if level == "#" {
+			return device, true
+		}
+		if i >= len(topicLevels) {
+			return "", false
+		}
+		switch {
+		case level == "+":
+			if device == "" {
+				device = topicLevels[i]
+			}
+		case level != topicLevels[i]:
+			return "", false
+		}
+	}
+	return device, len(filterLevels) == len(topicLevels)
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// geoPointDocument is a GeoJSON point. GeoJSON puts longitude first.
+type geoPointDocument struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+func newGeoPoint(latitude, longitude float64) geoPointDocument {
+	return geoPointDocument{Type: "Point", Coordinates: []float64{longitude, latitude}}
+}
+
+// ensureGeoIndex creates a 2dsphere index on field, first filling it in from
+// the latitude and longitude of documents written before it existed.
+func ensureGeoIndex(ctx context.Context, collection *mongo.Collection, field string) error {
+	backfill := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		field: bson.M{
+			"type":        "Point",
+			"coordinates": bson.A{"$longitude", "$latitude"},
+		},
+	}}}}
+	if _, err := collection.UpdateMany(ctx, bson.M{field: bson.M{"$exists": false}}, backfill); err != nil {
+		return fmt.Errorf("failed to backfill %s: %w", field, err)
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: field, Value: "2dsphere"}},
+		Options: options.Index().SetName(field + "_2dsphere"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s index: %w", field, err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MongoAttributeSchemaRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoAttributeSchemaRepository(collection *mongo.Collection) *MongoAttributeSchemaRepository {
+	return &MongoAttributeSchemaRepository{collection: collection}
+}
+
+// attributeSchemaDocument is keyed by tenant id; each tenant has one schema.
+type attributeSchemaDocument struct {
+	ID          string                        `bson:"_id"`
+	TenantID    string                        `bson:"tenantId"`
+	Definitions []attributeDefinitionDocument `bson:"definitions"`
+	Version     int64                         `bson:"version"`
+	UpdatedAt   int64                         `bson:"updatedAt"`
+}
+
+type attributeDefinitionDocument struct {
+	Name        string   `bson:"name"`
+	Type        string   `bson:"type"`
+	Required    bool     `bson:"required"`
+	Enum        []string `bson:"enum,omitempty"`
+	Description string   `bson:"description,omitempty"`
+}
+
+func (r *MongoAttributeSchemaRepository) Save(ctx context.Context, schema *entity.AttributeSchema) error {
+	doc := attributeSchemaDocument{
+		ID:        schema.TenantID(),
+		TenantID:  schema.TenantID(),
+		Version:   schema.Version().Value(),
+		UpdatedAt: schema.UpdatedAt().Unix(),
+	}
+	for _, def := range schema.Definitions() {
+		doc.Definitions = append(doc.Definitions, attributeDefinitionDocument{
+			Name:        def.Name(),
+			Type:        string(def.Type()),
+			Required:    def.Required(),
+			Enum:        def.Enum(),
+			Description: def.Description(),
+		})
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":     schema.TenantID(),
+		"version": schema.Version().Value() - 1,
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save attribute schema: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: attribute schema version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoAttributeSchemaRepository) Find(ctx context.Context) (*entity.AttributeSchema, error) {
+	tenantID := tenant.ID(ctx)
+
+	var doc attributeSchemaDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": tenantID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return entity.NewAttributeSchema(tenantID)
+		}
+		return nil, fmt.Errorf("failed to find attribute schema: %w", err)
+	}
+
+	definitions := make([]valueobject.AttributeDefinition, 0, len(doc.Definitions))
+	for _, d := range doc.Definitions {
+		def, err := valueobject.NewAttributeDefinition(d.Name, valueobject.AttributeType(d.Type), d.Required, d.Enum, d.Description)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attribute definition from database: %w", err)
+		}
+		definitions = append(definitions, def)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	return entity.LoadAttributeSchemaFromHistory(tenantID, definitions, version, time.Unix(doc.UpdatedAt, 0)), nil
+}
@@ -0,0 +1,195 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MongoDeviceRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDeviceRepository(collection *mongo.Collection) *MongoDeviceRepository {
+	return &MongoDeviceRepository{collection: collection}
+}
+
+type deviceDocument struct {
+	ID         string                  `bson:"_id"`
+	TenantID   string                  `bson:"tenantId"`
+	HardwareID string                  `bson:"hardwareId"`
+	Protocol   string                  `bson:"protocol"`
+	Firmware   string                  `bson:"firmware"`
+	SIM        string                  `bson:"sim"`
+	Pairings   []devicePairingDocument `bson:"pairings"`
+	Version    int64                   `bson:"version"`
+	CreatedAt  int64                   `bson:"createdAt"`
+	UpdatedAt  int64                   `bson:"updatedAt"`
+}
+
+type devicePairingDocument struct {
+	VehicleID  string `bson:"vehicleId"`
+	PairedAt   int64  `bson:"pairedAt"`
+	UnpairedAt *int64 `bson:"unpairedAt"`
+}
+
+func (r *MongoDeviceRepository) Save(ctx context.Context, device *entity.Device) error {
+	doc := deviceDocument{
+		ID:         device.ID().String(),
+		TenantID:   device.TenantID(),
+		HardwareID: device.HardwareID().String(),
+		Protocol:   device.Protocol().String(),
+		Firmware:   device.Firmware(),
+		SIM:        device.SIM(),
+		Pairings:   []devicePairingDocument{},
+		Version:    device.Version().Value(),
+		CreatedAt:  device.CreatedAt().Unix(),
+		UpdatedAt:  device.UpdatedAt().Unix(),
+	}
+	for _, p := range device.Pairings() {
+		pairing := devicePairingDocument{VehicleID: p.VehicleID, PairedAt: p.PairedAt.Unix()}
+		if p.UnpairedAt != nil {
+			ts := p.UnpairedAt.Unix()
+			pairing.UnpairedAt = &ts
+		}
+		doc.Pairings = append(doc.Pairings, pairing)
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      device.ID().String(),
+		"version":  device.Version().Value() - 1,
+		"tenantId": tenantMatch(device.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save device: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: device version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoDeviceRepository) FindByID(ctx context.Context, id valueobject.DeviceID) (*entity.Device, error) {
+	var doc deviceDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("device not found: %s", id.String())
+		}
+		return nil, fmt.Errorf("failed to find device: %w", err)
+	}
+
+	return toDeviceEntity(doc)
+}
+
+func (r *MongoDeviceRepository) FindByHardwareID(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	var doc deviceDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"hardwareId": hardwareID.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find device: %w", err)
+	}
+
+	return toDeviceEntity(doc)
+}
+
+func (r *MongoDeviceRepository) FindByHardwareIDInAnyTenant(ctx context.Context, hardwareID valueobject.HardwareID) (*entity.Device, error) {
+	var doc deviceDocument
+	err := r.collection.FindOne(ctx, bson.M{"hardwareId": hardwareID.String()}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find device: %w", err)
+	}
+
+	return toDeviceEntity(doc)
+}
+
+func (r *MongoDeviceRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Device, error) {
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find devices: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []deviceDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode devices: %w", err)
+	}
+
+	var results []*entity.Device
+	for _, doc := range docs {
+		device, err := toDeviceEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, device)
+	}
+
+	return results, nil
+}
+
+func toDeviceEntity(doc deviceDocument) (*entity.Device, error) {
+	deviceID, err := valueobject.NewDeviceID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid device id from database: %w", err)
+	}
+	hardwareID, err := valueobject.NewHardwareID(doc.HardwareID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hardware id from database: %w", err)
+	}
+	protocol, err := valueobject.NewDeviceProtocol(doc.Protocol)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protocol from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	pairings := make([]entity.DevicePairing, 0, len(doc.Pairings))
+	for _, p := range doc.Pairings {
+		pairing := entity.DevicePairing{VehicleID: p.VehicleID, PairedAt: time.Unix(p.PairedAt, 0)}
+		if p.UnpairedAt != nil {
+			t := time.Unix(*p.UnpairedAt, 0)
+			pairing.UnpairedAt = &t
+		}
+		pairings = append(pairings, pairing)
+	}
+
+	return entity.LoadDeviceFromHistory(
+		deviceID,
+		tenantOrDefault(doc.TenantID),
+		hardwareID,
+		protocol,
+		doc.Firmware,
+		doc.SIM,
+		pairings,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,225 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+// driverAssignmentIndex allows one assigned driver per vehicle and tenant.
+const driverAssignmentIndex = "tenantId_assignedVehicleId_unique"
+
+type MongoDriverRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDriverRepository(collection *mongo.Collection) *MongoDriverRepository {
+	return &MongoDriverRepository{collection: collection}
+}
+
+// EnsureIndexes creates the unique index on assignments. It settles two
+// drivers being assigned the same vehicle at once, which the check before
+// saving cannot.
+func (r *MongoDriverRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "tenantId", Value: 1}, {Key: "assignedVehicleId", Value: 1}},
+		Options: options.Index().
+			SetName(driverAssignmentIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"assignedVehicleId": bson.M{"$gt": ""}}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create driver assignment index: %w", err)
+	}
+	return nil
+}
+
+type driverDocument struct {
+	ID                string `bson:"_id"`
+	TenantID          string `bson:"tenantId"`
+	Name              string `bson:"name"`
+	LicenseNumber     string `bson:"licenseNumber"`
+	Phone             string `bson:"phone"`
+	AssignedVehicleID string `bson:"assignedVehicleId"`
+	AssignedAt        *int64 `bson:"assignedAt"`
+	Version           int64  `bson:"version"`
+	CreatedAt         int64  `bson:"createdAt"`
+	UpdatedAt         int64  `bson:"updatedAt"`
+}
+
+func (r *MongoDriverRepository) Save(ctx context.Context, driver *entity.Driver) error {
+	doc := driverDocument{
+		ID:                driver.ID().String(),
+		TenantID:          driver.TenantID(),
+		Name:              driver.Name(),
+		LicenseNumber:     driver.LicenseNumber().String(),
+		Phone:             driver.Phone(),
+		AssignedVehicleID: driver.AssignedVehicleID(),
+		Version:           driver.Version().Value(),
+		CreatedAt:         driver.CreatedAt().Unix(),
+		UpdatedAt:         driver.UpdatedAt().Unix(),
+	}
+	if assignedAt := driver.AssignedAt(); assignedAt != nil {
+		ts := assignedAt.Unix()
+		doc.AssignedAt = &ts
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      driver.ID().String(),
+		"version":  driver.Version().Value() - 1,
+		"tenantId": tenantMatch(driver.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if isDuplicateKeyOn(err, driverAssignmentIndex) {
+		return fmt.Errorf("%w: %s", entity.ErrVehicleAlreadyAssigned, driver.AssignedVehicleID())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: driver version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoDriverRepository) FindByID(ctx context.Context, id valueobject.DriverID) (*entity.Driver, error) {
+	var doc driverDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("driver not found: %s", id.String())
+		}
+		return nil, fmt.Errorf("failed to find driver: %w", err)
+	}
+
+	return toDriverEntity(doc)
+}
+
+func (r *MongoDriverRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Driver, error) {
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find drivers: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []driverDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode drivers: %w", err)
+	}
+
+	var results []*entity.Driver
+	for _, doc := range docs {
+		driver, err := toDriverEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, driver)
+	}
+
+	return results, nil
+}
+
+func (r *MongoDriverRepository) FindByAssignedVehicle(ctx context.Context, vehicleID valueobject.VehicleID) (*entity.Driver, error) {
+	var doc driverDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"assignedVehicleId": vehicleID.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find assigned driver: %w", err)
+	}
+
+	return toDriverEntity(doc)
+}
+
+func (r *MongoDriverRepository) Delete(ctx context.Context, id valueobject.DriverID) error {
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": id.String()}))
+	if err != nil {
+		return fmt.Errorf("failed to delete driver: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("driver not found: %s", id.String())
+	}
+
+	return nil
+}
+
+func (r *MongoDriverRepository) ExistsByLicenseNumber(ctx context.Context, licenseNumber string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, scoped(ctx, bson.M{"licenseNumber": licenseNumber}))
+	if err != nil {
+		return false, fmt.Errorf("failed to check license number existence: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func toDriverEntity(doc driverDocument) (*entity.Driver, error) {
+	driverID, err := valueobject.NewDriverID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid driver id from database: %w", err)
+	}
+	licenseNumber, err := valueobject.NewLicenseNumber(doc.LicenseNumber)
+	if err != nil {
+		return nil, fmt.Errorf("invalid license number from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	var assignedAt *time.Time
+	if doc.AssignedAt != nil {
+		t := time.Unix(*doc.AssignedAt, 0)
+		assignedAt = &t
+	}
+
+	return entity.LoadDriverFromHistory(
+		driverID,
+		tenantOrDefault(doc.TenantID),
+		doc.Name,
+		licenseNumber,
+		doc.Phone,
+		doc.AssignedVehicleID,
+		assignedAt,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
+
+// isDuplicateKeyOn reports whether err is a duplicate key on the named index
+// rather than on _id, which an upsert with a stale version also hits.
+func isDuplicateKeyOn(err error, index string) bool {
+	var writeErr mongo.WriteException
+	if !errors.As(err, &writeErr) {
+		return false
+	}
+	for _, e := range writeErr.WriteErrors {
+		if e.Code == 11000 && strings.Contains(e.Message, index) {
+			return true
+		}
+	}
+	return false
+}
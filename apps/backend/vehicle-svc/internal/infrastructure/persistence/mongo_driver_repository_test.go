@@ -0,0 +1,25 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestIsDuplicateKeyOn_OnlyMatchesNamedIndex(t *testing.T) {
+	duplicate := func(message string) error {
+		return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: message}}}
+	}
+
+	assert.True(t, isDuplicateKeyOn(
+		duplicate(`E11000 duplicate key error collection: fleet.drivers index: tenantId_assignedVehicleId_unique dup key: { tenantId: "fleet-a", assignedVehicleId: "v-1" }`),
+		driverAssignmentIndex))
+	// A stale version makes the upsert collide on _id instead.
+	assert.False(t, isDuplicateKeyOn(
+		duplicate(`E11000 duplicate key error collection: fleet.drivers index: _id_ dup key: { _id: "d-1" }`),
+		driverAssignmentIndex))
+	assert.False(t, isDuplicateKeyOn(errors.New("connection reset"), driverAssignmentIndex))
+	assert.False(t, isDuplicateKeyOn(nil, driverAssignmentIndex))
+}
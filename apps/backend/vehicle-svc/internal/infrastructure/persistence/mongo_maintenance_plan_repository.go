@@ -0,0 +1,161 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MongoMaintenancePlanRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoMaintenancePlanRepository(collection *mongo.Collection) *MongoMaintenancePlanRepository {
+	return &MongoMaintenancePlanRepository{collection: collection}
+}
+
+type maintenancePlanDocument struct {
+	ID              string  `bson:"_id"`
+	TenantID        string  `bson:"tenantId"`
+	VehicleModel    string  `bson:"vehicleModel"`
+	Name            string  `bson:"name"`
+	IntervalKm      float64 `bson:"intervalKm"`
+	IntervalMonths  int     `bson:"intervalMonths"`
+	AutoMaintenance bool    `bson:"autoMaintenance"`
+	Version         int64   `bson:"version"`
+	CreatedAt       int64   `bson:"createdAt"`
+	UpdatedAt       int64   `bson:"updatedAt"`
+}
+
+func (r *MongoMaintenancePlanRepository) Save(ctx context.Context, plan *entity.MaintenancePlan) error {
+	doc := maintenancePlanDocument{
+		ID:              plan.ID().String(),
+		TenantID:        plan.TenantID(),
+		VehicleModel:    plan.VehicleModel(),
+		Name:            plan.Name(),
+		IntervalKm:      plan.Interval().Kilometers(),
+		IntervalMonths:  plan.Interval().Months(),
+		AutoMaintenance: plan.AutoMaintenance(),
+		Version:         plan.Version().Value(),
+		CreatedAt:       plan.CreatedAt().Unix(),
+		UpdatedAt:       plan.UpdatedAt().Unix(),
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      plan.ID().String(),
+		"version":  plan.Version().Value() - 1,
+		"tenantId": tenantMatch(plan.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save maintenance plan: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: maintenance plan version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoMaintenancePlanRepository) FindByID(ctx context.Context, id valueobject.MaintenancePlanID) (*entity.MaintenancePlan, error) {
+	var doc maintenancePlanDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("maintenance plan not found: %s", id.String())
+		}
+		return nil, fmt.Errorf("failed to find maintenance plan: %w", err)
+	}
+
+	return toMaintenancePlanEntity(doc)
+}
+
+func (r *MongoMaintenancePlanRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.MaintenancePlan, error) {
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
+	return r.find(ctx, scoped(ctx, bson.M{}), opts)
+}
+
+func (r *MongoMaintenancePlanRepository) FindByVehicleModel(ctx context.Context, vehicleModel string) ([]*entity.MaintenancePlan, error) {
+	return r.find(ctx, scoped(ctx, bson.M{"vehicleModel": vehicleModel}), options.Find())
+}
+
+func (r *MongoMaintenancePlanRepository) Delete(ctx context.Context, id valueobject.MaintenancePlanID) error {
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": id.String()}))
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance plan: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("maintenance plan not found: %s", id.String())
+	}
+
+	return nil
+}
+
+func (r *MongoMaintenancePlanRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.MaintenancePlan, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance plans: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []maintenancePlanDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode maintenance plans: %w", err)
+	}
+
+	var results []*entity.MaintenancePlan
+	for _, doc := range docs {
+		plan, err := toMaintenancePlanEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, plan)
+	}
+
+	return results, nil
+}
+
+func toMaintenancePlanEntity(doc maintenancePlanDocument) (*entity.MaintenancePlan, error) {
+	planID, err := valueobject.NewMaintenancePlanID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance plan id from database: %w", err)
+	}
+	interval, err := valueobject.NewMaintenanceInterval(doc.IntervalKm, doc.IntervalMonths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance interval from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	return entity.LoadMaintenancePlanFromHistory(
+		planID,
+		tenantOrDefault(doc.TenantID),
+		doc.VehicleModel,
+		doc.Name,
+		interval,
+		doc.AutoMaintenance,
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
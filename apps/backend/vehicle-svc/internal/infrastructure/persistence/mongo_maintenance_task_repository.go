@@ -0,0 +1,258 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MongoMaintenanceTaskRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoMaintenanceTaskRepository(collection *mongo.Collection) *MongoMaintenanceTaskRepository {
+	return &MongoMaintenanceTaskRepository{collection: collection}
+}
+
+type maintenanceTaskDocument struct {
+	ID               string  `bson:"_id"`
+	TenantID         string  `bson:"tenantId"`
+	PlanID           string  `bson:"planId"`
+	PlanName         string  `bson:"planName"`
+	VehicleID        string  `bson:"vehicleId"`
+	Status           string  `bson:"status"`
+	DueMileage       float64 `bson:"dueMileage"`
+	OverdueMileage   float64 `bson:"overdueMileage"`
+	DueAt            *int64  `bson:"dueAt"`
+	OverdueAt        *int64  `bson:"overdueAt"`
+	CompletedMileage float64 `bson:"completedMileage"`
+	CompletedAt      *int64  `bson:"completedAt"`
+	Version          int64   `bson:"version"`
+	CreatedAt        int64   `bson:"createdAt"`
+	UpdatedAt        int64   `bson:"updatedAt"`
+}
+
+func (r *MongoMaintenanceTaskRepository) Save(ctx context.Context, task *entity.MaintenanceTask) error {
+	doc := maintenanceTaskDocument{
+		ID:               task.ID().String(),
+		TenantID:         task.TenantID(),
+		PlanID:           task.PlanID().String(),
+		PlanName:         task.PlanName(),
+		VehicleID:        task.VehicleID().String(),
+		Status:           string(task.Status()),
+		DueMileage:       task.DueMileage(),
+		OverdueMileage:   task.OverdueMileage(),
+		DueAt:            unixOrNil(task.DueAt()),
+		OverdueAt:        unixOrNil(task.OverdueAt()),
+		CompletedMileage: task.CompletedMileage(),
+		CompletedAt:      unixOrNil(task.CompletedAt()),
+		Version:          task.Version().Value(),
+		CreatedAt:        task.CreatedAt().Unix(),
+		UpdatedAt:        task.UpdatedAt().Unix(),
+	}
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{
+		"_id":      task.ID().String(),
+		"version":  task.Version().Value() - 1,
+		"tenantId": tenantMatch(task.TenantID()),
+	}
+	update := bson.M{
+		"$set": doc,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save maintenance task: %w", err)
+	}
+
+	if result.UpsertedID != nil {
+		return nil
+	}
+
+	if result.ModifiedCount == 0 && result.MatchedCount == 0 {
+		return fmt.Errorf("optimistic concurrency conflict: maintenance task version mismatch")
+	}
+
+	return nil
+}
+
+func (r *MongoMaintenanceTaskRepository) FindByID(ctx context.Context, id valueobject.MaintenanceTaskID) (*entity.MaintenanceTask, error) {
+	var doc maintenanceTaskDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("maintenance task not found: %s", id.String())
+		}
+		return nil, fmt.Errorf("failed to find maintenance task: %w", err)
+	}
+
+	return toMaintenanceTaskEntity(doc)
+}
+
+func (r *MongoMaintenanceTaskRepository) FindOpen(ctx context.Context) ([]*entity.MaintenanceTask, error) {
+	filter := bson.M{"status": bson.M{"$ne": string(valueobject.MaintenanceCompleted)}}
+	cursor, err := r.collection.Find(ctx, scoped(ctx, filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance tasks: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []maintenanceTaskDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode maintenance tasks: %w", err)
+	}
+
+	var results []*entity.MaintenanceTask
+	for _, doc := range docs {
+		task, err := toMaintenanceTaskEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, task)
+	}
+
+	return results, nil
+}
+
+func (r *MongoMaintenanceTaskRepository) FindDateDueInAnyTenant(ctx context.Context, now time.Time) ([]*entity.MaintenanceTask, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{
+			"status": string(valueobject.MaintenanceScheduled),
+			"dueAt":  bson.M{"$lte": now.Unix()},
+		},
+		bson.M{
+			"status":    bson.M{"$in": bson.A{string(valueobject.MaintenanceScheduled), string(valueobject.MaintenanceDue)}},
+			"overdueAt": bson.M{"$lte": now.Unix()},
+		},
+	}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find date-due maintenance tasks: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []maintenanceTaskDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode maintenance tasks: %w", err)
+	}
+
+	var results []*entity.MaintenanceTask
+	for _, doc := range docs {
+		task, err := toMaintenanceTaskEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, task)
+	}
+
+	return results, nil
+}
+
+func (r *MongoMaintenanceTaskRepository) FindOpenByVehicleAndPlan(ctx context.Context, vehicleID valueobject.VehicleID, planID valueobject.MaintenancePlanID) (*entity.MaintenanceTask, error) {
+	filter := bson.M{
+		"vehicleId": vehicleID.String(),
+		"planId":    planID.String(),
+		"status":    bson.M{"$ne": string(valueobject.MaintenanceCompleted)},
+	}
+	return r.findOne(ctx, filter, options.FindOne())
+}
+
+func (r *MongoMaintenanceTaskRepository) FindLastCompletedByVehicleAndPlan(ctx context.Context, vehicleID valueobject.VehicleID, planID valueobject.MaintenancePlanID) (*entity.MaintenanceTask, error) {
+	filter := bson.M{
+		"vehicleId": vehicleID.String(),
+		"planId":    planID.String(),
+		"status":    string(valueobject.MaintenanceCompleted),
+	}
+	opts := options.FindOne().SetSort(bson.M{"completedAt": -1})
+	return r.findOne(ctx, filter, opts)
+}
+
+func (r *MongoMaintenanceTaskRepository) DeleteOpenByPlan(ctx context.Context, planID valueobject.MaintenancePlanID) error {
+	filter := bson.M{
+		"planId": planID.String(),
+		"status": bson.M{"$ne": string(valueobject.MaintenanceCompleted)},
+	}
+	if _, err := r.collection.DeleteMany(ctx, scoped(ctx, filter)); err != nil {
+		return fmt.Errorf("failed to delete maintenance tasks: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MongoMaintenanceTaskRepository) findOne(ctx context.Context, filter bson.M, opts *options.FindOneOptions) (*entity.MaintenanceTask, error) {
+	var doc maintenanceTaskDocument
+	err := r.collection.FindOne(ctx, scoped(ctx, filter), opts).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find maintenance task: %w", err)
+	}
+
+	return toMaintenanceTaskEntity(doc)
+}
+
+func toMaintenanceTaskEntity(doc maintenanceTaskDocument) (*entity.MaintenanceTask, error) {
+	taskID, err := valueobject.NewMaintenanceTaskID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance task id from database: %w", err)
+	}
+	planID, err := valueobject.NewMaintenancePlanID(doc.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance plan id from database: %w", err)
+	}
+	vehicleID, err := valueobject.NewVehicleID(doc.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id from database: %w", err)
+	}
+	status, err := valueobject.NewMaintenanceTaskStatus(doc.Status)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance task status from database: %w", err)
+	}
+	version, err := valueobject.NewVersion(doc.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version from database: %w", err)
+	}
+
+	return entity.LoadMaintenanceTaskFromHistory(
+		taskID,
+		tenantOrDefault(doc.TenantID),
+		planID,
+		doc.PlanName,
+		vehicleID,
+		status,
+		doc.DueMileage,
+		doc.OverdueMileage,
+		timeOrNil(doc.DueAt),
+		timeOrNil(doc.OverdueAt),
+		doc.CompletedMileage,
+		timeOrNil(doc.CompletedAt),
+		version,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
+
+func unixOrNil(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	ts := t.Unix()
+	return &ts
+}
+
+func timeOrNil(ts *int64) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := time.Unix(*ts, 0)
+	return &t
+}
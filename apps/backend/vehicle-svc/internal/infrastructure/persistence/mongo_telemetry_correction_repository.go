@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
+)
+
+type MongoTelemetryCorrectionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoTelemetryCorrectionRepository(collection *mongo.Collection) *MongoTelemetryCorrectionRepository {
+	return &MongoTelemetryCorrectionRepository{collection: collection}
+}
+
+type telemetryCorrectionDocument struct {
+	ID        string  `bson:"_id"`
+	TenantID  string  `bson:"tenantId"`
+	VehicleID string  `bson:"vehicleId"`
+	Field     string  `bson:"field"`
+	OldValue  float64 `bson:"oldValue"`
+	NewValue  float64 `bson:"newValue"`
+	Reason    string  `bson:"reason"`
+	Actor     string  `bson:"actor"`
+	AppliedAt int64   `bson:"appliedAt"`
+}
+
+func (r *MongoTelemetryCorrectionRepository) Save(ctx context.Context, correction *entity.TelemetryCorrection) error {
+	doc := telemetryCorrectionDocument{
+		ID:        correction.ID().String(),
+		TenantID:  correction.TenantID(),
+		VehicleID: correction.VehicleID().String(),
+		Field:     string(correction.Field()),
+		OldValue:  correction.OldValue(),
+		NewValue:  correction.NewValue(),
+		Reason:    correction.Reason(),
+		Actor:     correction.Actor().String(),
+		AppliedAt: correction.AppliedAt().Unix(),
+	}
+
+	// Corrections are an append-only audit trail.
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("failed to save telemetry correction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MongoTelemetryCorrectionRepository) FindByVehicleID(ctx context.Context, vehicleID valueobject.VehicleID, limit int, offset int) ([]*entity.TelemetryCorrection, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "appliedAt", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, scoped(ctx, bson.M{"vehicleId": vehicleID.String()}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find telemetry corrections: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []telemetryCorrectionDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode telemetry corrections: %w", err)
+	}
+
+	var results []*entity.TelemetryCorrection
+	for _, doc := range docs {
+		correction, err := toTelemetryCorrectionEntity(doc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, correction)
+	}
+
+	return results, nil
+}
+
+func toTelemetryCorrectionEntity(doc telemetryCorrectionDocument) (*entity.TelemetryCorrection, error) {
+	correctionID, err := valueobject.NewTelemetryCorrectionID(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid telemetry correction id from database: %w", err)
+	}
+
+	vehicleID, err := valueobject.NewVehicleID(doc.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vehicle id from database: %w", err)
+	}
+
+	field, err := valueobject.NewCorrectionField(doc.Field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid correction field from database: %w", err)
+	}
+
+	actor, err := valueobject.NewActor(doc.Actor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor from database: %w", err)
+	}
+
+	return entity.LoadTelemetryCorrectionFromHistory(
+		correctionID,
+		tenantOrDefault(doc.TenantID),
+		vehicleID,
+		field,
+		doc.OldValue,
+		doc.NewValue,
+		doc.Reason,
+		actor,
+		time.Unix(doc.AppliedAt, 0),
+	), nil
+}
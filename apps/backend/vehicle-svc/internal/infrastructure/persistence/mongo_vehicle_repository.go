@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -10,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 )
 
@@ -23,7 +25,10 @@ func NewMongoVehicleRepository(collection *mongo.Collection) *MongoVehicleReposi
 
 type vehicleDocument struct {
 	ID            string  `bson:"_id"`
+	TenantID      string  `bson:"tenantId"`
 	VIN           string  `bson:"vin"`
+	Manufacturer  string  `bson:"manufacturer,omitempty"` // decoded from the VIN for filtering
+	ModelYear     int     `bson:"modelYear,omitempty"`
 	VehicleName   string  `bson:"vehicleName"`
 	VehicleModel  string  `bson:"vehicleModel"`
 	LicenseNumber string  `bson:"licenseNumber"`
@@ -31,35 +36,74 @@ type vehicleDocument struct {
 	Latitude      float64 `bson:"latitude"`
 	Longitude     float64 `bson:"longitude"`
 	Altitude      float64 `bson:"altitude"`
-	Mileage       float64 `bson:"mileage"`
-	FuelLevel     float64 `bson:"fuelLevel"`
-	Version       int64   `bson:"version"`
-	CreatedAt     int64   `bson:"createdAt"`
-	UpdatedAt     int64   `bson:"updatedAt"`
+	// Position repeats latitude and longitude as GeoJSON for the 2dsphere
+	// index.
+	Position geoPointDocument `bson:"position"`
+	// LocationAt is the device time of the position; zero for vehicles
+	// stored before it was kept, which fall back to CreatedAt.
+	LocationAt     int64    `bson:"locationAt"`
+	SpeedKmh       *float64 `bson:"speedKmh"`
+	Heading        *float64 `bson:"heading"`
+	Satellites     *int     `bson:"satellites"`
+	HDOP           *float64 `bson:"hdop"`
+	Mileage        float64  `bson:"mileage"`
+	FuelLevel      float64  `bson:"fuelLevel"`
+	EnergyType     string   `bson:"energyType,omitempty"` // empty for vehicles stored before energy types
+	EnergyCapacity float64  `bson:"energyCapacity"`
+	ChargingState  string   `bson:"chargingState"`
+	EstimatedRange *float64 `bson:"estimatedRangeKm"`
+	Version        int64    `bson:"version"`
+	CreatedAt      int64    `bson:"createdAt"`
+	UpdatedAt      int64    `bson:"updatedAt"`
+	DeletedAt      *int64   `bson:"deletedAt"`
+	DeletionReason string   `bson:"deletionReason,omitempty"`
+	// Not omitempty: Save uses $set, so clearing these must overwrite them.
+	Group      string                 `bson:"group"`
+	Tags       []string               `bson:"tags"`
+	Attributes map[string]interface{} `bson:"attributes"`
 }
 
 func (r *MongoVehicleRepository) Save(ctx context.Context, vehicle *entity.Vehicle) error {
 	doc := vehicleDocument{
-		ID:            vehicle.ID().String(),
-		VIN:           vehicle.VIN(),
-		VehicleName:   vehicle.VehicleName(),
-		VehicleModel:  vehicle.VehicleModel(),
-		LicenseNumber: vehicle.LicenseNumber().String(),
-		Status:        string(vehicle.Status()),
-		Latitude:      vehicle.CurrentLocation().Latitude(),
-		Longitude:     vehicle.CurrentLocation().Longitude(),
-		Altitude:      vehicle.CurrentLocation().Altitude(),
-		Mileage:       vehicle.Mileage().Kilometers(),
-		FuelLevel:     vehicle.FuelLevel().Percentage(),
-		Version:       vehicle.Version().Value(),
-		CreatedAt:     vehicle.CreatedAt().Unix(),
-		UpdatedAt:     vehicle.UpdatedAt().Unix(),
+		ID:             vehicle.ID().String(),
+		TenantID:       vehicle.TenantID(),
+		VIN:            vehicle.VIN().String(),
+		Manufacturer:   vehicle.VIN().Manufacturer(),
+		ModelYear:      vehicle.VIN().ModelYear(),
+		VehicleName:    vehicle.VehicleName(),
+		VehicleModel:   vehicle.VehicleModel(),
+		LicenseNumber:  vehicle.LicenseNumber().String(),
+		Status:         string(vehicle.Status()),
+		Latitude:       vehicle.CurrentLocation().Latitude(),
+		Longitude:      vehicle.CurrentLocation().Longitude(),
+		Altitude:       vehicle.CurrentLocation().Altitude(),
+		Position:       newGeoPoint(vehicle.CurrentLocation().Latitude(), vehicle.CurrentLocation().Longitude()),
+		LocationAt:     vehicle.CurrentLocation().Timestamp(),
+		SpeedKmh:       vehicle.CurrentLocation().SpeedKmh(),
+		Heading:        vehicle.CurrentLocation().Heading(),
+		Satellites:     vehicle.CurrentLocation().Satellites(),
+		HDOP:           vehicle.CurrentLocation().HDOP(),
+		Mileage:        vehicle.Mileage().Kilometers(),
+		FuelLevel:      vehicle.FuelLevel().Percentage(),
+		EnergyType:     string(vehicle.EnergySource().Type()),
+		EnergyCapacity: vehicle.EnergySource().Capacity(),
+		ChargingState:  string(vehicle.ChargingState()),
+		EstimatedRange: kilometersOrNil(vehicle.EstimatedRange()),
+		Version:        vehicle.Version().Value(),
+		CreatedAt:      vehicle.CreatedAt().Unix(),
+		UpdatedAt:      vehicle.UpdatedAt().Unix(),
+		DeletedAt:      unixOrNil(vehicle.DeletedAt()),
+		DeletionReason: vehicle.DeletionReason(),
+		Group:          vehicle.Group(),
+		Tags:           vehicle.Tags(),
+		Attributes:     vehicle.Attributes(),
 	}
 
 	opts := options.Update().SetUpsert(true)
 	filter := bson.M{
-		"_id":     vehicle.ID().String(),
-		"version": vehicle.Version().Value() - 1,
+		"_id":      vehicle.ID().String(),
+		"version":  vehicle.Version().Value() - 1,
+		"tenantId": tenantMatch(vehicle.TenantID()),
 	}
 	update := bson.M{
 		"$set": doc,
@@ -81,9 +125,14 @@ func (r *MongoVehicleRepository) Save(ctx context.Context, vehicle *entity.Vehic
 	return nil
 }
 
+// EnsureIndexes creates the 2dsphere index on current positions.
+func (r *MongoVehicleRepository) EnsureIndexes(ctx context.Context) error {
+	return ensureGeoIndex(ctx, r.collection, "position")
+}
+
 func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.VehicleID) (*entity.Vehicle, error) {
 	var doc vehicleDocument
-	err := r.collection.FindOne(ctx, bson.M{"_id": id.String()}).Decode(&doc)
+	err := r.collection.FindOne(ctx, scoped(ctx, bson.M{"_id": id.String()})).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, fmt.Errorf("vehicle not found: %s", id.String())
@@ -91,11 +140,12 @@ func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.Ve
 		return nil, fmt.Errorf("failed to find vehicle: %w", err)
 	}
 
+	vin := valueobject.RestoreVIN(doc.VIN)
 	status, err := valueobject.NewVehicleStatus(doc.Status)
 	if err != nil {
 		return nil, fmt.Errorf("invalid vehicle status from database: %w", err)
 	}
-	location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, doc.CreatedAt)
+	location, err := locationFromDocument(doc)
 	if err != nil {
 		return nil, fmt.Errorf("invalid location from database: %w", err)
 	}
@@ -111,6 +161,10 @@ func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.Ve
 	if err != nil {
 		return nil, fmt.Errorf("invalid fuel level from database: %w", err)
 	}
+	energySource, chargingState, estimatedRange, err := energyFromDocument(doc)
+	if err != nil {
+		return nil, err
+	}
 	version, err := valueobject.NewVersion(doc.Version)
 	if err != nil {
 		return nil, fmt.Errorf("invalid version from database: %w", err)
@@ -118,7 +172,8 @@ func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.Ve
 
 	vehicle := entity.LoadFromHistory(
 		id,
-		doc.VIN,
+		tenantOrDefault(doc.TenantID),
+		vin,
 		doc.VehicleName,
 		doc.VehicleModel,
 		licenseNumber,
@@ -126,17 +181,26 @@ func (r *MongoVehicleRepository) FindByID(ctx context.Context, id valueobject.Ve
 		location,
 		mileage,
 		fuelLevel,
+		energySource,
+		chargingState,
+		estimatedRange,
 		version,
 		time.Unix(doc.CreatedAt, 0),
 		time.Unix(doc.UpdatedAt, 0),
+		timeOrNil(doc.DeletedAt),
+		doc.DeletionReason,
+		doc.Group,
+		doc.Tags,
+		doc.Attributes,
 	)
 
 	return vehicle, nil
 }
 
-func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset int) ([]*entity.Vehicle, error) {
+func (r *MongoVehicleRepository) FindAll(ctx context.Context, filter repository.VehicleFilter, limit int, offset int) ([]*entity.Vehicle, error) {
+	query := vehicleQuery(ctx, filter)
 	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
-	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	cursor, err := r.collection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find vehicles: %w", err)
 	}
@@ -154,11 +218,12 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 		bson.Unmarshal(bsonBytes, &vehDoc)
 
 		vehicleID, _ := valueobject.NewVehicleID(vehDoc.ID)
+		vin := valueobject.RestoreVIN(vehDoc.VIN)
 		status, err := valueobject.NewVehicleStatus(doc.Status)
 		if err != nil {
 			return nil, fmt.Errorf("invalid vehicle status from database: %w", err)
 		}
-		location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, doc.CreatedAt)
+		location, err := locationFromDocument(doc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid location from database: %w", err)
 		}
@@ -174,6 +239,10 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 		if err != nil {
 			return nil, fmt.Errorf("invalid fuel level from database: %w", err)
 		}
+		energySource, chargingState, estimatedRange, err := energyFromDocument(vehDoc)
+		if err != nil {
+			return nil, err
+		}
 		version, err := valueobject.NewVersion(doc.Version)
 		if err != nil {
 			return nil, fmt.Errorf("invalid version from database: %w", err)
@@ -181,7 +250,8 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 
 		vehicle := entity.LoadFromHistory(
 			vehicleID,
-			vehDoc.VIN,
+			tenantOrDefault(vehDoc.TenantID),
+			vin,
 			vehDoc.VehicleName,
 			vehDoc.VehicleModel,
 			licenseNumber,
@@ -189,9 +259,17 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 			location,
 			mileage,
 			fuelLevel,
+			energySource,
+			chargingState,
+			estimatedRange,
 			version,
 			time.Unix(vehDoc.CreatedAt, 0),
 			time.Unix(vehDoc.UpdatedAt, 0),
+			timeOrNil(vehDoc.DeletedAt),
+			vehDoc.DeletionReason,
+			vehDoc.Group,
+			vehDoc.Tags,
+			vehDoc.Attributes,
 		)
 		results = append(results, vehicle)
 	}
@@ -199,8 +277,63 @@ func (r *MongoVehicleRepository) FindAll(ctx context.Context, limit int, offset
 	return results, nil
 }
 
+func energyFromDocument(doc vehicleDocument) (valueobject.EnergySource, valueobject.ChargingState, *valueobject.Mileage, error) {
+	energyType, err := valueobject.NewEnergyType(doc.EnergyType)
+	if err != nil {
+		return valueobject.EnergySource{}, "", nil, fmt.Errorf("invalid energy type from database: %w", err)
+	}
+	energySource, err := valueobject.NewEnergySource(energyType, doc.EnergyCapacity)
+	if err != nil {
+		return valueobject.EnergySource{}, "", nil, fmt.Errorf("invalid energy capacity from database: %w", err)
+	}
+	chargingState, err := valueobject.NewChargingState(doc.ChargingState)
+	if err != nil {
+		return valueobject.EnergySource{}, "", nil, fmt.Errorf("invalid charging state from database: %w", err)
+	}
+	var estimatedRange *valueobject.Mileage
+	if doc.EstimatedRange != nil {
+		r, err := valueobject.NewMileage(*doc.EstimatedRange)
+		if err != nil {
+			return valueobject.EnergySource{}, "", nil, fmt.Errorf("invalid estimated range from database: %w", err)
+		}
+		estimatedRange = &r
+	}
+	return energySource, chargingState, estimatedRange, nil
+}
+
+func kilometersOrNil(m *valueobject.Mileage) *float64 {
+	if m == nil {
+		return nil
+	}
+	km := m.Kilometers()
+	return &km
+}
+
+func vehicleQuery(ctx context.Context, filter repository.VehicleFilter) bson.M {
+	query := bson.M{"deletedAt": nil}
+	if filter.Deleted {
+		query["deletedAt"] = bson.M{"$ne": nil}
+	}
+	if filter.Manufacturer != "" {
+		query["manufacturer"] = bson.M{"$regex": "^" + regexp.QuoteMeta(filter.Manufacturer) + "$", "$options": "i"}
+	}
+	if filter.ModelYear != 0 {
+		query["modelYear"] = filter.ModelYear
+	}
+	if filter.Group != "" {
+		query["group"] = filter.Group
+	}
+	if filter.Tag != "" {
+		query["tags"] = filter.Tag
+	}
+	for name, value := range filter.Attributes {
+		query["attributes."+name] = value
+	}
+	return scoped(ctx, query)
+}
+
 func (r *MongoVehicleRepository) Delete(ctx context.Context, id valueobject.VehicleID) error {
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id.String()})
+	result, err := r.collection.DeleteOne(ctx, scoped(ctx, bson.M{"_id": id.String()}))
 	if err != nil {
 		return fmt.Errorf("failed to delete vehicle: %w", err)
 	}
@@ -213,10 +346,26 @@ func (r *MongoVehicleRepository) Delete(ctx context.Context, id valueobject.Vehi
 }
 
 func (r *MongoVehicleRepository) ExistsByVIN(ctx context.Context, vin string) (bool, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"vin": vin})
+	count, err := r.collection.CountDocuments(ctx, scoped(ctx, bson.M{"vin": vin}))
 	if err != nil {
 		return false, fmt.Errorf("failed to check vin existence: %w", err)
 	}
 
 	return count > 0, nil
 }
+
+func locationFromDocument(doc vehicleDocument) (valueobject.Location, error) {
+	timestamp := doc.LocationAt
+	if timestamp == 0 {
+		timestamp = doc.CreatedAt
+	}
+	location, err := valueobject.NewLocation(doc.Latitude, doc.Longitude, doc.Altitude, timestamp)
+	if err != nil {
+		return valueobject.Location{}, err
+	}
+	location, err = location.WithMotion(doc.SpeedKmh, doc.Heading)
+	if err != nil {
+		return valueobject.Location{}, err
+	}
+	return location.WithFixQuality(doc.Satellites, doc.HDOP)
+}
@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
+)
+
+func TestVehicleQuery_ScopedToTenant(t *testing.T) {
+	fleetA := tenant.WithID(context.Background(), "fleet-a")
+	fleetB := tenant.WithID(context.Background(), "fleet-b")
+	filter := repository.VehicleFilter{Manufacturer: "Honda", ModelYear: 2021}
+
+	queryA := vehicleQuery(fleetA, filter)
+	queryB := vehicleQuery(fleetB, filter)
+
+	assert.Equal(t, "fleet-a", queryA["tenantId"])
+	assert.Equal(t, "fleet-b", queryB["tenantId"])
+	assert.NotEqual(t, queryA, queryB)
+
+	deleted := vehicleQuery(fleetA, repository.VehicleFilter{Deleted: true})
+	assert.Equal(t, "fleet-a", deleted["tenantId"])
+}
+
+func TestScoped_DefaultTenantIncludesLegacyDocuments(t *testing.T) {
+	query := scoped(context.Background(), bson.M{"_id": "v-1"})
+
+	assert.Equal(t, bson.M{"$in": bson.A{tenant.DefaultID, nil}}, query["tenantId"])
+	assert.Equal(t, "v-1", query["_id"])
+	assert.Equal(t, tenant.DefaultID, tenantOrDefault(""))
+}
+
+func TestVehicleQuery_FiltersByGroupAndTag(t *testing.T) {
+	query := vehicleQuery(tenant.WithID(context.Background(), "fleet-a"), repository.VehicleFilter{Group: "North Depot", Tag: "reefer"})
+
+	assert.Equal(t, "North Depot", query["group"])
+	assert.Equal(t, "reefer", query["tags"])
+	assert.Equal(t, "fleet-a", query["tenantId"])
+}
+
+func TestVehicleQuery_FiltersByAttributes(t *testing.T) {
+	query := vehicleQuery(tenant.WithID(context.Background(), "fleet-a"), repository.VehicleFilter{
+		Attributes: map[string]interface{}{"costCenter": "CC-12", "axles": int64(3)},
+	})
+
+	assert.Equal(t, "CC-12", query["attributes.costCenter"])
+	assert.Equal(t, int64(3), query["attributes.axles"])
+}
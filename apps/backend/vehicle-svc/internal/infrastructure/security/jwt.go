@@ -14,8 +14,10 @@ const (
 )
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	TenantID string `json:"tenant_id"`
+	Units    string `json:"units,omitempty"` // the user's unit preference
 	jwt.RegisteredClaims
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/entity"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/repository"
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/tenant"
 	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/domain/valueobject"
 	"go.uber.org/zap"
 )
@@ -24,7 +25,7 @@ func NewSeeder(vehicleRepo repository.VehicleRepository, logger *zap.Logger) *Se
 
 func (s *Seeder) SeedVehicles(ctx context.Context) error {
 	// Check if vehicles already exist
-	vehicles, err := s.vehicleRepo.FindAll(ctx, 1, 0)
+	vehicles, err := s.vehicleRepo.FindAll(ctx, repository.VehicleFilter{}, 1, 0)
 	if err != nil {
 		s.logger.Error("failed to check existing vehicles", zap.Error(err))
 		return err
@@ -62,7 +63,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     85.0,
 		},
 		{
-			vin:           "2HGBH41JXMN109187",
+			vin:           "2HGBH41J9MN109187",
 			vehicleName:   "Fleet Vehicle 002",
 			vehicleModel:  "Honda Accord 2023",
 			licenseNumber: "DEF-456",
@@ -74,7 +75,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     60.0,
 		},
 		{
-			vin:           "3HGBH41JXMN109188",
+			vin:           "3HGBH41J8MN109188",
 			vehicleName:   "Fleet Vehicle 003",
 			vehicleModel:  "Ford F-150 2023",
 			licenseNumber: "GHI-789",
@@ -86,7 +87,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     92.0,
 		},
 		{
-			vin:           "4HGBH41JXMN109189",
+			vin:           "4HGBH41J7MN109189",
 			vehicleName:   "Fleet Vehicle 004",
 			vehicleModel:  "Tesla Model 3 2023",
 			licenseNumber: "JKL-012",
@@ -98,7 +99,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     45.0,
 		},
 		{
-			vin:           "5HGBH41JXMN109190",
+			vin:           "5HGBH41J0MN109190",
 			vehicleName:   "Fleet Vehicle 005",
 			vehicleModel:  "Chevrolet Silverado 2023",
 			licenseNumber: "MNO-345",
@@ -122,7 +123,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     55.0,
 		},
 		{
-			vin:           "7HGBH41JXMN109192",
+			vin:           "7HGBH41J9MN109192",
 			vehicleName:   "Fleet Vehicle 007",
 			vehicleModel:  "Mercedes-Benz E-Class 2023",
 			licenseNumber: "STU-901",
@@ -134,7 +135,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     88.0,
 		},
 		{
-			vin:           "8HGBH41JXMN109193",
+			vin:           "8HGBH41J8MN109193",
 			vehicleName:   "Fleet Vehicle 008",
 			vehicleModel:  "Nissan Altima 2023",
 			licenseNumber: "VWX-234",
@@ -146,7 +147,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     25.0,
 		},
 		{
-			vin:           "9HGBH41JXMN109194",
+			vin:           "9HGBH41J7MN109194",
 			vehicleName:   "Fleet Vehicle 009",
 			vehicleModel:  "Hyundai Sonata 2023",
 			licenseNumber: "YZA-567",
@@ -158,7 +159,7 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			fuelLevel:     95.0,
 		},
 		{
-			vin:           "1AHGBH41JXMN10919",
+			vin:           "1AHGBH410XMN10919",
 			vehicleName:   "Fleet Vehicle 010",
 			vehicleModel:  "Volkswagen Passat 2023",
 			licenseNumber: "BCD-890",
@@ -174,6 +175,12 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 	for _, v := range seedVehicles {
 		vehicleID := valueobject.GenerateVehicleID()
 
+		vin, err := valueobject.NewVIN(v.vin, valueobject.VINValidationStrict)
+		if err != nil {
+			s.logger.Error("failed to create vin", zap.Error(err), zap.String("vin", v.vin))
+			continue
+		}
+
 		licenseNumber, err := valueobject.NewLicenseNumber(v.licenseNumber)
 		if err != nil {
 			s.logger.Error("failed to create license number", zap.Error(err), zap.String("vin", v.vin))
@@ -206,7 +213,8 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 
 		vehicle, err := entity.NewVehicle(
 			vehicleID,
-			v.vin,
+			tenant.ID(ctx),
+			vin,
 			v.vehicleName,
 			v.vehicleModel,
 			licenseNumber,
@@ -214,6 +222,8 @@ func (s *Seeder) SeedVehicles(ctx context.Context) error {
 			location,
 			mileage,
 			fuelLevel,
+			valueobject.EnergySource{},
+			nil,
 		)
 		if err != nil {
 			s.logger.Error("failed to create vehicle entity", zap.Error(err), zap.String("vin", v.vin))
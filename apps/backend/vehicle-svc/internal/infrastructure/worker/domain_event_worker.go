@@ -134,11 +134,24 @@ func (w *DomainEventWorker) publishEvent(ctx context.Context, event repository.O
 
 func (w *DomainEventWorker) determineTopicFromEventType(eventType string) string {
 	topicMap := map[string]string{
-		"*event.VehicleCreatedEvent":          "vehicle.created",
-		"*event.VehicleLocationUpdatedEvent":  "vehicle.location.updated",
-		"*event.VehicleStatusChangedEvent":    "vehicle.status.changed",
-		"*event.VehicleMileageUpdatedEvent":   "vehicle.mileage.updated",
-		"*event.VehicleFuelLevelUpdatedEvent": "vehicle.fuel.updated",
+		"*event.VehicleCreatedEvent":            "vehicle.created",
+		"*event.VehicleLocationUpdatedEvent":    "vehicle.location.updated",
+		"*event.VehicleLocationArchivedEvent":   "vehicle.location.archived",
+		"*event.VehicleStatusChangedEvent":      "vehicle.status.changed",
+		"*event.VehicleMileageUpdatedEvent":     "vehicle.mileage.updated",
+		"*event.VehicleFuelLevelUpdatedEvent":   "vehicle.fuel.updated", // outbox rows written before energy levels
+		"*event.VehicleEnergyLevelUpdatedEvent": "vehicle.energy.updated",
+		"*event.DriverAssignedEvent":            "driver.assigned",
+		"*event.DriverUnassignedEvent":          "driver.unassigned",
+		"*event.DevicePairedEvent":              "device.paired",
+		"*event.DeviceUnpairedEvent":            "device.unpaired",
+		"*event.MaintenanceDueEvent":            "maintenance.due",
+		"*event.TrackingCorrectionAppliedEvent": "tracking.correction.applied",
+		"*event.VehicleDeletedEvent":            "vehicle.deleted",
+		"*event.VehicleRestoredEvent":           "vehicle.restored",
+		"*event.VehicleDetailsUpdatedEvent":     "vehicle.details.updated",
+		"*event.VehicleGroupChangedEvent":       "vehicle.group.changed",
+		"*event.VehicleTagsChangedEvent":        "vehicle.tags.changed",
 	}
 
 	if topic, exists := topicMap[eventType]; exists {
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kymnguyen/mvta/apps/backend/vehicle-svc/internal/application/command"
+)
+
+// MaintenanceScheduler periodically escalates maintenance tasks whose due or
+// overdue date has passed. Mileage bounds are checked on every odometer
+// update; dates pass without one.
+type MaintenanceScheduler struct {
+	commandBus command.CommandBus
+	logger     *zap.Logger
+	interval   time.Duration
+	done       chan struct{}
+}
+
+func NewMaintenanceScheduler(commandBus command.CommandBus, logger *zap.Logger, interval time.Duration) *MaintenanceScheduler {
+	return &MaintenanceScheduler{
+		commandBus: commandBus,
+		logger:     logger,
+		interval:   interval,
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *MaintenanceScheduler) Start(ctx context.Context) {
+	go s.loop(ctx)
+}
+
+func (s *MaintenanceScheduler) Stop() {
+	close(s.done)
+}
+
+func (s *MaintenanceScheduler) loop(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			s.logger.Info("maintenance scheduler stopped")
+			return
+		case <-ctx.Done():
+			s.logger.Info("maintenance scheduler context cancelled")
+			return
+		case <-ticker.C:
+			if err := s.commandBus.Dispatch(ctx, &command.EvaluateDueMaintenanceCommand{}); err != nil {
+				s.logger.Error("failed to evaluate due maintenance", zap.Error(err))
+			}
+		}
+	}
+}